@@ -0,0 +1,94 @@
+package main
+
+import (
+	"github.com/felipeversiane/picpay-golang.git/config"
+	"github.com/felipeversiane/picpay-golang.git/internal/handler"
+	"github.com/felipeversiane/picpay-golang.git/internal/notification"
+	"github.com/felipeversiane/picpay-golang.git/internal/receipt"
+	"github.com/felipeversiane/picpay-golang.git/internal/repository"
+	"github.com/felipeversiane/picpay-golang.git/internal/router"
+	"github.com/felipeversiane/picpay-golang.git/internal/service"
+	"github.com/felipeversiane/picpay-golang.git/internal/storage"
+	"github.com/felipeversiane/picpay-golang.git/internal/worker"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// container is the composition root of the API: it builds every repository,
+// service and handler exactly once, so the routes and the workers share the
+// same instances.
+type container struct {
+	handlers router.Handlers
+	services worker.Services
+	health   service.HealthService
+}
+
+func newContainer(cfg config.Config, conn *pgxpool.Pool) container {
+	notifier := notification.NewLogNotifier()
+	fileStorage := storage.NewLocalStorage(cfg.Storage.Path)
+	signer := receipt.NewSigner([]byte(cfg.Receipt.SecretKey))
+
+	userRepository := repository.NewUserRepository(conn)
+	orderRepository := repository.NewOrderRepository(conn)
+	orderAttachmentRepository := repository.NewOrderAttachmentRepository(conn)
+	recurrenceRepository := repository.NewRecurrenceRepository(conn)
+	transferLimitRepository := repository.NewTransferLimitRepository(conn)
+	feePlanRepository := repository.NewFeePlanRepository(conn)
+	settlementRepository := repository.NewSettlementRepository(conn)
+	anticipationRepository := repository.NewAnticipationRepository(conn)
+	pixKeyRepository := repository.NewPixKeyRepository(conn)
+	qrCodeRepository := repository.NewQRCodeRepository(conn)
+	paymentRequestRepository := repository.NewPaymentRequestRepository(conn)
+	billSplitRepository := repository.NewBillSplitRepository(conn)
+	paymentLinkRepository := repository.NewPaymentLinkRepository(conn)
+	invoiceRepository := repository.NewInvoiceRepository(conn)
+	payoutRepository := repository.NewPayoutRepository(conn)
+	healthRepository := repository.NewHealthRepository(conn)
+
+	userService := service.NewUserService(userRepository)
+	feePlanService := service.NewFeePlanService(feePlanRepository, userService)
+	orderService := service.NewOrderService(orderRepository, userService, feePlanService, cfg.Authorizer.URL, cfg.TransferLimit)
+	orderAttachmentService := service.NewOrderAttachmentService(orderAttachmentRepository, orderService, fileStorage)
+	recurrenceService := service.NewRecurrenceService(recurrenceRepository, orderService, userService)
+	transferLimitService := service.NewTransferLimitService(transferLimitRepository, cfg.TransferLimit)
+	settlementService := service.NewSettlementService(settlementRepository, userService)
+	anticipationService := service.NewAnticipationService(anticipationRepository, userService, cfg.Anticipation.MonthlyRate)
+	pixKeyService := service.NewPixKeyService(pixKeyRepository, userService, notifier, cfg.PixKey.ClaimWindow)
+	qrCodeService := service.NewQRCodeService(qrCodeRepository, userService, orderService)
+	paymentRequestService := service.NewPaymentRequestService(paymentRequestRepository, billSplitRepository, userService, orderService)
+	billSplitService := service.NewBillSplitService(billSplitRepository, userService, notifier)
+	paymentLinkService := service.NewPaymentLinkService(paymentLinkRepository, userService, orderService)
+	invoiceService := service.NewInvoiceService(invoiceRepository, userService, orderService)
+	payoutService := service.NewPayoutService(payoutRepository, userService, orderService)
+	receiptService := service.NewReceiptService(orderService, userService, signer)
+	healthService := service.NewHealthService(healthRepository, cfg.Authorizer.URL)
+
+	return container{
+		handlers: router.Handlers{
+			User:            handler.NewUserHandler(userService),
+			Order:           handler.NewOrderHandler(orderService, pixKeyService),
+			OrderAttachment: handler.NewOrderAttachmentHandler(orderAttachmentService),
+			Recurrence:      handler.NewRecurrenceHandler(recurrenceService),
+			TransferLimit:   handler.NewTransferLimitHandler(transferLimitService),
+			FeePlan:         handler.NewFeePlanHandler(feePlanService),
+			Settlement:      handler.NewSettlementHandler(settlementService),
+			Anticipation:    handler.NewAnticipationHandler(anticipationService),
+			PixKey:          handler.NewPixKeyHandler(pixKeyService),
+			QRCode:          handler.NewQRCodeHandler(qrCodeService),
+			PaymentRequest:  handler.NewPaymentRequestHandler(paymentRequestService),
+			BillSplit:       handler.NewBillSplitHandler(billSplitService),
+			PaymentLink:     handler.NewPaymentLinkHandler(paymentLinkService),
+			Invoice:         handler.NewInvoiceHandler(invoiceService),
+			Payout:          handler.NewPayoutHandler(payoutService),
+			Receipt:         handler.NewReceiptHandler(receiptService),
+			Health:          handler.NewHealthHandler(healthService),
+		},
+		services: worker.Services{
+			Recurrence:     recurrenceService,
+			Settlement:     settlementService,
+			PaymentRequest: paymentRequestService,
+			Invoice:        invoiceService,
+			Payout:         payoutService,
+		},
+		health: healthService,
+	}
+}
@@ -2,36 +2,28 @@ package main
 
 import (
 	"context"
+	"errors"
+	"flag"
+	"fmt"
 	"os"
+	"os/signal"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
 
+	"github.com/felipeversiane/picpay-golang.git/config"
 	"github.com/felipeversiane/picpay-golang.git/config/db"
 	"github.com/felipeversiane/picpay-golang.git/config/logger"
+	"github.com/felipeversiane/picpay-golang.git/config/server"
+	"github.com/felipeversiane/picpay-golang.git/config/tracing"
+	"github.com/felipeversiane/picpay-golang.git/internal/metrics"
 	"github.com/felipeversiane/picpay-golang.git/internal/router"
+	"github.com/felipeversiane/picpay-golang.git/internal/worker"
 	"github.com/gin-gonic/gin"
-	"github.com/joho/godotenv"
 	"go.uber.org/zap"
 )
 
-var (
-	POSTGRES_URL = "POSTGRES_URL"
-)
-
-func init() {
-	logger.Info("Initialize methods is going to run...",
-		zap.String("journey", "Initialize"))
-
-	if _, err := os.Stat(".env"); err == nil {
-		err := godotenv.Load()
-		if err != nil {
-			logger.Fatal("Error loading .env file: ", err,
-				zap.String("journey", "Loading .env"))
-		}
-	}
-
-	logger.Info("Initialize methods runned...",
-		zap.String("journey", "Initialize"))
-}
-
 // @title PicPay Challange
 // @version 1.0
 // @description REST API for a PicPay Challange.
@@ -44,11 +36,47 @@ func init() {
 // @license.name Apache 2.0
 // @license.url http://www.apache.org/licenses/LICENSE-2.0.html
 func main() {
-	var err error
-	ctx := context.Background()
-	connectionString := os.Getenv(POSTGRES_URL)
+	configFile := flag.String("config", "", "path of an optional YAML configuration file, CONFIG_FILE by default")
+	printConfig := flag.Bool("print-config", false, "print the configuration with its secrets redacted and exit")
+	flag.Parse()
+
+	cfg, err := config.Load(*configFile)
+	err = errors.Join(err, cfg.Validate())
+
+	if *printConfig {
+		if printErr := cfg.Print(os.Stdout); printErr != nil {
+			err = errors.Join(err, printErr)
+		}
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Invalid configuration:\n%v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	if err != nil {
+		logger.Fatal("Invalid configuration", err,
+			zap.String("journey", "Configuration"))
+	}
+	if err := logger.Configure(cfg.Log); err != nil {
+		logger.Fatal("Invalid log configuration", err,
+			zap.String("journey", "Configuration"))
+	}
+	gin.SetMode(strings.ToLower(strings.TrimSpace(cfg.Server.GinMode)))
+
+	logger.Info("Configuration loaded",
+		zap.String("journey", "Configuration"))
 
-	conn, err := db.NewConnection(ctx, connectionString)
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	shutdownTracing, err := tracing.Init(ctx, cfg.Tracing)
+	if err != nil {
+		logger.Fatal("Tracing error: ", err,
+			zap.String("journey", "Tracing"))
+	}
+
+	conn, err := db.NewConnection(ctx, cfg.Database.URL)
 	if err != nil {
 		logger.Fatal("Database error: ", err,
 			zap.String("journey", "Database Connection"))
@@ -58,11 +86,77 @@ func main() {
 	logger.Info("Database connection completed",
 		zap.String("journey", "Database Connection"))
 
+	metrics.RegisterPool(conn)
+
+	app := newContainer(cfg, conn)
+
 	g := gin.New()
 	g.Use(gin.Recovery())
-	router.InitRoutes(g)
+	router.InitRoutes(g, app.handlers, cfg)
 	logger.Info("Routes initialized sucessfully.",
 		zap.String("journey", "Initialize Routes"))
-	g.Run()
 
+	srv, err := server.NewServer(g, cfg.Server)
+	if err != nil {
+		logger.Fatal("Invalid server configuration", err,
+			zap.String("journey", "Server"))
+	}
+
+	workerCtx, stopWorkers := context.WithCancel(context.Background())
+	defer stopWorkers()
+	workers := worker.InitWorkers(workerCtx, app.services, cfg.Workers)
+	logger.Info("Workers initialized sucessfully.",
+		zap.String("journey", "Initialize Workers"))
+
+	// The readiness probe fails first, and the server only stops accepting
+	// connections once the load balancer had the delay to notice it.
+	serveCtx, stopServing := context.WithCancel(context.Background())
+	defer stopServing()
+	go func() {
+		<-ctx.Done()
+		app.health.MarkShuttingDown()
+		logger.Info("Waiting for the readiness probe to report the shutdown",
+			zap.Duration("delay", cfg.Server.ReadinessDelay),
+			zap.String("journey", "Shutdown"))
+		time.Sleep(cfg.Server.ReadinessDelay)
+		stopServing()
+	}()
+
+	if err := srv.Run(serveCtx); err != nil {
+		logger.Error("Server error", err,
+			zap.String("journey", "Server"))
+	}
+
+	stopWorkers()
+	waitWorkers(workers, srv.ShutdownTimeout())
+
+	flushCtx, cancelFlush := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancelFlush()
+	if err := shutdownTracing(flushCtx); err != nil {
+		logger.Error("Error flushing traces", err,
+			zap.String("journey", "Shutdown"))
+	}
+
+	logger.Info("Shutdown completed",
+		zap.String("journey", "Shutdown"))
+	logger.Close()
+}
+
+// waitWorkers waits for the workers to finish their current task, giving up
+// after timeout so a stuck task cannot hold the process forever.
+func waitWorkers(workers *sync.WaitGroup, timeout time.Duration) {
+	done := make(chan struct{})
+	go func() {
+		workers.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		logger.Info("Workers stopped",
+			zap.String("journey", "Shutdown"))
+	case <-time.After(timeout):
+		logger.Warn("Timed out waiting for workers to stop",
+			zap.String("journey", "Shutdown"))
+	}
 }
@@ -0,0 +1,286 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/joho/godotenv"
+	"gopkg.in/yaml.v3"
+)
+
+var CONFIG_FILE = "CONFIG_FILE"
+
+const redacted = "[REDACTED]"
+
+// Config is the whole configuration of the API. Every field is bound to the
+// environment variable in its env tag and to the key in its yaml tag. Fields
+// tagged secret are redacted when the configuration is printed.
+type Config struct {
+	Server        Server        `yaml:"server"`
+	Database      Database      `yaml:"database"`
+	Authorizer    Authorizer    `yaml:"authorizer"`
+	Log           Log           `yaml:"log"`
+	Tracing       Tracing       `yaml:"tracing"`
+	Admin         Admin         `yaml:"admin"`
+	Receipt       Receipt       `yaml:"receipt"`
+	Storage       Storage       `yaml:"storage"`
+	TransferLimit TransferLimit `yaml:"transfer_limit"`
+	Anticipation  Anticipation  `yaml:"anticipation"`
+	PixKey        PixKey        `yaml:"pix_key"`
+	Workers       Workers       `yaml:"workers"`
+}
+
+type Server struct {
+	Port              int           `yaml:"port" env:"PORT"`
+	GinMode           string        `yaml:"gin_mode" env:"GIN_MODE"`
+	ReadTimeout       time.Duration `yaml:"read_timeout" env:"SERVER_READ_TIMEOUT"`
+	ReadHeaderTimeout time.Duration `yaml:"read_header_timeout" env:"SERVER_READ_HEADER_TIMEOUT"`
+	WriteTimeout      time.Duration `yaml:"write_timeout" env:"SERVER_WRITE_TIMEOUT"`
+	IdleTimeout       time.Duration `yaml:"idle_timeout" env:"SERVER_IDLE_TIMEOUT"`
+	ShutdownTimeout   time.Duration `yaml:"shutdown_timeout" env:"SERVER_SHUTDOWN_TIMEOUT"`
+	ReadinessDelay    time.Duration `yaml:"shutdown_readiness_delay" env:"SHUTDOWN_READINESS_DELAY"`
+	TLSCertFile       string        `yaml:"tls_cert_file" env:"TLS_CERT_FILE"`
+	TLSKeyFile        string        `yaml:"tls_key_file" env:"TLS_KEY_FILE"`
+	RequestTimeout    time.Duration `yaml:"request_timeout" env:"REQUEST_TIMEOUT"`
+	RouteTimeouts     string        `yaml:"route_timeouts" env:"ROUTE_TIMEOUTS"`
+}
+
+type Database struct {
+	URL string `yaml:"url" env:"POSTGRES_URL" secret:"password"`
+}
+
+type Authorizer struct {
+	URL string `yaml:"url" env:"AUTHORIZATION_URL"`
+}
+
+type Log struct {
+	Level              string        `yaml:"level" env:"LOG_LEVEL"`
+	Output             string        `yaml:"output" env:"LOG_OUTPUT"`
+	Encoding           string        `yaml:"encoding" env:"LOG_ENCODING"`
+	FileMaxSizeMB      int           `yaml:"file_max_size_mb" env:"LOG_FILE_MAX_SIZE_MB"`
+	FileMaxBackups     int           `yaml:"file_max_backups" env:"LOG_FILE_MAX_BACKUPS"`
+	FileMaxAgeDays     int           `yaml:"file_max_age_days" env:"LOG_FILE_MAX_AGE_DAYS"`
+	FileCompress       bool          `yaml:"file_compress" env:"LOG_FILE_COMPRESS"`
+	FileRotateInterval time.Duration `yaml:"file_rotate_interval" env:"LOG_FILE_ROTATE_INTERVAL"`
+	RedactDisable      string        `yaml:"redact_disable" env:"LOG_REDACT_DISABLE"`
+	RedactKeys         string        `yaml:"redact_keys" env:"LOG_REDACT_KEYS"`
+}
+
+type Tracing struct {
+	Exporter     string `yaml:"exporter" env:"OTEL_TRACES_EXPORTER"`
+	ServiceName  string `yaml:"service_name" env:"OTEL_SERVICE_NAME"`
+	OTLPEndpoint string `yaml:"otlp_endpoint" env:"OTEL_EXPORTER_OTLP_ENDPOINT"`
+}
+
+type Admin struct {
+	Token string `yaml:"token" env:"ADMIN_TOKEN" secret:"true"`
+}
+
+type Receipt struct {
+	SecretKey string `yaml:"secret_key" env:"RECEIPT_SECRET_KEY" secret:"true"`
+}
+
+type Storage struct {
+	Path string `yaml:"path" env:"STORAGE_PATH"`
+}
+
+type TransferLimit struct {
+	NightStartHour int `yaml:"night_start_hour" env:"NIGHT_START_HOUR"`
+	NightEndHour   int `yaml:"night_end_hour" env:"NIGHT_END_HOUR"`
+}
+
+type Anticipation struct {
+	MonthlyRate float64 `yaml:"monthly_rate" env:"ANTICIPATION_MONTHLY_RATE"`
+}
+
+type PixKey struct {
+	ClaimWindow time.Duration `yaml:"claim_window" env:"PIX_KEY_CLAIM_WINDOW"`
+}
+
+type Workers struct {
+	RecurrenceInterval     time.Duration `yaml:"recurrence_interval" env:"RECURRENCE_WORKER_INTERVAL"`
+	SettlementInterval     time.Duration `yaml:"settlement_interval" env:"SETTLEMENT_WORKER_INTERVAL"`
+	PaymentRequestInterval time.Duration `yaml:"payment_request_interval" env:"PAYMENT_REQUEST_WORKER_INTERVAL"`
+	InvoiceInterval        time.Duration `yaml:"invoice_interval" env:"INVOICE_WORKER_INTERVAL"`
+	PayoutInterval         time.Duration `yaml:"payout_interval" env:"PAYOUT_WORKER_INTERVAL"`
+}
+
+// Default returns the configuration used for every value that is not set.
+// The database and authorizer URLs have no default and must be set.
+func Default() Config {
+	return Config{
+		Server: Server{
+			Port:              8080,
+			GinMode:           "debug",
+			ReadTimeout:       15 * time.Second,
+			ReadHeaderTimeout: 5 * time.Second,
+			WriteTimeout:      15 * time.Second,
+			IdleTimeout:       60 * time.Second,
+			ShutdownTimeout:   30 * time.Second,
+			ReadinessDelay:    5 * time.Second,
+			RequestTimeout:    10 * time.Second,
+		},
+		Log: Log{
+			Level:          "info",
+			Output:         "stdout",
+			Encoding:       "json",
+			FileMaxSizeMB:  100,
+			FileMaxBackups: 10,
+			FileMaxAgeDays: 7,
+		},
+		Tracing: Tracing{
+			Exporter:     "none",
+			ServiceName:  "picpay-golang",
+			OTLPEndpoint: "http://localhost:4318",
+		},
+		Storage: Storage{Path: "./storage"},
+		TransferLimit: TransferLimit{
+			NightStartHour: 20,
+			NightEndHour:   6,
+		},
+		Anticipation: Anticipation{MonthlyRate: 1.99},
+		PixKey:       PixKey{ClaimWindow: 7 * 24 * time.Hour},
+		Workers: Workers{
+			RecurrenceInterval:     time.Minute,
+			SettlementInterval:     time.Hour,
+			PaymentRequestInterval: time.Minute,
+			InvoiceInterval:        time.Hour,
+			PayoutInterval:         10 * time.Second,
+		},
+	}
+}
+
+// Load builds the configuration from, in increasing order of precedence, the
+// defaults, the YAML file at path (or CONFIG_FILE when path is empty; the
+// file is optional), the .env file of the working directory and the
+// environment. Empty environment variables count as unset.
+func Load(path string) (Config, error) {
+	cfg := Default()
+
+	if path == "" {
+		path = strings.TrimSpace(os.Getenv(CONFIG_FILE))
+	}
+	if path != "" {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return cfg, fmt.Errorf("error reading config file: %w", err)
+		}
+		if err := yaml.Unmarshal(content, &cfg); err != nil {
+			return cfg, fmt.Errorf("error parsing config file %s: %w", path, err)
+		}
+	}
+
+	if _, err := os.Stat(".env"); err == nil {
+		if err := godotenv.Load(); err != nil {
+			return cfg, fmt.Errorf("error loading .env file: %w", err)
+		}
+	}
+
+	errs := []error{}
+	walk(&cfg, func(field reflect.Value, tag reflect.StructTag) {
+		name := tag.Get("env")
+		value := strings.TrimSpace(os.Getenv(name))
+		if value == "" {
+			return
+		}
+		if err := setField(field, value); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", name, err))
+		}
+	})
+	return cfg, errors.Join(errs...)
+}
+
+// Print writes the configuration as YAML with its secrets redacted.
+func (c Config) Print(w io.Writer) error {
+	walk(&c, func(field reflect.Value, tag reflect.StructTag) {
+		switch tag.Get("secret") {
+		case "":
+		case "password":
+			field.SetString(redactPassword(field.String()))
+		default:
+			if field.String() != "" {
+				field.SetString(redacted)
+			}
+		}
+	})
+
+	encoder := yaml.NewEncoder(w)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(c); err != nil {
+		return err
+	}
+	return encoder.Close()
+}
+
+// walk calls fn with every field of cfg bound to an environment variable.
+func walk(cfg *Config, fn func(field reflect.Value, tag reflect.StructTag)) {
+	sections := reflect.ValueOf(cfg).Elem()
+	for i := 0; i < sections.NumField(); i++ {
+		section := sections.Field(i)
+		for j := 0; j < section.NumField(); j++ {
+			tag := section.Type().Field(j).Tag
+			if tag.Get("env") != "" {
+				fn(section.Field(j), tag)
+			}
+		}
+	}
+}
+
+func setField(field reflect.Value, value string) error {
+	if field.Type() == reflect.TypeOf(time.Duration(0)) {
+		duration, err := time.ParseDuration(value)
+		if err != nil {
+			return err
+		}
+		field.SetInt(int64(duration))
+		return nil
+	}
+
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(value)
+	case reflect.Int:
+		number, err := strconv.Atoi(value)
+		if err != nil {
+			return fmt.Errorf("%q is not an integer", value)
+		}
+		field.SetInt(int64(number))
+	case reflect.Float64:
+		number, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return fmt.Errorf("%q is not a number", value)
+		}
+		field.SetFloat(number)
+	case reflect.Bool:
+		flag, err := strconv.ParseBool(value)
+		if err != nil {
+			return fmt.Errorf("%q is not a boolean", value)
+		}
+		field.SetBool(flag)
+	}
+	return nil
+}
+
+// redactPassword masks the password of a URL and keeps the rest, which is
+// what one needs to tell which database the API points to. Values that are
+// not URLs, such as key/value connection strings, are redacted as a whole.
+func redactPassword(value string) string {
+	if value == "" {
+		return value
+	}
+	parsed, err := url.Parse(value)
+	if err != nil || parsed.Scheme == "" {
+		return redacted
+	}
+	if _, ok := parsed.User.Password(); ok {
+		parsed.User = url.UserPassword(parsed.User.Username(), "xxxxx")
+	}
+	return parsed.String()
+}
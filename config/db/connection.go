@@ -4,11 +4,10 @@ import (
 	"context"
 	"time"
 
+	"github.com/felipeversiane/picpay-golang.git/config/tracing"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
-var Conn *pgxpool.Pool
-
 func NewConnection(ctx context.Context, connectionString string) (*pgxpool.Pool, error) {
 	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
@@ -17,11 +16,7 @@ func NewConnection(ctx context.Context, connectionString string) (*pgxpool.Pool,
 	if err != nil {
 		return nil, err
 	}
+	cfg.ConnConfig.Tracer = tracing.NewQueryTracer()
 
-	Conn, err = pgxpool.NewWithConfig(ctx, cfg)
-	if err != nil {
-		return nil, err
-	}
-
-	return Conn, nil
+	return pgxpool.NewWithConfig(ctx, cfg)
 }
@@ -3,10 +3,11 @@ package http_error
 import "net/http"
 
 type HttpError struct {
-	Message string   `json:"message"`
-	Err     string   `json:"error"`
-	Code    int      `json:"code"`
-	Causes  []Causes `json:"causes"`
+	Message string                 `json:"message"`
+	Err     string                 `json:"error"`
+	Code    int                    `json:"code"`
+	Causes  []Causes               `json:"causes"`
+	Details map[string]interface{} `json:"details,omitempty"`
 }
 
 type Causes struct {
@@ -66,3 +67,12 @@ func NewForbiddenError(message string) *HttpError {
 		Code:    http.StatusForbidden,
 	}
 }
+
+func NewUnprocessableEntityError(message string, err string, details map[string]interface{}) *HttpError {
+	return &HttpError{
+		Message: message,
+		Err:     err,
+		Code:    http.StatusUnprocessableEntity,
+		Details: details,
+	}
+}
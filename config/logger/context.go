@@ -0,0 +1,88 @@
+package logger
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+type contextKey struct{}
+
+// requestFields are the request-scoped values added to every log line
+// written through FromContext.
+type requestFields struct {
+	requestID string
+	userID    string
+	route     string
+}
+
+// Logger writes log lines with the request-scoped fields of a context.
+type Logger struct {
+	log *zap.Logger
+}
+
+// WithRequest stores the request ID and route in ctx.
+func WithRequest(ctx context.Context, requestID string, route string) context.Context {
+	fields := fieldsFromContext(ctx)
+	fields.requestID = requestID
+	fields.route = route
+	return context.WithValue(ctx, contextKey{}, fields)
+}
+
+// WithUserID stores the ID of the user acting in the request in ctx.
+func WithUserID(ctx context.Context, userID string) context.Context {
+	fields := fieldsFromContext(ctx)
+	fields.userID = userID
+	return context.WithValue(ctx, contextKey{}, fields)
+}
+
+// RequestID returns the request ID stored in ctx, or an empty string.
+func RequestID(ctx context.Context) string {
+	return fieldsFromContext(ctx).requestID
+}
+
+// FromContext returns a logger that adds the request ID, user ID, route and
+// trace ID found in ctx to every line.
+func FromContext(ctx context.Context) *Logger {
+	fields := fieldsFromContext(ctx)
+
+	tags := make([]zap.Field, 0, 4)
+	if fields.requestID != "" {
+		tags = append(tags, zap.String("request_id", fields.requestID))
+	}
+	if fields.userID != "" {
+		tags = append(tags, zap.String("user_id", fields.userID))
+	}
+	if fields.route != "" {
+		tags = append(tags, zap.String("route", fields.route))
+	}
+	if spanContext := trace.SpanContextFromContext(ctx); spanContext.HasTraceID() {
+		tags = append(tags, zap.String("trace_id", spanContext.TraceID().String()))
+	}
+
+	return &Logger{log.With(tags...)}
+}
+
+func (l *Logger) Info(message string, tags ...zap.Field) {
+	l.log.Info(message, tags...)
+	l.log.Sync()
+}
+
+func (l *Logger) Error(message string, err error, tags ...zap.Field) {
+	tags = append(tags, zap.NamedError("error", err))
+	l.log.Error(message, tags...)
+	l.log.Sync()
+}
+
+func (l *Logger) Warn(message string, tags ...zap.Field) {
+	l.log.Warn(message, tags...)
+	l.log.Sync()
+}
+
+func fieldsFromContext(ctx context.Context) requestFields {
+	if fields, ok := ctx.Value(contextKey{}).(requestFields); ok {
+		return fields
+	}
+	return requestFields{}
+}
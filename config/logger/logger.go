@@ -2,9 +2,11 @@ package logger
 
 import (
 	"fmt"
+	"net/http"
 	"os"
 	"strings"
 
+	"github.com/felipeversiane/picpay-golang.git/config"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 )
@@ -12,28 +14,60 @@ import (
 var (
 	log *zap.Logger
 
-	LOG_OUTPUT = "LOG_OUTPUT"
-	LOG_LEVEL  = "LOG_LEVEL"
+	// closeSinks stops the rotation and closes the files of the current
+	// sinks, when the logger is rebuilt or closed.
+	closeSinks = func() {}
+
+	// level is shared by every sink, so changing it through LevelHandler
+	// takes effect at once without restarting the process.
+	level = zap.NewAtomicLevelAt(zapcore.InfoLevel)
 )
 
+// init writes JSON to stdout only, until Configure is called with the
+// configuration loaded.
 func init() {
-	logConfig := zap.Config{
-		OutputPaths: []string{getOutputLogs()},
-		Level:       zap.NewAtomicLevelAt(getLevelLogs()),
-		Encoding:    "json",
-		EncoderConfig: zapcore.EncoderConfig{
-			LevelKey:     "level",
-			TimeKey:      "time",
-			MessageKey:   "message",
-			EncodeTime:   zapcore.ISO8601TimeEncoder,
-			EncodeLevel:  zapcore.LowercaseLevelEncoder,
-			EncodeCaller: zapcore.ShortCallerEncoder,
-		},
+	build([]sink{{target: "stdout", level: zapcore.DebugLevel}}, newEncoder(EncodingJSON), NewRedactor(DefaultRules(), DefaultKeys()))
+}
+
+// Configure rebuilds the logger with the sinks, encoding, level and
+// redaction of cfg. It is called once at startup, after the configuration
+// is loaded and validated.
+func Configure(cfg config.Log) error {
+	sinks, err := parseSinks(cfg.Output, cfg)
+	if err != nil {
+		return err
 	}
-	var err error
-	if log, err = logConfig.Build(); err != nil {
-		panic(err)
+
+	level.SetLevel(parseLevel(cfg.Level))
+	build(sinks, newEncoder(cfg.Encoding), newConfiguredRedactor(cfg.RedactDisable, cfg.RedactKeys))
+	return nil
+}
+
+func build(sinks []sink, encoder zapcore.Encoder, redactor *Redactor) {
+	core, closer := newCore(sinks, encoder, level, redactor)
+	if log != nil {
+		log.Sync()
 	}
+	log = zap.New(core, zap.ErrorOutput(zapcore.Lock(os.Stderr)))
+
+	previous := closeSinks
+	closeSinks = closer
+	previous()
+}
+
+// Close flushes the logger and stops the rotation of its files. It is
+// called last on shutdown; a line logged afterwards reopens the files
+// without rotating them on the interval.
+func Close() {
+	log.Sync()
+	closeSinks()
+	closeSinks = func() {}
+}
+
+// LevelHandler reports the current level on GET and changes it on PUT with
+// a JSON body such as {"level":"debug"}.
+func LevelHandler() http.Handler {
+	return level
 }
 
 func Info(message string, tags ...zap.Field) {
@@ -68,18 +102,8 @@ func Printf(format string, v ...interface{}) {
 	log.Sync()
 }
 
-func getOutputLogs() string {
-	output := strings.ToLower(strings.TrimSpace(os.Getenv(LOG_OUTPUT)))
-	if output == "" || output == "stdout" {
-		return "stdout"
-	}
-	return output
-}
-
-func getLevelLogs() zapcore.Level {
-	switch strings.ToLower(strings.TrimSpace(os.Getenv(LOG_LEVEL))) {
-	case "info":
-		return zapcore.InfoLevel
+func parseLevel(value string) zapcore.Level {
+	switch strings.ToLower(strings.TrimSpace(value)) {
 	case "error":
 		return zapcore.ErrorLevel
 	case "debug":
@@ -0,0 +1,229 @@
+package logger
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+const Redacted = "[REDACTED]"
+
+// Rule masks every match of Pattern in messages and field values with
+// Replacement, which may refer to capture groups as in regexp.ReplaceAllString.
+type Rule struct {
+	Name        string
+	Pattern     *regexp.Regexp
+	Replacement string
+}
+
+// DefaultRules mask secrets written as key/value pairs, bearer tokens and
+// JWTs, emails, CPFs and CNPJs. The secret rule runs first, so a password
+// that looks like a document is still masked as a whole.
+func DefaultRules() []Rule {
+	return []Rule{
+		{
+			Name:        "secret",
+			Pattern:     regexp.MustCompile(`(?i)("?\b(?:password|passwd|pwd|secret|token|access_token|refresh_token|api_key|apikey|authorization)"?\s*[:=]\s*)("(?:[^"\\]|\\.)*"|(?:(?:bearer|basic)\s+)?[^\s,&}]+)`),
+			Replacement: `${1}"` + Redacted + `"`,
+		},
+		{
+			Name:        "bearer",
+			Pattern:     regexp.MustCompile(`(?i)\b(bearer|basic)\s+[A-Za-z0-9\-._~+/]+=*`),
+			Replacement: "${1} " + Redacted,
+		},
+		{
+			Name:        "jwt",
+			Pattern:     regexp.MustCompile(`\beyJ[A-Za-z0-9_-]*\.[A-Za-z0-9_-]+\.[A-Za-z0-9_-]*`),
+			Replacement: Redacted,
+		},
+		{
+			Name:        "email",
+			Pattern:     regexp.MustCompile(`[A-Za-z0-9._%+-]+@([A-Za-z0-9-]+(?:\.[A-Za-z0-9-]+)*\.[A-Za-z]{2,})`),
+			Replacement: "***@${1}",
+		},
+		{
+			Name:        "cnpj",
+			Pattern:     regexp.MustCompile(`\b\d{2}\.?\d{3}\.?\d{3}/?\d{4}-?\d{2}\b`),
+			Replacement: "**.***.***/****-**",
+		},
+		{
+			Name:        "cpf",
+			Pattern:     regexp.MustCompile(`\b\d{3}\.?\d{3}\.?\d{3}-?\d{2}\b`),
+			Replacement: "***.***.***-**",
+		},
+	}
+}
+
+// DefaultKeys are field keys whose value is always replaced as a whole,
+// whatever it looks like.
+func DefaultKeys() []string {
+	return []string{
+		"password", "passwd", "pwd", "secret", "token", "access_token",
+		"refresh_token", "api_key", "apikey", "authorization", "cookie",
+		"document", "cpf", "cnpj", "email",
+	}
+}
+
+// Redactor masks personal data and secrets before they reach the encoder.
+type Redactor struct {
+	rules []Rule
+	keys  map[string]bool
+}
+
+func NewRedactor(rules []Rule, keys []string) *Redactor {
+	r := &Redactor{rules: rules, keys: map[string]bool{}}
+	for _, key := range keys {
+		r.keys[normalizeKey(key)] = true
+	}
+	return r
+}
+
+// newConfiguredRedactor uses the default rules and keys, minus the rules
+// named in disable and plus the keys listed in extraKeys, both comma
+// separated.
+func newConfiguredRedactor(disable string, extraKeys string) *Redactor {
+	disabled := map[string]bool{}
+	for _, name := range splitList(disable) {
+		disabled[strings.ToLower(name)] = true
+	}
+
+	rules := []Rule{}
+	for _, rule := range DefaultRules() {
+		if !disabled[rule.Name] {
+			rules = append(rules, rule)
+		}
+	}
+
+	keys := append(DefaultKeys(), splitList(extraKeys)...)
+	return NewRedactor(rules, keys)
+}
+
+// String applies every rule to s.
+func (r *Redactor) String(s string) string {
+	for _, rule := range r.rules {
+		s = rule.Pattern.ReplaceAllString(s, rule.Replacement)
+	}
+	return s
+}
+
+// Field masks the whole value of a field with a sensitive key and applies
+// the rules to the text of any other field that can carry free text.
+func (r *Redactor) Field(f zapcore.Field) zapcore.Field {
+	if r.keys[normalizeKey(f.Key)] {
+		return zap.String(f.Key, Redacted)
+	}
+
+	switch f.Type {
+	case zapcore.StringType:
+		f.String = r.String(f.String)
+	case zapcore.ByteStringType:
+		if b, ok := f.Interface.([]byte); ok {
+			return zap.String(f.Key, r.String(string(b)))
+		}
+	case zapcore.ErrorType:
+		if err, ok := f.Interface.(error); ok {
+			return zap.String(f.Key, r.String(err.Error()))
+		}
+	case zapcore.StringerType:
+		if s, ok := f.Interface.(fmt.Stringer); ok {
+			return zap.String(f.Key, r.String(s.String()))
+		}
+	case zapcore.ReflectType, zapcore.ObjectMarshalerType, zapcore.ArrayMarshalerType:
+		encoded, err := json.Marshal(f.Interface)
+		if err != nil {
+			return zap.String(f.Key, Redacted)
+		}
+		return zap.Reflect(f.Key, json.RawMessage(r.JSON(encoded)))
+	}
+	return f
+}
+
+// JSON masks the values of sensitive keys in a JSON document and applies
+// the rules to every string in it.
+func (r *Redactor) JSON(data []byte) []byte {
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return []byte(fmt.Sprintf("%q", r.String(string(data))))
+	}
+	redacted, err := json.Marshal(r.value(value))
+	if err != nil {
+		return []byte(fmt.Sprintf("%q", Redacted))
+	}
+	return redacted
+}
+
+func (r *Redactor) value(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for key, inner := range v {
+			if r.keys[normalizeKey(key)] {
+				v[key] = Redacted
+				continue
+			}
+			v[key] = r.value(inner)
+		}
+		return v
+	case []interface{}:
+		for i, inner := range v {
+			v[i] = r.value(inner)
+		}
+		return v
+	case string:
+		return r.String(v)
+	default:
+		return v
+	}
+}
+
+func (r *Redactor) Fields(fields []zapcore.Field) []zapcore.Field {
+	redacted := make([]zapcore.Field, len(fields))
+	for i, f := range fields {
+		redacted[i] = r.Field(f)
+	}
+	return redacted
+}
+
+// redactingCore masks the message and fields of every entry before handing
+// it to the wrapped core, so nothing unmasked reaches the encoder.
+type redactingCore struct {
+	zapcore.Core
+	redactor *Redactor
+}
+
+func NewRedactingCore(core zapcore.Core, redactor *Redactor) zapcore.Core {
+	return &redactingCore{core, redactor}
+}
+
+func (c *redactingCore) With(fields []zapcore.Field) zapcore.Core {
+	return &redactingCore{c.Core.With(c.redactor.Fields(fields)), c.redactor}
+}
+
+func (c *redactingCore) Check(entry zapcore.Entry, checked *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.Enabled(entry.Level) {
+		return checked.AddCore(entry, c)
+	}
+	return checked
+}
+
+func (c *redactingCore) Write(entry zapcore.Entry, fields []zapcore.Field) error {
+	entry.Message = c.redactor.String(entry.Message)
+	return c.Core.Write(entry, c.redactor.Fields(fields))
+}
+
+func normalizeKey(key string) string {
+	return strings.ReplaceAll(strings.ToLower(strings.TrimSpace(key)), "-", "_")
+}
+
+func splitList(value string) []string {
+	items := []string{}
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
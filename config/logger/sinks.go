@@ -0,0 +1,141 @@
+package logger
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/felipeversiane/picpay-golang.git/config"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"gopkg.in/natefinch/lumberjack.v2"
+)
+
+const (
+	EncodingJSON    = "json"
+	EncodingConsole = "console"
+)
+
+// sink is one destination of the logs with its own minimum level. A line
+// reaches the sink only when it passes both the sink level and the global
+// level, which can be changed at runtime. File sinks are rotated as set in
+// file.
+type sink struct {
+	target string
+	level  zapcore.Level
+	file   config.Log
+}
+
+// parseSinks reads LOG_OUTPUT as a comma separated list of "target=level",
+// where target is stdout, stderr or a file path and the level is optional,
+// such as "stdout=info,/var/log/picpay/api.log=debug".
+func parseSinks(value string, file config.Log) ([]sink, error) {
+	sinks := []sink{}
+	for _, entry := range splitList(value) {
+		target, levelName, hasLevel := strings.Cut(entry, "=")
+		s := sink{target: strings.TrimSpace(target), level: zapcore.DebugLevel, file: file}
+		if hasLevel {
+			level, err := zapcore.ParseLevel(strings.TrimSpace(levelName))
+			if err != nil {
+				return nil, fmt.Errorf("invalid level of log output %q: %w", entry, err)
+			}
+			s.level = level
+		}
+		if strings.EqualFold(s.target, "stdout") || strings.EqualFold(s.target, "stderr") {
+			s.target = strings.ToLower(s.target)
+		}
+		sinks = append(sinks, s)
+	}
+	if len(sinks) == 0 {
+		sinks = append(sinks, sink{target: "stdout", level: zapcore.DebugLevel})
+	}
+	return sinks, nil
+}
+
+// writer opens the destination of the sink. The returned function stops the
+// interval rotation and closes the file, and is a no-op for stdout and
+// stderr.
+func (s sink) writer() (zapcore.WriteSyncer, func()) {
+	switch s.target {
+	case "stdout":
+		return zapcore.Lock(os.Stdout), func() {}
+	case "stderr":
+		return zapcore.Lock(os.Stderr), func() {}
+	}
+
+	file := &lumberjack.Logger{
+		Filename:   s.target,
+		MaxSize:    s.file.FileMaxSizeMB,
+		MaxBackups: s.file.FileMaxBackups,
+		MaxAge:     s.file.FileMaxAgeDays,
+		Compress:   s.file.FileCompress,
+		LocalTime:  true,
+	}
+	stop := make(chan struct{})
+	if s.file.FileRotateInterval > 0 {
+		go rotateEvery(file, s.file.FileRotateInterval, stop)
+	}
+	return zapcore.AddSync(file), func() {
+		close(stop)
+		file.Close()
+	}
+}
+
+// rotateEvery rotates the file on a fixed interval on top of the size based
+// rotation done by lumberjack, until stop is closed.
+func rotateEvery(file *lumberjack.Logger, interval time.Duration, stop <-chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			if err := file.Rotate(); err != nil {
+				fmt.Fprintf(os.Stderr, "error rotating log file %s: %v\n", file.Filename, err)
+			}
+		}
+	}
+}
+
+func newEncoder(encoding string) zapcore.Encoder {
+	config := zapcore.EncoderConfig{
+		LevelKey:     "level",
+		TimeKey:      "time",
+		MessageKey:   "message",
+		EncodeTime:   zapcore.ISO8601TimeEncoder,
+		EncodeLevel:  zapcore.LowercaseLevelEncoder,
+		EncodeCaller: zapcore.ShortCallerEncoder,
+	}
+	if strings.EqualFold(strings.TrimSpace(encoding), EncodingConsole) {
+		config.EncodeLevel = zapcore.CapitalColorLevelEncoder
+		return zapcore.NewConsoleEncoder(config)
+	}
+	return zapcore.NewJSONEncoder(config)
+}
+
+// newCore tees one core per sink behind the global level. Each sink core is
+// wrapped in its own redacting core, since a tee writes an entry to all its
+// cores once any of them is enabled for its level. The returned function
+// closes every sink.
+func newCore(sinks []sink, encoder zapcore.Encoder, global zap.AtomicLevel, redactor *Redactor) (zapcore.Core, func()) {
+	cores := make([]zapcore.Core, 0, len(sinks))
+	closers := make([]func(), 0, len(sinks))
+	for _, s := range sinks {
+		minimum := s.level
+		enabler := zap.LevelEnablerFunc(func(level zapcore.Level) bool {
+			return global.Enabled(level) && level >= minimum
+		})
+		writer, closer := s.writer()
+		core := zapcore.NewCore(encoder.Clone(), writer, enabler)
+		cores = append(cores, NewRedactingCore(core, redactor))
+		closers = append(closers, closer)
+	}
+	return zapcore.NewTee(cores...), func() {
+		for _, closer := range closers {
+			closer()
+		}
+	}
+}
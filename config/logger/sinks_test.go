@@ -0,0 +1,30 @@
+package logger
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"gopkg.in/natefinch/lumberjack.v2"
+)
+
+func TestRotateEvery_StopsWhenStopIsClosed(t *testing.T) {
+	file := &lumberjack.Logger{Filename: filepath.Join(t.TempDir(), "api.log")}
+	defer file.Close()
+
+	stop := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		rotateEvery(file, time.Millisecond, stop)
+		close(done)
+	}()
+
+	time.Sleep(10 * time.Millisecond)
+	close(stop)
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Errorf("rotateEvery: expected to return once stop is closed and is still running")
+	}
+}
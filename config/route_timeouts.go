@@ -0,0 +1,37 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ParseRouteTimeouts reads RouteTimeouts, a comma separated list of
+// "METHOD /route=duration" where route is the template the route was
+// registered with, such as "POST /api/v1/order/:id/attachments=30s". The
+// result is keyed by "METHOD /route". Every malformed entry is reported.
+func (s Server) ParseRouteTimeouts() (map[string]time.Duration, error) {
+	routes := map[string]time.Duration{}
+	errs := []error{}
+	for _, entry := range strings.Split(s.RouteTimeouts, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		route, value, found := strings.Cut(entry, "=")
+		fields := strings.Fields(route)
+		if !found || len(fields) != 2 || !strings.HasPrefix(fields[1], "/") {
+			errs = append(errs, fmt.Errorf("%q is not in the METHOD /route=duration format", entry))
+			continue
+		}
+		timeout, err := time.ParseDuration(strings.TrimSpace(value))
+		if err != nil || timeout <= 0 {
+			errs = append(errs, fmt.Errorf("%q does not have a positive duration", entry))
+			continue
+		}
+		routes[strings.ToUpper(fields[0])+" "+fields[1]] = timeout
+	}
+	return routes, errors.Join(errs...)
+}
@@ -0,0 +1,44 @@
+package config
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestParseRouteTimeouts(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected map[string]time.Duration
+		valid    bool
+	}{
+		{"", map[string]time.Duration{}, true},
+		{" , ", map[string]time.Duration{}, true},
+		{"POST /api/v1/order/:id/attachments=30s", map[string]time.Duration{"POST /api/v1/order/:id/attachments": 30 * time.Second}, true},
+		{"post /a=1s, GET /b = 2m", map[string]time.Duration{"POST /a": time.Second, "GET /b": 2 * time.Minute}, true},
+		{"POST /a", nil, false},
+		{"/a=1s", nil, false},
+		{"POST a=1s", nil, false},
+		{"POST /a=soon", nil, false},
+		{"POST /a=0s", nil, false},
+		{"POST /a=-1s", nil, false},
+		{"POST /a=1s,GET /b", nil, false},
+	}
+
+	for _, tt := range tests {
+		got, err := Server{RouteTimeouts: tt.value}.ParseRouteTimeouts()
+		if tt.valid && err != nil {
+			t.Errorf("ParseRouteTimeouts(%q): expected no error and received %v", tt.value, err)
+			continue
+		}
+		if !tt.valid {
+			if err == nil {
+				t.Errorf("ParseRouteTimeouts(%q): expected an error", tt.value)
+			}
+			continue
+		}
+		if !reflect.DeepEqual(got, tt.expected) {
+			t.Errorf("ParseRouteTimeouts(%q): expected %v and received %v", tt.value, tt.expected, got)
+		}
+	}
+}
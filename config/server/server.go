@@ -0,0 +1,131 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/felipeversiane/picpay-golang.git/config"
+	"github.com/felipeversiane/picpay-golang.git/config/logger"
+	"go.uber.org/zap"
+)
+
+type Server interface {
+	Run(ctx context.Context) error
+	ShutdownTimeout() time.Duration
+}
+
+type server struct {
+	httpServer      *http.Server
+	certFile        string
+	keyFile         string
+	shutdownTimeout time.Duration
+}
+
+// NewServer wraps the handler in an http.Server configured from cfg. TLS is
+// served when both the certificate and key files are set. Invalid values are
+// rejected instead of replaced by defaults.
+func NewServer(handler http.Handler, cfg config.Server) (Server, error) {
+	errs := []error{}
+	if cfg.Port <= 0 || cfg.Port > 65535 {
+		errs = append(errs, fmt.Errorf("%d is not a valid port", cfg.Port))
+	}
+	for _, timeout := range []struct {
+		name  string
+		value time.Duration
+	}{
+		{"read timeout", cfg.ReadTimeout},
+		{"read header timeout", cfg.ReadHeaderTimeout},
+		{"write timeout", cfg.WriteTimeout},
+		{"idle timeout", cfg.IdleTimeout},
+		{"shutdown timeout", cfg.ShutdownTimeout},
+	} {
+		if timeout.value <= 0 {
+			errs = append(errs, fmt.Errorf("%s must be greater than zero", timeout.name))
+		}
+	}
+
+	certFile := strings.TrimSpace(cfg.TLSCertFile)
+	keyFile := strings.TrimSpace(cfg.TLSKeyFile)
+	if (certFile == "") != (keyFile == "") {
+		errs = append(errs, errors.New("the TLS certificate and key files must be set together"))
+	}
+	for _, file := range []string{certFile, keyFile} {
+		if file == "" {
+			continue
+		}
+		if _, err := os.Stat(file); err != nil {
+			errs = append(errs, fmt.Errorf("TLS file: %w", err))
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	return &server{
+		httpServer: &http.Server{
+			Addr:              ":" + strconv.Itoa(cfg.Port),
+			Handler:           handler,
+			ReadTimeout:       cfg.ReadTimeout,
+			ReadHeaderTimeout: cfg.ReadHeaderTimeout,
+			WriteTimeout:      cfg.WriteTimeout,
+			IdleTimeout:       cfg.IdleTimeout,
+		},
+		certFile:        certFile,
+		keyFile:         keyFile,
+		shutdownTimeout: cfg.ShutdownTimeout,
+	}, nil
+}
+
+// Run serves until ctx is done, then stops accepting connections and waits
+// for in-flight requests to finish, up to the shutdown timeout.
+func (s *server) Run(ctx context.Context) error {
+	serveErr := make(chan error, 1)
+	go func() {
+		logger.Info("Server listening",
+			zap.String("addr", s.httpServer.Addr),
+			zap.Bool("tls", s.tlsEnabled()),
+			zap.String("journey", "Server"))
+
+		var err error
+		if s.tlsEnabled() {
+			err = s.httpServer.ListenAndServeTLS(s.certFile, s.keyFile)
+		} else {
+			err = s.httpServer.ListenAndServe()
+		}
+		serveErr <- err
+	}()
+
+	select {
+	case err := <-serveErr:
+		return err
+	case <-ctx.Done():
+	}
+
+	logger.Info("Shutting down server", zap.String("journey", "Server"))
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), s.shutdownTimeout)
+	defer cancel()
+
+	if err := s.httpServer.Shutdown(shutdownCtx); err != nil {
+		return err
+	}
+	if err := <-serveErr; !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+func (s *server) ShutdownTimeout() time.Duration {
+	return s.shutdownTimeout
+}
+
+func (s *server) tlsEnabled() bool {
+	return s.certFile != "" && s.keyFile != ""
+}
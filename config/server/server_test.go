@@ -0,0 +1,55 @@
+package server
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/felipeversiane/picpay-golang.git/config"
+)
+
+func TestNewServer(t *testing.T) {
+	dir := t.TempDir()
+	cert := filepath.Join(dir, "cert.pem")
+	key := filepath.Join(dir, "key.pem")
+	for _, file := range []string{cert, key} {
+		if err := os.WriteFile(file, []byte("test"), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	valid := config.Default().Server
+
+	tests := []struct {
+		name  string
+		edit  func(cfg *config.Server)
+		valid bool
+	}{
+		{"defaults", func(cfg *config.Server) {}, true},
+		{"tls", func(cfg *config.Server) { cfg.TLSCertFile, cfg.TLSKeyFile = cert, key }, true},
+		{"zero port", func(cfg *config.Server) { cfg.Port = 0 }, false},
+		{"port out of range", func(cfg *config.Server) { cfg.Port = 70000 }, false},
+		{"zero read timeout", func(cfg *config.Server) { cfg.ReadTimeout = 0 }, false},
+		{"negative shutdown timeout", func(cfg *config.Server) { cfg.ShutdownTimeout = -time.Second }, false},
+		{"certificate without key", func(cfg *config.Server) { cfg.TLSCertFile = cert }, false},
+		{"missing certificate", func(cfg *config.Server) { cfg.TLSCertFile, cfg.TLSKeyFile = filepath.Join(dir, "missing.pem"), key }, false},
+	}
+
+	for _, tt := range tests {
+		cfg := valid
+		tt.edit(&cfg)
+
+		srv, err := NewServer(http.NotFoundHandler(), cfg)
+		if tt.valid && err != nil {
+			t.Errorf("%s: expected no error and received %v", tt.name, err)
+		}
+		if !tt.valid && err == nil {
+			t.Errorf("%s: expected an error", tt.name)
+		}
+		if tt.valid && err == nil && srv.ShutdownTimeout() != cfg.ShutdownTimeout {
+			t.Errorf("%s: expected shutdown timeout %s and received %s", tt.name, cfg.ShutdownTimeout, srv.ShutdownTimeout())
+		}
+	}
+}
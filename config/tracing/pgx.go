@@ -0,0 +1,70 @@
+package tracing
+
+import (
+	"context"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// maxStatementLength bounds the SQL recorded on a span. Statements use
+// placeholders, so arguments are never recorded.
+const maxStatementLength = 1000
+
+type queryTracer struct{}
+
+// NewQueryTracer returns a pgx tracer that opens a client span for every
+// query and CopyFrom, as a child of the span in the query context.
+func NewQueryTracer() pgx.QueryTracer {
+	return queryTracer{}
+}
+
+func (queryTracer) TraceQueryStart(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryStartData) context.Context {
+	ctx, _ = Start(ctx, "db.query",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("db.system", "postgresql"),
+			attribute.String("db.statement", statement(data.SQL)),
+		))
+	return ctx
+}
+
+func (queryTracer) TraceQueryEnd(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryEndData) {
+	span := trace.SpanFromContext(ctx)
+	if data.Err != nil {
+		RecordError(span, data.Err)
+	} else {
+		span.SetAttributes(attribute.Int64("db.rows_affected", data.CommandTag.RowsAffected()))
+	}
+	span.End()
+}
+
+func (queryTracer) TraceCopyFromStart(ctx context.Context, _ *pgx.Conn, data pgx.TraceCopyFromStartData) context.Context {
+	ctx, _ = Start(ctx, "db.copy_from",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("db.system", "postgresql"),
+			attribute.String("db.sql.table", data.TableName.Sanitize()),
+		))
+	return ctx
+}
+
+func (queryTracer) TraceCopyFromEnd(ctx context.Context, _ *pgx.Conn, data pgx.TraceCopyFromEndData) {
+	span := trace.SpanFromContext(ctx)
+	if data.Err != nil {
+		RecordError(span, data.Err)
+	} else {
+		span.SetAttributes(attribute.Int64("db.rows_affected", data.CommandTag.RowsAffected()))
+	}
+	span.End()
+}
+
+func statement(sql string) string {
+	sql = strings.Join(strings.Fields(sql), " ")
+	if len(sql) > maxStatementLength {
+		return sql[:maxStatementLength]
+	}
+	return sql
+}
@@ -0,0 +1,124 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/felipeversiane/picpay-golang.git/config"
+	"github.com/felipeversiane/picpay-golang.git/config/logger"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+const (
+	ExporterNone   = "none"
+	ExporterOTLP   = "otlp"
+	ExporterStdout = "stdout"
+
+	defaultServiceName  = "picpay-golang"
+	instrumentationName = "github.com/felipeversiane/picpay-golang.git"
+)
+
+// Init installs the global tracer provider and the W3C trace context
+// propagator. cfg.Exporter picks where spans go: otlp sends them over HTTP
+// to cfg.OTLPEndpoint, stdout prints them, and none keeps tracing off while
+// still propagating incoming trace context. The returned function flushes
+// pending spans.
+func Init(ctx context.Context, cfg config.Tracing) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	exporterName := strings.ToLower(strings.TrimSpace(cfg.Exporter))
+	if exporterName == "" {
+		exporterName = ExporterNone
+	}
+
+	var exporter sdktrace.SpanExporter
+	var err error
+	switch exporterName {
+	case ExporterNone:
+		return func(context.Context) error { return nil }, nil
+	case ExporterOTLP:
+		var options []otlptracehttp.Option
+		options, err = otlpOptions(cfg.OTLPEndpoint)
+		if err == nil {
+			exporter, err = otlptracehttp.New(ctx, options...)
+		}
+	case ExporterStdout:
+		exporter, err = stdouttrace.New()
+	default:
+		return nil, fmt.Errorf("unknown trace exporter %q", exporterName)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	serviceName := strings.TrimSpace(cfg.ServiceName)
+	if serviceName == "" {
+		serviceName = defaultServiceName
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewSchemaless(
+			attribute.String("service.name", serviceName),
+		)),
+	)
+	otel.SetTracerProvider(provider)
+
+	logger.Info("Tracing initialized",
+		zap.String("exporter", exporterName),
+		zap.String("journey", "Tracing"))
+
+	return provider.Shutdown, nil
+}
+
+// otlpOptions points the exporter at endpoint, the base URL of the
+// collector, sending spans to its /v1/traces path as the OTLP specification
+// does for OTEL_EXPORTER_OTLP_ENDPOINT.
+func otlpOptions(endpoint string) ([]otlptracehttp.Option, error) {
+	parsed, err := url.Parse(strings.TrimSpace(endpoint))
+	if err != nil || parsed.Host == "" {
+		return nil, fmt.Errorf("invalid OTLP endpoint %q", endpoint)
+	}
+
+	options := []otlptracehttp.Option{
+		otlptracehttp.WithEndpoint(parsed.Host),
+		otlptracehttp.WithURLPath(strings.TrimSuffix(parsed.Path, "/") + "/v1/traces"),
+	}
+	if parsed.Scheme != "https" {
+		options = append(options, otlptracehttp.WithInsecure())
+	}
+	return options, nil
+}
+
+// Start opens a span named after the operation, such as
+// "OrderService.InsertOrder", as a child of the span in ctx.
+func Start(ctx context.Context, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	return otel.Tracer(instrumentationName).Start(ctx, name, opts...)
+}
+
+// InjectHeaders writes the trace context of the span in ctx into the
+// headers of an outgoing request, so the callee can join the trace.
+func InjectHeaders(ctx context.Context, header http.Header) {
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(header))
+}
+
+// RecordError marks the span as failed with err.
+func RecordError(span trace.Span, err error) {
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+}
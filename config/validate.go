@@ -0,0 +1,108 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type namedDuration struct {
+	env   string
+	value time.Duration
+}
+
+// Validate checks the required values and the format of every value and
+// reports all the problems at once, naming the environment variable of each.
+func (c Config) Validate() error {
+	errs := []error{}
+	check := func(ok bool, env string, format string, args ...interface{}) {
+		if !ok {
+			errs = append(errs, fmt.Errorf("%s: %s", env, fmt.Sprintf(format, args...)))
+		}
+	}
+
+	check(c.Server.Port > 0 && c.Server.Port <= 65535, "PORT", "%d is not a valid port", c.Server.Port)
+	check(oneOf(c.Server.GinMode, "debug", "release", "test"), "GIN_MODE", "must be debug, release or test")
+	for _, d := range []namedDuration{
+		{"SERVER_READ_TIMEOUT", c.Server.ReadTimeout},
+		{"SERVER_READ_HEADER_TIMEOUT", c.Server.ReadHeaderTimeout},
+		{"SERVER_WRITE_TIMEOUT", c.Server.WriteTimeout},
+		{"SERVER_IDLE_TIMEOUT", c.Server.IdleTimeout},
+		{"SERVER_SHUTDOWN_TIMEOUT", c.Server.ShutdownTimeout},
+		{"REQUEST_TIMEOUT", c.Server.RequestTimeout},
+	} {
+		check(d.value > 0, d.env, "must be greater than zero")
+	}
+	check(c.Server.ReadinessDelay >= 0, "SHUTDOWN_READINESS_DELAY", "must not be negative")
+	check((c.Server.TLSCertFile == "") == (c.Server.TLSKeyFile == ""), "TLS_CERT_FILE",
+		"TLS_CERT_FILE and TLS_KEY_FILE must be set together")
+	if _, err := c.Server.ParseRouteTimeouts(); err != nil {
+		check(false, "ROUTE_TIMEOUTS", "%v", err)
+	}
+
+	if c.Database.URL == "" {
+		check(false, "POSTGRES_URL", "is required")
+	} else if _, err := pgxpool.ParseConfig(c.Database.URL); err != nil {
+		check(false, "POSTGRES_URL", "is not a valid connection string")
+	}
+
+	if c.Authorizer.URL == "" {
+		check(false, "AUTHORIZATION_URL", "is required")
+	} else {
+		check(validHTTPURL(c.Authorizer.URL), "AUTHORIZATION_URL", "%q is not an http or https URL", c.Authorizer.URL)
+	}
+
+	check(oneOf(c.Log.Level, "debug", "info", "warn", "error"), "LOG_LEVEL", "must be debug, info, warn or error")
+	check(strings.TrimSpace(c.Log.Output) != "", "LOG_OUTPUT", "is required")
+	check(oneOf(c.Log.Encoding, "json", "console"), "LOG_ENCODING", "must be json or console")
+	check(c.Log.FileMaxSizeMB > 0, "LOG_FILE_MAX_SIZE_MB", "must be greater than zero")
+	check(c.Log.FileMaxBackups >= 0, "LOG_FILE_MAX_BACKUPS", "must not be negative")
+	check(c.Log.FileMaxAgeDays >= 0, "LOG_FILE_MAX_AGE_DAYS", "must not be negative")
+	check(c.Log.FileRotateInterval >= 0, "LOG_FILE_ROTATE_INTERVAL", "must not be negative")
+
+	check(oneOf(c.Tracing.Exporter, "none", "otlp", "stdout"), "OTEL_TRACES_EXPORTER", "must be none, otlp or stdout")
+	if c.Tracing.Exporter == "otlp" {
+		check(validHTTPURL(c.Tracing.OTLPEndpoint), "OTEL_EXPORTER_OTLP_ENDPOINT", "%q is not an http or https URL", c.Tracing.OTLPEndpoint)
+	}
+
+	if !oneOf(c.Server.GinMode, "debug", "test") {
+		check(strings.TrimSpace(c.Receipt.SecretKey) != "", "RECEIPT_SECRET_KEY", "is required outside development")
+	}
+
+	check(strings.TrimSpace(c.Storage.Path) != "", "STORAGE_PATH", "is required")
+	check(c.TransferLimit.NightStartHour >= 0 && c.TransferLimit.NightStartHour <= 23, "NIGHT_START_HOUR", "must be between 0 and 23")
+	check(c.TransferLimit.NightEndHour >= 0 && c.TransferLimit.NightEndHour <= 23, "NIGHT_END_HOUR", "must be between 0 and 23")
+	check(c.TransferLimit.NightStartHour != c.TransferLimit.NightEndHour, "NIGHT_END_HOUR", "must be different from NIGHT_START_HOUR")
+	check(c.Anticipation.MonthlyRate >= 0, "ANTICIPATION_MONTHLY_RATE", "must not be negative")
+	check(c.PixKey.ClaimWindow > 0, "PIX_KEY_CLAIM_WINDOW", "must be greater than zero")
+
+	for _, d := range []namedDuration{
+		{"RECURRENCE_WORKER_INTERVAL", c.Workers.RecurrenceInterval},
+		{"SETTLEMENT_WORKER_INTERVAL", c.Workers.SettlementInterval},
+		{"PAYMENT_REQUEST_WORKER_INTERVAL", c.Workers.PaymentRequestInterval},
+		{"INVOICE_WORKER_INTERVAL", c.Workers.InvoiceInterval},
+		{"PAYOUT_WORKER_INTERVAL", c.Workers.PayoutInterval},
+	} {
+		check(d.value > 0, d.env, "must be greater than zero")
+	}
+
+	return errors.Join(errs...)
+}
+
+func oneOf(value string, allowed ...string) bool {
+	for _, a := range allowed {
+		if strings.EqualFold(strings.TrimSpace(value), a) {
+			return true
+		}
+	}
+	return false
+}
+
+func validHTTPURL(value string) bool {
+	parsed, err := url.Parse(value)
+	return err == nil && (parsed.Scheme == "http" || parsed.Scheme == "https") && parsed.Host != ""
+}
@@ -0,0 +1,86 @@
+package validation
+
+import (
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+)
+
+const (
+	DocumentTypeCPF  = "cpf"
+	DocumentTypeCNPJ = "cnpj"
+)
+
+// NormalizeDocument strips the formatting characters accepted in CPF and
+// CNPJ numbers ("123.456.789-09", "11.222.333/0001-81").
+func NormalizeDocument(document string) string {
+	return strings.NewReplacer(".", "", "-", "", "/", "", " ", "").Replace(strings.TrimSpace(document))
+}
+
+// DocumentType returns DocumentTypeCPF or DocumentTypeCNPJ for a valid
+// document and an empty string otherwise.
+func DocumentType(document string) string {
+	digits := NormalizeDocument(document)
+	switch {
+	case IsCPF(digits):
+		return DocumentTypeCPF
+	case IsCNPJ(digits):
+		return DocumentTypeCNPJ
+	}
+	return ""
+}
+
+func IsCPF(document string) bool {
+	digits, ok := documentDigits(NormalizeDocument(document), 11)
+	if !ok {
+		return false
+	}
+	return checkDigit(digits[:9], []int{10, 9, 8, 7, 6, 5, 4, 3, 2}) == digits[9] &&
+		checkDigit(digits[:10], []int{11, 10, 9, 8, 7, 6, 5, 4, 3, 2}) == digits[10]
+}
+
+func IsCNPJ(document string) bool {
+	digits, ok := documentDigits(NormalizeDocument(document), 14)
+	if !ok {
+		return false
+	}
+	return checkDigit(digits[:12], []int{5, 4, 3, 2, 9, 8, 7, 6, 5, 4, 3, 2}) == digits[12] &&
+		checkDigit(digits[:13], []int{6, 5, 4, 3, 2, 9, 8, 7, 6, 5, 4, 3, 2}) == digits[13]
+}
+
+func validateDocument(fl validator.FieldLevel) bool {
+	return DocumentType(fl.Field().String()) != ""
+}
+
+// documentDigits rejects anything that is not exactly size digits, as well
+// as repeated sequences such as 111.111.111-11 that pass the checksum.
+func documentDigits(document string, size int) ([]int, bool) {
+	if len(document) != size {
+		return nil, false
+	}
+
+	digits := make([]int, size)
+	repeated := true
+	for i, r := range document {
+		if r < '0' || r > '9' {
+			return nil, false
+		}
+		digits[i] = int(r - '0')
+		if digits[i] != digits[0] {
+			repeated = false
+		}
+	}
+
+	return digits, !repeated
+}
+
+func checkDigit(digits []int, weights []int) int {
+	sum := 0
+	for i, d := range digits {
+		sum += d * weights[i]
+	}
+	if rest := sum % 11; rest >= 2 {
+		return 11 - rest
+	}
+	return 0
+}
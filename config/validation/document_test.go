@@ -0,0 +1,76 @@
+package validation
+
+import "testing"
+
+func TestIsCPF(t *testing.T) {
+	tests := []struct {
+		document string
+		expected bool
+	}{
+		{"529.982.247-25", true},
+		{"52998224725", true},
+		{" 529.982.247-25 ", true},
+		{"123.456.789-09", true},
+		{"529.982.247-24", false},
+		{"529.982.247-15", false},
+		{"111.111.111-11", false},
+		{"000.000.000-00", false},
+		{"5299822472", false},
+		{"529982247251", false},
+		{"529.982.247-2a", false},
+		{"", false},
+	}
+
+	for _, tt := range tests {
+		if got := IsCPF(tt.document); got != tt.expected {
+			t.Errorf("IsCPF(%q): expected %v and received %v", tt.document, tt.expected, got)
+		}
+	}
+}
+
+func TestIsCNPJ(t *testing.T) {
+	tests := []struct {
+		document string
+		expected bool
+	}{
+		{"11.222.333/0001-81", true},
+		{"11222333000181", true},
+		{"12.345.678/0001-95", true},
+		{"11.222.333/0001-80", false},
+		{"11.222.333/0001-71", false},
+		{"00.000.000/0000-00", false},
+		{"1122233300018", false},
+		{"11.222.333/0001-8x", false},
+		{"", false},
+	}
+
+	for _, tt := range tests {
+		if got := IsCNPJ(tt.document); got != tt.expected {
+			t.Errorf("IsCNPJ(%q): expected %v and received %v", tt.document, tt.expected, got)
+		}
+	}
+}
+
+func TestDocumentType(t *testing.T) {
+	tests := []struct {
+		document string
+		expected string
+	}{
+		{"529.982.247-25", DocumentTypeCPF},
+		{"11.222.333/0001-81", DocumentTypeCNPJ},
+		{"529.982.247-24", ""},
+		{"not a document", ""},
+	}
+
+	for _, tt := range tests {
+		if got := DocumentType(tt.document); got != tt.expected {
+			t.Errorf("DocumentType(%q): expected %q and received %q", tt.document, tt.expected, got)
+		}
+	}
+}
+
+func TestNormalizeDocument(t *testing.T) {
+	if got := NormalizeDocument(" 11.222.333/0001-81 "); got != "11222333000181" {
+		t.Errorf("Expected 11222333000181 and received %q", got)
+	}
+}
@@ -0,0 +1,47 @@
+package validation
+
+import "strings"
+
+// MaskName keeps the first name and the initial of the last name, which is
+// what is shown of a counterpart in lookups and receipts.
+func MaskName(firstName string, lastName string) string {
+	lastName = strings.TrimSpace(lastName)
+	if lastName == "" {
+		return strings.TrimSpace(firstName)
+	}
+	return strings.TrimSpace(firstName) + " " + string([]rune(lastName)[0]) + "."
+}
+
+// MaskDocument hides the first and last digits of a CPF (***.456.789-**)
+// and the branch and check digits of a CNPJ (12.345.678/****-**).
+func MaskDocument(document string) string {
+	digits := NormalizeDocument(document)
+	switch len(digits) {
+	case 11:
+		return "***." + digits[3:6] + "." + digits[6:9] + "-**"
+	case 14:
+		return digits[0:2] + "." + digits[2:5] + "." + digits[5:8] + "/****-**"
+	default:
+		return strings.Repeat("*", len(digits))
+	}
+}
+
+// MaskContact keeps the first letter and the domain of an email address
+// (p***@example.com) and the last four digits of a phone number, so a
+// recipient can be told apart in logs without being exposed.
+func MaskContact(contact string) string {
+	contact = strings.TrimSpace(contact)
+	if at := strings.LastIndex(contact, "@"); at >= 0 {
+		local := []rune(contact[:at])
+		if len(local) == 0 {
+			return "***" + contact[at:]
+		}
+		return string(local[0]) + "***" + contact[at:]
+	}
+
+	runes := []rune(contact)
+	if len(runes) <= 4 {
+		return strings.Repeat("*", len(runes))
+	}
+	return strings.Repeat("*", len(runes)-4) + string(runes[len(runes)-4:])
+}
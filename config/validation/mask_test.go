@@ -0,0 +1,63 @@
+package validation
+
+import "testing"
+
+func TestMaskName(t *testing.T) {
+	tests := []struct {
+		firstName string
+		lastName  string
+		expected  string
+	}{
+		{"Pedro", "Silva", "Pedro S."},
+		{" Pedro ", " Silva ", "Pedro S."},
+		{"Ana", "Ávila", "Ana Á."},
+		{"Loja", "", "Loja"},
+		{"Loja", "   ", "Loja"},
+	}
+
+	for _, tt := range tests {
+		if got := MaskName(tt.firstName, tt.lastName); got != tt.expected {
+			t.Errorf("MaskName(%q, %q): expected %q and received %q", tt.firstName, tt.lastName, tt.expected, got)
+		}
+	}
+}
+
+func TestMaskDocument(t *testing.T) {
+	tests := []struct {
+		document string
+		expected string
+	}{
+		{"52998224725", "***.982.247-**"},
+		{"529.982.247-25", "***.982.247-**"},
+		{"11222333000181", "11.222.333/****-**"},
+		{"11.222.333/0001-81", "11.222.333/****-**"},
+		{"12345", "*****"},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		if got := MaskDocument(tt.document); got != tt.expected {
+			t.Errorf("MaskDocument(%q): expected %q and received %q", tt.document, tt.expected, got)
+		}
+	}
+}
+
+func TestMaskContact(t *testing.T) {
+	tests := []struct {
+		contact  string
+		expected string
+	}{
+		{"pedro@example.com", "p***@example.com"},
+		{" Ávila@example.com ", "Á***@example.com"},
+		{"@example.com", "***@example.com"},
+		{"+5511987654321", "**********4321"},
+		{"1234", "****"},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		if got := MaskContact(tt.contact); got != tt.expected {
+			t.Errorf("MaskContact(%q): expected %q and received %q", tt.contact, tt.expected, got)
+		}
+	}
+}
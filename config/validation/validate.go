@@ -23,6 +23,17 @@ func init() {
 		unt := ut.New(en, en)
 		transl, _ = unt.GetTranslator("en")
 		en_translation.RegisterDefaultTranslations(val, transl)
+
+		val.RegisterValidation("document", validateDocument)
+		val.RegisterTranslation("document", transl,
+			func(ut ut.Translator) error {
+				return ut.Add("document", "{0} must be a valid CPF or CNPJ", true)
+			},
+			func(ut ut.Translator, fe validator.FieldError) string {
+				t, _ := ut.T("document", fe.Field())
+				return t
+			},
+		)
 	}
 }
 
@@ -23,9 +23,9 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/order": {
+        "/bill_split": {
             "post": {
-                "description": "Insert a new order with the provided order information",
+                "description": "Creates a payment request from the owner to every participant. Equal splits divide the total among the participants and the owner; custom splits use the amount of each participant and the owner covers the rest. The split is settled when every share is paid.",
                 "consumes": [
                     "application/json"
                 ],
@@ -33,25 +33,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Orders"
+                    "Bill Splits"
                 ],
-                "summary": "Insert a new order",
+                "summary": "Insert a new bill split",
                 "parameters": [
                     {
-                        "description": "Order information for registration",
-                        "name": "orderRequest",
+                        "description": "Bill split information",
+                        "name": "billSplitRequest",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.OrderRequest"
+                            "$ref": "#/definitions/request.BillSplitRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/response.OrderResponse"
+                            "$ref": "#/definitions/response.BillSplitResponse"
                         }
                     },
                     "400": {
@@ -69,9 +69,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/order/{id}": {
+        "/bill_split/{id}": {
             "get": {
-                "description": "Retrieves order details based on the order ID provided as a parameter.",
+                "description": "Retrieves the bill split, the payment request of every share and how much was already paid.",
                 "consumes": [
                     "application/json"
                 ],
@@ -79,13 +79,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Orders"
+                    "Bill Splits"
                 ],
-                "summary": "Find Order by ID",
+                "summary": "Find bill split by ID",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "ID of the order to be retrieved",
+                        "description": "ID of the bill split",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -93,19 +93,19 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "User information retrieved successfully",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/response.OrderResponse"
+                            "$ref": "#/definitions/response.BillSplitResponse"
                         }
                     },
                     "400": {
-                        "description": "Error: Invalid order ID",
+                        "description": "Error: Invalid bill split ID",
                         "schema": {
                             "$ref": "#/definitions/http_error.HttpError"
                         }
                     },
                     "404": {
-                        "description": "User not found",
+                        "description": "Bill split not found",
                         "schema": {
                             "$ref": "#/definitions/http_error.HttpError"
                         }
@@ -113,9 +113,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/user": {
+        "/bill_split/{id}/remind": {
             "post": {
-                "description": "Insert a new user with the provided user information",
+                "description": "Sends a reminder to every participant whose share is still pending.",
                 "consumes": [
                     "application/json"
                 ],
@@ -123,25 +123,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
+                    "Bill Splits"
                 ],
-                "summary": "Insert a new user",
+                "summary": "Remind bill split participants",
                 "parameters": [
                     {
-                        "description": "User information for registration",
-                        "name": "userRequest",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/request.UserRequest"
-                        }
+                        "type": "string",
+                        "description": "ID of the bill split",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/response.UserResponse"
+                            "$ref": "#/definitions/response.BillSplitReminderResponse"
                         }
                     },
                     "400": {
@@ -150,6 +148,38 @@ const docTemplate = `{
                             "$ref": "#/definitions/http_error.HttpError"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/fee_plan": {
+            "get": {
+                "description": "Retrieves every registered fee plan.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Fee Plans"
+                ],
+                "summary": "List Fee Plans",
+                "responses": {
+                    "200": {
+                        "description": "Fee plans retrieved successfully",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/response.FeePlanResponse"
+                            }
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -159,9 +189,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/user/find_user_by_document/{document}": {
+        "/fee_plan/{id}": {
             "get": {
-                "description": "Retrieves user details based on the user document provided as a parameter.",
+                "description": "Retrieves fee plan details based on the fee plan ID provided as a parameter.",
                 "consumes": [
                     "application/json"
                 ],
@@ -169,27 +199,33 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
+                    "Fee Plans"
                 ],
-                "summary": "Find User by Document",
+                "summary": "Find Fee Plan by ID",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Document of the user to be retrieved",
-                        "name": "document",
+                        "description": "ID of the fee plan to be retrieved",
+                        "name": "id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "User information retrieved successfully",
+                        "description": "Fee plan information retrieved successfully",
                         "schema": {
-                            "$ref": "#/definitions/response.UserResponse"
+                            "$ref": "#/definitions/response.FeePlanResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Error: Invalid fee plan ID",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
                         }
                     },
                     "404": {
-                        "description": "User not found",
+                        "description": "Fee plan not found",
                         "schema": {
                             "$ref": "#/definitions/http_error.HttpError"
                         }
@@ -197,9 +233,55 @@ const docTemplate = `{
                 }
             }
         },
-        "/user/find_user_by_email/{email}": {
+        "/invoice": {
+            "post": {
+                "description": "Issues an invoice whose amount is the sum of its items. After the due date the late_fee (percentage, charged once) and the daily_interest (percentage per day late) are added to the amount due.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Invoices"
+                ],
+                "summary": "Insert a new invoice",
+                "parameters": [
+                    {
+                        "description": "Invoice information",
+                        "name": "invoiceRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.InvoiceRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/response.InvoiceResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/invoice/{id}": {
             "get": {
-                "description": "Retrieves user details based on the user email provided as a parameter.",
+                "description": "Retrieves the invoice with its items and the amount due right now.",
                 "consumes": [
                     "application/json"
                 ],
@@ -207,27 +289,33 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
+                    "Invoices"
                 ],
-                "summary": "Find User by Email",
+                "summary": "Find invoice by ID",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Email of the user to be retrieved",
-                        "name": "email",
+                        "description": "ID of the invoice",
+                        "name": "id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "User information retrieved successfully",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/response.UserResponse"
+                            "$ref": "#/definitions/response.InvoiceResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Error: Invalid invoice ID",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
                         }
                     },
                     "404": {
-                        "description": "User not found",
+                        "description": "Invoice not found",
                         "schema": {
                             "$ref": "#/definitions/http_error.HttpError"
                         }
@@ -235,9 +323,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/user/{id}": {
-            "get": {
-                "description": "Retrieves user details based on the user ID provided as a parameter.",
+        "/invoice/{id}/cancel": {
+            "put": {
+                "description": "Cancels the invoice so it can no longer be paid.",
                 "consumes": [
                     "application/json"
                 ],
@@ -245,13 +333,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
+                    "Invoices"
                 ],
-                "summary": "Find User by ID",
+                "summary": "Cancel invoice",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "ID of the user to be retrieved",
+                        "description": "ID of the invoice",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -259,27 +347,29 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "User information retrieved successfully",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/response.UserResponse"
+                            "$ref": "#/definitions/response.InvoiceResponse"
                         }
                     },
                     "400": {
-                        "description": "Error: Invalid user ID",
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/http_error.HttpError"
                         }
                     },
                     "404": {
-                        "description": "User not found",
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/http_error.HttpError"
                         }
                     }
                 }
-            },
+            }
+        },
+        "/invoice/{id}/pay": {
             "put": {
-                "description": "Updates user details based on the ID provided as a parameter.",
+                "description": "Transfers the amount due, including late fee and interest, from the customer to the merchant through the regular order flow.",
                 "consumes": [
                     "application/json"
                 ],
@@ -287,30 +377,76 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
+                    "Invoices"
                 ],
-                "summary": "Update User",
+                "summary": "Pay invoice",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "ID of the user to be updated",
+                        "description": "ID of the invoice",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.InvoiceResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
                     },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/order": {
+            "post": {
+                "description": "Insert a new order with the provided order information. The payee is identified either by its UUID (payee) or by one of its keys (payee_key). The optional message, up to 140 characters, is shown to both parties; profanity is masked and messages with links are refused.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Orders"
+                ],
+                "summary": "Insert a new order",
+                "parameters": [
                     {
-                        "description": "User information for update",
-                        "name": "userRequest",
+                        "description": "Order information for registration",
+                        "name": "orderRequest",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.UserUpdateRequest"
+                            "$ref": "#/definitions/request.OrderRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK"
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.OrderResponse"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -325,9 +461,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Deletes a user based on the ID provided as a parameter.",
+            }
+        },
+        "/order/qr": {
+            "post": {
+                "description": "Parses and validates the EMV payload (checksum, issuer, status and expiration) and transfers the amount to the merchant. The amount is required only for codes without a fixed amount.",
                 "consumes": [
                     "application/json"
                 ],
@@ -335,21 +473,26 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
+                    "Orders"
                 ],
-                "summary": "Delete User",
+                "summary": "Insert a new order from a QR code",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "ID of the user to be deleted",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Payer and scanned payload",
+                        "name": "qrCodeOrderRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.QRCodeOrderRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK"
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/response.OrderResponse"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -357,6 +500,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/http_error.HttpError"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -365,128 +520,3482 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "http_error.Causes": {
-            "type": "object",
-            "properties": {
-                "field": {
-                    "type": "string"
-                },
-                "message": {
-                    "type": "string"
-                }
-            }
         },
-        "http_error.HttpError": {
-            "type": "object",
-            "properties": {
-                "causes": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/http_error.Causes"
-                    }
-                },
-                "code": {
-                    "type": "integer"
-                },
-                "error": {
-                    "type": "string"
-                },
-                "message": {
-                    "type": "string"
-                }
+        "/order/{id}": {
+            "get": {
+                "description": "Retrieves order details based on the order ID provided as a parameter.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Orders"
+                ],
+                "summary": "Find Order by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID of the order to be retrieved",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "User information retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/response.OrderResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Error: Invalid order ID",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/order/{id}/attachments": {
+            "get": {
+                "description": "Lists the receipts attached to the order.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Orders"
+                ],
+                "summary": "Find order attachments",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID of the order",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID of the payer or payee",
+                        "name": "user_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/response.OrderAttachmentResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Error: Invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    },
+                    "403": {
+                        "description": "User is not the payer or the payee",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Order not found",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Uploads a PDF, JPEG or PNG file of up to 5 MB as a receipt of the order. Only the payer or the payee can upload, and an order holds up to 5 attachments.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Orders"
+                ],
+                "summary": "Insert an order attachment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID of the order",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID of the payer or payee uploading the file",
+                        "name": "user_id",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "Receipt file",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/response.OrderAttachmentResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/order/{id}/attachments/{attachment_id}": {
+            "get": {
+                "description": "Downloads the file of a receipt attached to the order.",
+                "produces": [
+                    "application/octet-stream"
+                ],
+                "tags": [
+                    "Orders"
+                ],
+                "summary": "Download order attachment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID of the order",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID of the attachment",
+                        "name": "attachment_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID of the payer or payee",
+                        "name": "user_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Error: Invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    },
+                    "403": {
+                        "description": "User is not the payer or the payee",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Attachment not found",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/order/{id}/receipt": {
+            "get": {
+                "description": "Issues the receipt of the order with masked payer and payee data and an authentication code that can be checked at /receipts/verify. Returns JSON by default or a PDF file with format=pdf.",
+                "produces": [
+                    "application/json",
+                    "application/pdf"
+                ],
+                "tags": [
+                    "Receipts"
+                ],
+                "summary": "Find order receipt",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID of the order",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "json (default) or pdf",
+                        "name": "format",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.ReceiptResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Error: Invalid order ID or format",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Order not found",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/payment_link": {
+            "post": {
+                "description": "Creates a link identified by a public slug. Without an amount the payer chooses how much to pay. Single-use links can be paid once; links with expires_in (seconds) stop accepting payments after it.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payment Links"
+                ],
+                "summary": "Insert a new payment link",
+                "parameters": [
+                    {
+                        "description": "Payment link information",
+                        "name": "paymentLinkRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.PaymentLinkRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/response.PaymentLinkResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/payment_link/public/{slug}": {
+            "get": {
+                "description": "Returns the merchant name, description, amount and status of the link so the payer can review it before paying.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payment Links"
+                ],
+                "summary": "Resolve payment link",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Public slug of the payment link",
+                        "name": "slug",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.PaymentLinkPublicResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Payment link not found",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/payment_link/public/{slug}/pay": {
+            "post": {
+                "description": "Creates an order from the payer to the merchant of the link and records it against the link. The amount is required only for links without a fixed amount.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payment Links"
+                ],
+                "summary": "Pay payment link",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Public slug of the payment link",
+                        "name": "slug",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Payer and amount",
+                        "name": "paymentLinkOrderRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.PaymentLinkOrderRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/response.OrderResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/payment_link/{id}": {
+            "get": {
+                "description": "Retrieves the payment link with its slug and status.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payment Links"
+                ],
+                "summary": "Find payment link by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID of the payment link",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.PaymentLinkResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Error: Invalid payment link ID",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Payment link not found",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/payment_link/{id}/payments": {
+            "get": {
+                "description": "Lists the orders paid through the payment link, most recent first.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payment Links"
+                ],
+                "summary": "Find payment link payments",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID of the payment link",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/response.PaymentLinkPaymentResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Error: Invalid payment link ID",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Payment link not found",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/payment_request": {
+            "post": {
+                "description": "Creates a pending request from the requester to the payer. It expires after expires_in seconds (7 days by default).",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payment Requests"
+                ],
+                "summary": "Insert a new payment request",
+                "parameters": [
+                    {
+                        "description": "Payment request information",
+                        "name": "paymentRequestRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.PaymentRequestRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/response.PaymentRequestResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/payment_request/{id}": {
+            "get": {
+                "description": "Retrieves the payment request with its status and, once accepted, the order that paid it.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payment Requests"
+                ],
+                "summary": "Find payment request by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID of the payment request",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.PaymentRequestResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Error: Invalid payment request ID",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Payment request not found",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/payment_request/{id}/accept": {
+            "put": {
+                "description": "Transfers the amount from the payer to the requester through the regular order flow.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payment Requests"
+                ],
+                "summary": "Accept payment request",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID of the payment request",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.PaymentRequestResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/payment_request/{id}/decline": {
+            "put": {
+                "description": "Declines the payment request without transferring any money.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payment Requests"
+                ],
+                "summary": "Decline payment request",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID of the payment request",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.PaymentRequestResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/payouts/batch": {
+            "post": {
+                "description": "Validates every item up front and queues the batch, which is processed in the background. The body is either JSON or a text/csv file with a \"payee,amount\" header, in which case payer_id and mode are read from the query string. In partial mode each item is paid on its own; in all_or_nothing mode either every item is paid or none is.",
+                "consumes": [
+                    "application/json",
+                    "text/csv"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payouts"
+                ],
+                "summary": "Insert a new payout batch",
+                "parameters": [
+                    {
+                        "description": "Payout batch information",
+                        "name": "payoutBatchRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.PayoutBatchRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID of the merchant, for CSV bodies",
+                        "name": "payer_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "partial or all_or_nothing, for CSV bodies",
+                        "name": "mode",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/response.PayoutBatchResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/payouts/batch/{id}": {
+            "get": {
+                "description": "Retrieves the status of the batch and, once processed, how many items succeeded and failed.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payouts"
+                ],
+                "summary": "Find payout batch by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID of the payout batch",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.PayoutBatchResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Error: Invalid payout batch ID",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Payout batch not found",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/payouts/batch/{id}/result": {
+            "get": {
+                "description": "Downloads a CSV file with the status and failure message of every item of a processed batch.",
+                "produces": [
+                    "text/csv"
+                ],
+                "tags": [
+                    "Payouts"
+                ],
+                "summary": "Download payout batch result",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID of the payout batch",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Error: Invalid payout batch ID or batch still being processed",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Payout batch not found",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/pix_key": {
+            "post": {
+                "description": "Registers an email, phone, document or random (evp) key. Document keys must match the user document and evp keys are generated, both are active immediately. Email and phone keys stay pending until the code sent to them is verified.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Keys"
+                ],
+                "summary": "Insert a new key",
+                "parameters": [
+                    {
+                        "description": "Key information for registration",
+                        "name": "pixKeyRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.PixKeyRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/response.PixKeyResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/pix_key/claim": {
+            "post": {
+                "description": "Opens a claim to move an email or phone key to the claimer. A code is sent to the key to prove ownership and the current owner is notified so they can cancel the claim.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Keys"
+                ],
+                "summary": "Claim key",
+                "parameters": [
+                    {
+                        "description": "Claim information",
+                        "name": "claimRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.PixKeyClaimRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/response.PixKeyClaimResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/pix_key/claim/{id}/cancel": {
+            "put": {
+                "description": "Cancels an open claim, keeping the key with its current owner. Only the current owner of the key and the claimer can cancel it.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Keys"
+                ],
+                "summary": "Cancel key claim",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID of the claim",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID of the owner of the key or of the claimer",
+                        "name": "user_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.PixKeyClaimResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/pix_key/claim/{id}/confirm": {
+            "put": {
+                "description": "Moves the key to the claimer with the code sent to the key.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Keys"
+                ],
+                "summary": "Confirm key claim",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID of the claim",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Verification code",
+                        "name": "verificationRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.PixKeyVerificationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.PixKeyClaimResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/pix_key/lookup/{key}": {
+            "get": {
+                "description": "Retrieves the owner of an active key so the payer can confirm it before paying. The owner name and CPF are masked.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Keys"
+                ],
+                "summary": "Lookup key",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Email, phone, CPF/CNPJ or random key",
+                        "name": "key",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.PixKeyLookupResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Key not found",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/pix_key/{id}": {
+            "delete": {
+                "description": "Removes the key from the directory, making it available for registration again.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Keys"
+                ],
+                "summary": "Delete key",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID of the key to be deleted",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/pix_key/{id}/verify": {
+            "put": {
+                "description": "Activates a pending email or phone key with the code sent to it.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Keys"
+                ],
+                "summary": "Verify key",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID of the key",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Verification code",
+                        "name": "verificationRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.PixKeyVerificationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.PixKeyResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/qr_code": {
+            "post": {
+                "description": "Generates an EMV (BR Code) payload for the merchant. Static codes are reusable and may have an amount; dynamic codes have a fixed amount, can be paid once and expire after expires_in seconds (default 3600).",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "QR Codes"
+                ],
+                "summary": "Insert a new QR code",
+                "parameters": [
+                    {
+                        "description": "QR code information",
+                        "name": "qrCodeRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.QRCodeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/response.QRCodeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/qr_code/{id}": {
+            "get": {
+                "description": "Retrieves the QR code with its payload and status.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "QR Codes"
+                ],
+                "summary": "Find QR code by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID of the QR code",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.QRCodeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Error: Invalid QR code ID",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "QR code not found",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/qr_code/{id}/image": {
+            "get": {
+                "description": "Renders the payload of the QR code as a 256x256 PNG image.",
+                "produces": [
+                    "image/png"
+                ],
+                "tags": [
+                    "QR Codes"
+                ],
+                "summary": "Find QR code image",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID of the QR code",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Error: Invalid QR code ID",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "QR code not found",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/receipts/verify": {
+            "post": {
+                "description": "Checks that the authentication code was issued for the order. A valid code returns the receipt so it can be compared with the one presented.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Receipts"
+                ],
+                "summary": "Verify receipt",
+                "parameters": [
+                    {
+                        "description": "Order ID and authentication code",
+                        "name": "receiptVerificationRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.ReceiptVerificationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.ReceiptVerificationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/recurrence": {
+            "post": {
+                "description": "Creates a standing order that generates a regular order on every occurrence of the cron schedule (evaluated in UTC)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Recurrences"
+                ],
+                "summary": "Insert a new recurrence",
+                "parameters": [
+                    {
+                        "description": "Recurrence information for registration",
+                        "name": "recurrenceRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.RecurrenceRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/response.RecurrenceResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/recurrence/{id}": {
+            "get": {
+                "description": "Retrieves recurrence details based on the recurrence ID provided as a parameter.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Recurrences"
+                ],
+                "summary": "Find Recurrence by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID of the recurrence to be retrieved",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Recurrence information retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/response.RecurrenceResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Error: Invalid recurrence ID",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Recurrence not found",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Cancels the recurrence. Its execution history is kept.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Recurrences"
+                ],
+                "summary": "Cancel Recurrence",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID of the recurrence to be cancelled",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.RecurrenceResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/recurrence/{id}/executions": {
+            "get": {
+                "description": "Retrieves every execution of the recurrence, most recent first.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Recurrences"
+                ],
+                "summary": "Find Recurrence executions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID of the recurrence",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Executions retrieved successfully",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/response.RecurrenceExecutionResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Error: Invalid recurrence ID",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Recurrence not found",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/recurrence/{id}/pause": {
+            "put": {
+                "description": "Stops generating orders for the recurrence until it is resumed.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Recurrences"
+                ],
+                "summary": "Pause Recurrence",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID of the recurrence to be paused",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.RecurrenceResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/recurrence/{id}/resume": {
+            "put": {
+                "description": "Schedules the next occurrence of a paused recurrence from now on.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Recurrences"
+                ],
+                "summary": "Resume Recurrence",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID of the recurrence to be resumed",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.RecurrenceResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/user": {
+            "post": {
+                "description": "Insert a new user with the provided user information",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Insert a new user",
+                "parameters": [
+                    {
+                        "description": "User information for registration",
+                        "name": "userRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.UserRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.UserResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/find_user_by_document/{document}": {
+            "get": {
+                "description": "Retrieves user details based on the user document provided as a parameter.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Find User by Document",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Document of the user to be retrieved",
+                        "name": "document",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "User information retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/response.UserResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/find_user_by_email/{email}": {
+            "get": {
+                "description": "Retrieves user details based on the user email provided as a parameter.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Find User by Email",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Email of the user to be retrieved",
+                        "name": "email",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "User information retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/response.UserResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/{id}": {
+            "get": {
+                "description": "Retrieves user details based on the user ID provided as a parameter.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Find User by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID of the user to be retrieved",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "User information retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/response.UserResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Error: Invalid user ID",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Updates user details based on the ID provided as a parameter.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Update User",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID of the user to be updated",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "User information for update",
+                        "name": "userRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.UserUpdateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Deletes a user based on the ID provided as a parameter.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Delete User",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID of the user to be deleted",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/{id}/anticipation": {
+            "post": {
+                "description": "Atomically moves the selected pending settlements to the available balance, minus the anticipation fee that is credited to the platform revenue account.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Anticipations"
+                ],
+                "summary": "Accept Anticipation",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID of the merchant",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Settlements to anticipate",
+                        "name": "anticipationRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.AnticipationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/response.AnticipationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/{id}/anticipation/quote": {
+            "post": {
+                "description": "Calculates the fee for receiving the selected pending settlements today. Each settlement is discounted pro rata by the days left until its scheduled date at ANTICIPATION_MONTHLY_RATE percent per 30 days. Nothing is changed.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Anticipations"
+                ],
+                "summary": "Quote Anticipation",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID of the merchant",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Settlements to anticipate",
+                        "name": "anticipationRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.AnticipationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.AnticipationQuoteResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/{id}/invoices": {
+            "get": {
+                "description": "Lists the invoices issued or received by the user.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Invoices"
+                ],
+                "summary": "Find user invoices",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID of the user",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/response.InvoiceResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Error: Invalid user ID",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/{id}/limits": {
+            "get": {
+                "description": "Retrieves the limits of the user tier together with the current usage and the remaining allowance.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Find User transfer limits",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID of the user",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Transfer limits retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/response.TransferLimitsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Error: Invalid user ID",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/{id}/payment_requests": {
+            "get": {
+                "description": "Lists the pending payment requests sent or received by the user, most recent first.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payment Requests"
+                ],
+                "summary": "Find pending payment requests",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID of the user",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/response.PaymentRequestResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Error: Invalid user ID",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/{id}/pix_keys": {
+            "get": {
+                "description": "Retrieves every key registered by the user, active or pending.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Find User keys",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID of the user",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Keys retrieved successfully",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/response.PixKeyResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Error: Invalid user ID",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/{id}/settlements": {
+            "get": {
+                "description": "Retrieves every received amount that is still pending, ordered by the business day it will be released.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Find User pending settlements",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID of the merchant",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Pending settlements retrieved successfully",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/response.SettlementResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Error: Invalid user ID",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/{id}/settlements/schedule": {
+            "get": {
+                "description": "Retrieves the pending amount that will become available on each upcoming business day.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Find User settlement schedule",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID of the merchant",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Settlement schedule retrieved successfully",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/response.SettlementScheduleResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Error: Invalid user ID",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/http_error.HttpError"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "http_error.Causes": {
+            "type": "object",
+            "properties": {
+                "field": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "http_error.HttpError": {
+            "type": "object",
+            "properties": {
+                "causes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/http_error.Causes"
+                    }
+                },
+                "code": {
+                    "type": "integer"
+                },
+                "details": {
+                    "type": "object",
+                    "additionalProperties": true
+                },
+                "error": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "request.AnticipationRequest": {
+            "type": "object",
+            "required": [
+                "settlement_ids"
+            ],
+            "properties": {
+                "settlement_ids": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "request.BillSplitParticipantRequest": {
+            "type": "object",
+            "required": [
+                "user_id"
+            ],
+            "properties": {
+                "amount": {
+                    "type": "number",
+                    "minimum": 0
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "request.BillSplitRequest": {
+            "type": "object",
+            "required": [
+                "description",
+                "mode",
+                "owner",
+                "participants",
+                "total_amount"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "maxLength": 140
+                },
+                "expires_in": {
+                    "type": "integer",
+                    "maximum": 2592000,
+                    "minimum": 0
+                },
+                "mode": {
+                    "type": "string",
+                    "enum": [
+                        "equal",
+                        "custom"
+                    ]
+                },
+                "owner": {
+                    "type": "string"
+                },
+                "participants": {
+                    "type": "array",
+                    "maxItems": 20,
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/request.BillSplitParticipantRequest"
+                    }
+                },
+                "total_amount": {
+                    "type": "number",
+                    "minimum": 0.01
+                }
+            }
+        },
+        "request.InvoiceItemRequest": {
+            "type": "object",
+            "required": [
+                "description",
+                "quantity",
+                "unit_price"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "maxLength": 140
+                },
+                "quantity": {
+                    "type": "integer",
+                    "maximum": 10000,
+                    "minimum": 1
+                },
+                "unit_price": {
+                    "type": "number",
+                    "minimum": 0.01
+                }
+            }
+        },
+        "request.InvoiceRequest": {
+            "type": "object",
+            "required": [
+                "customer_id",
+                "due_date",
+                "items",
+                "merchant_id"
+            ],
+            "properties": {
+                "customer_id": {
+                    "type": "string"
+                },
+                "daily_interest": {
+                    "type": "number",
+                    "maximum": 1,
+                    "minimum": 0
+                },
+                "due_date": {
+                    "type": "string"
+                },
+                "items": {
+                    "type": "array",
+                    "maxItems": 50,
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/request.InvoiceItemRequest"
+                    }
+                },
+                "late_fee": {
+                    "type": "number",
+                    "maximum": 20,
+                    "minimum": 0
+                },
+                "merchant_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "request.OrderRequest": {
+            "type": "object",
+            "required": [
+                "amount",
+                "payer"
+            ],
+            "properties": {
+                "amount": {
+                    "type": "number",
+                    "minimum": 0.01
+                },
+                "message": {
+                    "type": "string",
+                    "maxLength": 140
+                },
+                "payee": {
+                    "type": "string"
+                },
+                "payee_key": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "payer": {
+                    "type": "string"
+                }
+            }
+        },
+        "request.PaymentLinkOrderRequest": {
+            "type": "object",
+            "required": [
+                "payer"
+            ],
+            "properties": {
+                "amount": {
+                    "type": "number",
+                    "minimum": 0
+                },
+                "payer": {
+                    "type": "string"
+                }
+            }
+        },
+        "request.PaymentLinkRequest": {
+            "type": "object",
+            "required": [
+                "description",
+                "merchant_id"
+            ],
+            "properties": {
+                "amount": {
+                    "type": "number",
+                    "minimum": 0
+                },
+                "description": {
+                    "type": "string",
+                    "maxLength": 140
+                },
+                "expires_in": {
+                    "type": "integer",
+                    "maximum": 31536000,
+                    "minimum": 0
+                },
+                "merchant_id": {
+                    "type": "string"
+                },
+                "reusable": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "request.PaymentRequestRequest": {
+            "type": "object",
+            "required": [
+                "amount",
+                "payer",
+                "requester"
+            ],
+            "properties": {
+                "amount": {
+                    "type": "number",
+                    "minimum": 0.01
+                },
+                "expires_in": {
+                    "type": "integer",
+                    "maximum": 2592000,
+                    "minimum": 0
+                },
+                "note": {
+                    "type": "string",
+                    "maxLength": 140
+                },
+                "payer": {
+                    "type": "string"
+                },
+                "requester": {
+                    "type": "string"
+                }
+            }
+        },
+        "request.PayoutBatchRequest": {
+            "type": "object",
+            "required": [
+                "items",
+                "payer_id"
+            ],
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "maxItems": 1000,
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/request.PayoutItemRequest"
+                    }
+                },
+                "mode": {
+                    "type": "string",
+                    "enum": [
+                        "partial",
+                        "all_or_nothing"
+                    ]
+                },
+                "payer_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "request.PayoutItemRequest": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "payee": {
+                    "type": "string"
+                }
+            }
+        },
+        "request.PixKeyClaimRequest": {
+            "type": "object",
+            "required": [
+                "claimer_id",
+                "key"
+            ],
+            "properties": {
+                "claimer_id": {
+                    "type": "string"
+                },
+                "key": {
+                    "type": "string",
+                    "maxLength": 255
+                }
+            }
+        },
+        "request.PixKeyRequest": {
+            "type": "object",
+            "required": [
+                "key_type",
+                "user_id"
+            ],
+            "properties": {
+                "key": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "key_type": {
+                    "type": "string",
+                    "enum": [
+                        "email",
+                        "phone",
+                        "document",
+                        "evp"
+                    ]
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "request.PixKeyVerificationRequest": {
+            "type": "object",
+            "required": [
+                "code"
+            ],
+            "properties": {
+                "code": {
+                    "type": "string"
+                }
+            }
+        },
+        "request.QRCodeOrderRequest": {
+            "type": "object",
+            "required": [
+                "payer",
+                "payload"
+            ],
+            "properties": {
+                "amount": {
+                    "type": "number",
+                    "minimum": 0
+                },
+                "payer": {
+                    "type": "string"
+                },
+                "payload": {
+                    "type": "string",
+                    "maxLength": 512
+                }
+            }
+        },
+        "request.QRCodeRequest": {
+            "type": "object",
+            "required": [
+                "merchant_id",
+                "type"
+            ],
+            "properties": {
+                "amount": {
+                    "type": "number",
+                    "minimum": 0
+                },
+                "city": {
+                    "type": "string",
+                    "maxLength": 15
+                },
+                "expires_in": {
+                    "type": "integer",
+                    "maximum": 604800,
+                    "minimum": 0
+                },
+                "merchant_id": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "static",
+                        "dynamic"
+                    ]
+                }
+            }
+        },
+        "request.ReceiptVerificationRequest": {
+            "type": "object",
+            "required": [
+                "authentication_code",
+                "order_id"
+            ],
+            "properties": {
+                "authentication_code": {
+                    "type": "string",
+                    "maxLength": 64
+                },
+                "order_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "request.RecurrenceRequest": {
+            "type": "object",
+            "required": [
+                "amount",
+                "on_insufficient_funds",
+                "payee",
+                "payer",
+                "schedule"
+            ],
+            "properties": {
+                "amount": {
+                    "type": "number",
+                    "minimum": 0.01
+                },
+                "max_retries": {
+                    "type": "integer",
+                    "maximum": 10,
+                    "minimum": 0
+                },
+                "on_insufficient_funds": {
+                    "type": "string",
+                    "enum": [
+                        "skip",
+                        "retry",
+                        "pause"
+                    ]
+                },
+                "payee": {
+                    "type": "string"
+                },
+                "payer": {
+                    "type": "string"
+                },
+                "schedule": {
+                    "type": "string",
+                    "maxLength": 100
+                }
+            }
+        },
+        "request.UserRequest": {
+            "type": "object",
+            "required": [
+                "balance",
+                "document",
+                "email",
+                "first_name",
+                "last_name",
+                "password"
+            ],
+            "properties": {
+                "balance": {
+                    "type": "number",
+                    "minimum": 0
+                },
+                "document": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "first_name": {
+                    "type": "string",
+                    "maxLength": 50
+                },
+                "is_merchant": {
+                    "type": "boolean",
+                    "default": false
+                },
+                "last_name": {
+                    "type": "string",
+                    "maxLength": 50
+                },
+                "merchant_category": {
+                    "type": "string",
+                    "maxLength": 50
+                },
+                "password": {
+                    "type": "string",
+                    "minLength": 6
+                }
+            }
+        },
+        "request.UserUpdateRequest": {
+            "type": "object",
+            "required": [
+                "balance",
+                "first_name",
+                "last_name"
+            ],
+            "properties": {
+                "balance": {
+                    "type": "number",
+                    "minimum": 0
+                },
+                "first_name": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "is_merchant": {
+                    "type": "boolean",
+                    "default": false
+                },
+                "last_name": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "merchant_category": {
+                    "type": "string",
+                    "maxLength": 50
+                },
+                "tier": {
+                    "type": "string",
+                    "enum": [
+                        "basic",
+                        "verified",
+                        "premium"
+                    ]
+                }
+            }
+        },
+        "response.AnticipationItemResponse": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "days": {
+                    "type": "integer"
+                },
+                "fee": {
+                    "type": "number"
+                },
+                "scheduled_for": {
+                    "type": "string"
+                },
+                "settlement_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "response.AnticipationQuoteResponse": {
+            "type": "object",
+            "properties": {
+                "fee": {
+                    "type": "number"
+                },
+                "gross_amount": {
+                    "type": "number"
+                },
+                "merchant_id": {
+                    "type": "string"
+                },
+                "monthly_rate": {
+                    "type": "number"
+                },
+                "net_amount": {
+                    "type": "number"
+                },
+                "settlements": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.AnticipationItemResponse"
+                    }
+                }
+            }
+        },
+        "response.AnticipationResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "fee": {
+                    "type": "number"
+                },
+                "gross_amount": {
+                    "type": "number"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "merchant_id": {
+                    "type": "string"
+                },
+                "monthly_rate": {
+                    "type": "number"
+                },
+                "net_amount": {
+                    "type": "number"
+                },
+                "settlements": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.AnticipationItemResponse"
+                    }
+                }
+            }
+        },
+        "response.BillSplitReminderResponse": {
+            "type": "object",
+            "properties": {
+                "reminded": {
+                    "type": "integer"
+                }
+            }
+        },
+        "response.BillSplitResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "mode": {
+                    "type": "string"
+                },
+                "owner": {
+                    "type": "string"
+                },
+                "paid_amount": {
+                    "type": "number"
+                },
+                "settled_at": {
+                    "type": "string"
+                },
+                "shares": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.PaymentRequestResponse"
+                    }
+                },
+                "status": {
+                    "type": "string"
+                },
+                "total_amount": {
+                    "type": "number"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "response.FeePlanResponse": {
+            "type": "object",
+            "properties": {
+                "category": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "fixed_fee": {
+                    "type": "number"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "merchant_id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "percentage": {
+                    "type": "number"
+                },
+                "settlement_days": {
+                    "type": "integer"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "response.InvoiceItemResponse": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "quantity": {
+                    "type": "integer"
+                },
+                "unit_price": {
+                    "type": "number"
+                }
+            }
+        },
+        "response.InvoiceResponse": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "amount_due": {
+                    "type": "number"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "customer_id": {
+                    "type": "string"
+                },
+                "daily_interest": {
+                    "type": "number"
+                },
+                "due_date": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.InvoiceItemResponse"
+                    }
+                },
+                "late_fee": {
+                    "type": "number"
+                },
+                "merchant_id": {
+                    "type": "string"
+                },
+                "order_id": {
+                    "type": "string"
+                },
+                "paid_amount": {
+                    "type": "number"
+                },
+                "paid_at": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "response.OrderAttachmentResponse": {
+            "type": "object",
+            "properties": {
+                "content_type": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "file_name": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "order_id": {
+                    "type": "string"
+                },
+                "size": {
+                    "type": "integer"
+                },
+                "uploaded_by": {
+                    "type": "string"
+                }
+            }
+        },
+        "response.OrderResponse": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "fee": {
+                    "type": "number"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "is_reversed": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "net_amount": {
+                    "type": "number"
+                },
+                "payee": {
+                    "type": "string"
+                },
+                "payer": {
+                    "type": "string"
+                }
+            }
+        },
+        "response.PaymentLinkPaymentResponse": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "order_id": {
+                    "type": "string"
+                },
+                "payer": {
+                    "type": "string"
+                },
+                "payment_link_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "response.PaymentLinkPublicResponse": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "expires_at": {
+                    "type": "string"
+                },
+                "merchant_name": {
+                    "type": "string"
+                },
+                "reusable": {
+                    "type": "boolean"
+                },
+                "slug": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "response.PaymentLinkResponse": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "expires_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "merchant_id": {
+                    "type": "string"
+                },
+                "reusable": {
+                    "type": "boolean"
+                },
+                "slug": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "response.PaymentRequestResponse": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "expires_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "note": {
+                    "type": "string"
+                },
+                "order_id": {
+                    "type": "string"
+                },
+                "payer": {
+                    "type": "string"
+                },
+                "requester": {
+                    "type": "string"
+                },
+                "split_id": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "response.PayoutBatchResponse": {
+            "type": "object",
+            "properties": {
+                "completed_at": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "failed_items": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "mode": {
+                    "type": "string"
+                },
+                "payer_id": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "succeeded_items": {
+                    "type": "integer"
+                },
+                "total_amount": {
+                    "type": "number"
+                },
+                "total_items": {
+                    "type": "integer"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "response.PixKeyClaimResponse": {
+            "type": "object",
+            "properties": {
+                "claimable_at": {
+                    "type": "string"
+                },
+                "claimer_id": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "donor_id": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "key": {
+                    "type": "string"
+                },
+                "key_id": {
+                    "type": "string"
+                },
+                "resolved_at": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                }
             }
         },
-        "request.OrderRequest": {
+        "response.PixKeyLookupResponse": {
             "type": "object",
-            "required": [
-                "amount",
-                "payee",
-                "payer"
-            ],
             "properties": {
-                "amount": {
-                    "type": "number",
-                    "minimum": 0.01
+                "is_merchant": {
+                    "type": "boolean"
                 },
-                "payee": {
+                "key": {
                     "type": "string"
                 },
-                "payer": {
+                "key_type": {
+                    "type": "string"
+                },
+                "owner_document": {
+                    "type": "string"
+                },
+                "owner_name": {
                     "type": "string"
                 }
             }
         },
-        "request.UserRequest": {
+        "response.PixKeyResponse": {
             "type": "object",
-            "required": [
-                "balance",
-                "document",
-                "email",
-                "first_name",
-                "last_name",
-                "password"
-            ],
             "properties": {
-                "balance": {
-                    "type": "number",
-                    "minimum": 0
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "key": {
+                    "type": "string"
+                },
+                "key_type": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "response.QRCodeResponse": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "expires_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "merchant_id": {
+                    "type": "string"
+                },
+                "order_id": {
+                    "type": "string"
                 },
+                "payload": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "response.ReceiptPartyResponse": {
+            "type": "object",
+            "properties": {
                 "document": {
-                    "type": "string",
-                    "maxLength": 11,
-                    "minLength": 4
+                    "type": "string"
                 },
-                "email": {
+                "name": {
                     "type": "string"
+                }
+            }
+        },
+        "response.ReceiptResponse": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number"
                 },
-                "first_name": {
-                    "type": "string",
-                    "maxLength": 50
+                "authentication_code": {
+                    "type": "string"
                 },
-                "is_merchant": {
-                    "type": "boolean",
-                    "default": false
+                "created_at": {
+                    "type": "string"
                 },
-                "last_name": {
-                    "type": "string",
-                    "maxLength": 50
+                "message": {
+                    "type": "string"
                 },
-                "password": {
-                    "type": "string",
-                    "minLength": 6
+                "order_id": {
+                    "type": "string"
+                },
+                "payee": {
+                    "$ref": "#/definitions/response.ReceiptPartyResponse"
+                },
+                "payer": {
+                    "$ref": "#/definitions/response.ReceiptPartyResponse"
                 }
             }
         },
-        "request.UserUpdateRequest": {
+        "response.ReceiptVerificationResponse": {
             "type": "object",
-            "required": [
-                "balance",
-                "first_name",
-                "last_name"
-            ],
             "properties": {
-                "balance": {
-                    "type": "number",
-                    "minimum": 0
+                "receipt": {
+                    "$ref": "#/definitions/response.ReceiptResponse"
                 },
-                "first_name": {
-                    "type": "string",
-                    "maxLength": 100
+                "valid": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "response.RecurrenceExecutionResponse": {
+            "type": "object",
+            "properties": {
+                "executed_at": {
+                    "type": "string"
                 },
-                "is_merchant": {
-                    "type": "boolean",
-                    "default": false
+                "id": {
+                    "type": "string"
                 },
-                "last_name": {
-                    "type": "string",
-                    "maxLength": 100
+                "message": {
+                    "type": "string"
+                },
+                "order_id": {
+                    "type": "string"
+                },
+                "recurrence_id": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
                 }
             }
         },
-        "response.OrderResponse": {
+        "response.RecurrenceResponse": {
             "type": "object",
             "properties": {
                 "amount": {
@@ -498,11 +4007,131 @@ const docTemplate = `{
                 "id": {
                     "type": "string"
                 },
+                "max_retries": {
+                    "type": "integer"
+                },
+                "next_run_at": {
+                    "type": "string"
+                },
+                "on_insufficient_funds": {
+                    "type": "string"
+                },
                 "payee": {
                     "type": "string"
                 },
                 "payer": {
                     "type": "string"
+                },
+                "retry_count": {
+                    "type": "integer"
+                },
+                "schedule": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "response.SettlementResponse": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "merchant_id": {
+                    "type": "string"
+                },
+                "order_id": {
+                    "type": "string"
+                },
+                "scheduled_for": {
+                    "type": "string"
+                },
+                "settled_at": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "response.SettlementScheduleResponse": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "count": {
+                    "type": "integer"
+                },
+                "date": {
+                    "type": "string"
+                }
+            }
+        },
+        "response.TransferLimitsResponse": {
+            "type": "object",
+            "properties": {
+                "available_for_transfer": {
+                    "type": "number"
+                },
+                "daily_limit": {
+                    "type": "number"
+                },
+                "daily_remaining": {
+                    "type": "number"
+                },
+                "daily_used": {
+                    "type": "number"
+                },
+                "hourly_transfers_remaining": {
+                    "type": "integer"
+                },
+                "is_night": {
+                    "type": "boolean"
+                },
+                "max_per_transaction": {
+                    "type": "number"
+                },
+                "max_transfers_per_hour": {
+                    "type": "integer"
+                },
+                "monthly_limit": {
+                    "type": "number"
+                },
+                "monthly_remaining": {
+                    "type": "number"
+                },
+                "monthly_used": {
+                    "type": "number"
+                },
+                "nightly_limit": {
+                    "type": "number"
+                },
+                "nightly_remaining": {
+                    "type": "number"
+                },
+                "nightly_used": {
+                    "type": "number"
+                },
+                "tier": {
+                    "type": "string"
+                },
+                "transfers_last_hour": {
+                    "type": "integer"
+                },
+                "user_id": {
+                    "type": "string"
                 }
             }
         },
@@ -518,6 +4147,9 @@ const docTemplate = `{
                 "document": {
                     "type": "string"
                 },
+                "document_type": {
+                    "type": "string"
+                },
                 "email": {
                     "type": "string"
                 },
@@ -533,9 +4165,18 @@ const docTemplate = `{
                 "last_name": {
                     "type": "string"
                 },
+                "merchant_category": {
+                    "type": "string"
+                },
                 "password": {
                     "type": "string"
                 },
+                "pending_balance": {
+                    "type": "number"
+                },
+                "tier": {
+                    "type": "string"
+                },
                 "updated_at": {
                     "type": "string"
                 }
@@ -547,7 +4188,7 @@ const docTemplate = `{
 // SwaggerInfo holds exported Swagger Info so clients can modify it
 var SwaggerInfo = &swag.Spec{
 	Version:          "1.0",
-	Host:             "localhost:9000",
+	Host:             "picpay-golang.onrender.com/docs/index.html",
 	BasePath:         "/api/v1",
 	Schemes:          []string{"http"},
 	Title:            "PicPay Challange",
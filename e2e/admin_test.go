@@ -0,0 +1,31 @@
+package e2e
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestAdminLogLevel_ShouldRejectRequestsWithoutTheAdminToken(t *testing.T) {
+	t.Log("*** Test Admin Log Level Without Token")
+
+	for _, authorization := range []string{"", "Bearer wrong-token"} {
+		req, err := http.NewRequest(http.MethodGet, "http://localhost:8000/admin/log-level", nil)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		if authorization != "" {
+			req.Header.Set("Authorization", authorization)
+		}
+
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		resp.Body.Close()
+
+		// Without ADMIN_TOKEN the route is not registered at all.
+		if resp.StatusCode != http.StatusUnauthorized && resp.StatusCode != http.StatusNotFound {
+			t.Fatalf("Invalid Status Code. Expected 401 or 404 and received %d", resp.StatusCode)
+		}
+	}
+}
@@ -0,0 +1,174 @@
+package e2e
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/felipeversiane/picpay-golang.git/internal/entity/request"
+	"github.com/google/uuid"
+)
+
+func anticipationMerchant() request.UserRequest {
+	return request.UserRequest{
+		Email:            "anticipationmerchant@example.com",
+		Password:         "passwor8!F",
+		FirstName:        "Farmacia",
+		LastName:         "Popular",
+		Document:         "66123456000157",
+		Balance:          0.00,
+		IsMerchant:       true,
+		MerchantCategory: "pharmacy",
+	}
+}
+
+func anticipationCustomer() request.UserRequest {
+	return request.UserRequest{
+		Email:      "anticipationcustomer@example.com",
+		Password:   "passwor8!F",
+		FirstName:  "Rafael",
+		LastName:   "Costa",
+		Document:   "66123456830",
+		Balance:    300.00,
+		IsMerchant: false,
+	}
+}
+
+func TestQuoteAnticipation_ShouldReturnStatusBadRequest_WhenItHasInvalidData(t *testing.T) {
+	t.Log("*** Test Quote Anticipation with Invalid Data")
+
+	api := NewApiClient()
+	params := []map[string]interface{}{
+		nil,
+		{},
+		{"settlement_ids": []string{}},
+		{"settlement_ids": []string{"not"}},
+	}
+
+	for _, p := range params {
+		resp, err := api.Post("/user/"+uuid.NewString()+"/anticipation/quote", p)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		defer resp.Body.Close()
+		assertStatusCode(t, resp, http.StatusBadRequest)
+	}
+}
+
+func findPendingSettlementIDs(merchant string, t *testing.T) []string {
+	t.Log("*** Find Pending Settlement IDs")
+
+	api := NewApiClient()
+
+	resp, err := api.Get("/user/" + merchant + "/settlements")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer resp.Body.Close()
+
+	assertStatusCode(t, resp, http.StatusOK)
+
+	res, err := api.ParseListBody(resp)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(res) != 1 {
+		t.Fatalf("Invalid number of pending settlements. Expected 1 and received %d", len(res))
+	}
+
+	return []string{res[0]["id"].(string)}
+}
+
+func quoteAnticipationSuccessfully(merchant string, settlementIDs []string, t *testing.T) float64 {
+	t.Log("*** Quote Anticipation Successfully")
+
+	api := NewApiClient()
+
+	resp, err := api.Post("/user/"+merchant+"/anticipation/quote", map[string]interface{}{"settlement_ids": settlementIDs})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer resp.Body.Close()
+
+	assertStatusCode(t, resp, http.StatusOK)
+
+	res, err := api.ParseBody(resp)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if res["gross_amount"].(float64) != 97.50 {
+		t.Fatalf("Invalid Gross Amount. Expected 97.50 and received %v", res["gross_amount"])
+	}
+	if res["fee"].(float64) <= 0 {
+		t.Fatal("Invalid Anticipation Fee")
+	}
+
+	return res["net_amount"].(float64)
+}
+
+func acceptAnticipationSuccessfully(merchant string, settlementIDs []string, expectedNet float64, t *testing.T) {
+	t.Log("*** Accept Anticipation Successfully")
+
+	api := NewApiClient()
+
+	resp, err := api.Post("/user/"+merchant+"/anticipation", map[string]interface{}{"settlement_ids": settlementIDs})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer resp.Body.Close()
+
+	assertStatusCode(t, resp, http.StatusCreated)
+
+	res, err := api.ParseBody(resp)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if res["net_amount"].(float64) != expectedNet {
+		t.Fatalf("Invalid Net Amount. Expected %v and received %v", expectedNet, res["net_amount"])
+	}
+
+	resp, err = api.Get("/user/" + merchant)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer resp.Body.Close()
+
+	user, err := api.ParseBody(resp)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if user["balance"].(float64) != expectedNet {
+		t.Fatalf("Available balance incorrect. Expected %v but got %v", expectedNet, user["balance"])
+	}
+	if user["pending_balance"].(float64) != 0.00 {
+		t.Fatalf("Pending balance incorrect. Expected 0.00 but got %v", user["pending_balance"])
+	}
+
+	resp, err = api.Post("/user/"+merchant+"/anticipation", map[string]interface{}{"settlement_ids": settlementIDs})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer resp.Body.Close()
+
+	assertStatusCode(t, resp, http.StatusBadRequest)
+}
+
+func TestAnticipationFlow(t *testing.T) {
+	t.Log("*** Start Anticipation Flow")
+
+	merchantID := insertOrderUserSuccessfully(anticipationMerchant(), t)
+	customerID := insertOrderUserSuccessfully(anticipationCustomer(), t)
+
+	feePlanID := insertSettlementFeePlanSuccessfully(merchantID, t)
+	insertOrderWithSettlementSuccessfully(customerID, merchantID, t)
+
+	settlementIDs := findPendingSettlementIDs(merchantID, t)
+	net := quoteAnticipationSuccessfully(merchantID, settlementIDs, t)
+	acceptAnticipationSuccessfully(merchantID, settlementIDs, net, t)
+	deleteFeePlanSuccessfully(feePlanID, t)
+
+	deleteOrderUserSuccessfully(merchantID, t)
+	deleteOrderUserSuccessfully(customerID, t)
+
+	t.Log("*** End Anticipation Flow Successful")
+}
@@ -4,7 +4,9 @@ import (
 	"bytes"
 	"encoding/json"
 	"io"
+	"mime/multipart"
 	"net/http"
+	"os"
 	"testing"
 
 	"github.com/felipeversiane/picpay-golang.git/config/logger"
@@ -12,6 +14,7 @@ import (
 
 type ApiClient struct {
 	baseUrl string
+	token   string
 }
 
 func NewApiClient() ApiClient {
@@ -20,6 +23,22 @@ func NewApiClient() ApiClient {
 	}
 }
 
+// NewAdminClient calls the admin routes with the ADMIN_TOKEN the API was
+// started with.
+func NewAdminClient() ApiClient {
+	return ApiClient{
+		baseUrl: "http://localhost:8000/admin",
+		token:   os.Getenv("ADMIN_TOKEN"),
+	}
+}
+
+func (api *ApiClient) do(req *http.Request) (*http.Response, error) {
+	if api.token != "" {
+		req.Header.Set("Authorization", "Bearer "+api.token)
+	}
+	return http.DefaultClient.Do(req)
+}
+
 func (api *ApiClient) Post(path string, data map[string]interface{}) (*http.Response, error) {
 	body, err := json.Marshal(data)
 	if err != nil {
@@ -29,9 +48,63 @@ func (api *ApiClient) Post(path string, data map[string]interface{}) (*http.Resp
 	payload := bytes.NewBuffer(body)
 	url := api.baseUrl + path
 
-	logger.Println("POST", url, payload)
+	logger.Println("POST", url)
+
+	req, err := http.NewRequest(http.MethodPost, url, payload)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := api.do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Println("RESPONSE", resp.Status)
+
+	return resp, nil
+}
+
+func (api *ApiClient) PostCSV(path string, data string) (*http.Response, error) {
+	url := api.baseUrl + path
+
+	logger.Println("POST", url)
 
-	resp, err := http.Post(url, "application/json", payload)
+	resp, err := http.Post(url, "text/csv", bytes.NewBufferString(data))
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Println("RESPONSE", resp.Status)
+
+	return resp, nil
+}
+
+func (api *ApiClient) PostFile(path string, fields map[string]string, fileName string, content []byte) (*http.Response, error) {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	for key, value := range fields {
+		if err := writer.WriteField(key, value); err != nil {
+			return nil, err
+		}
+	}
+	part, err := writer.CreateFormFile("file", fileName)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := part.Write(content); err != nil {
+		return nil, err
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+
+	url := api.baseUrl + path
+
+	logger.Println("POST", url)
+
+	resp, err := http.Post(url, writer.FormDataContentType(), &body)
 	if err != nil {
 		return nil, err
 	}
@@ -65,7 +138,7 @@ func (api *ApiClient) Put(path string, data map[string]interface{}) (*http.Respo
 	payload := bytes.NewBuffer(body)
 	url := api.baseUrl + path
 
-	logger.Println("PUT", url, payload)
+	logger.Println("PUT", url)
 
 	req, err := http.NewRequest(http.MethodPut, url, payload)
 	if err != nil {
@@ -93,8 +166,7 @@ func (api *ApiClient) Delete(path string) (*http.Response, error) {
 		return nil, err
 	}
 
-	client := &http.Client{}
-	resp, err := client.Do(req)
+	resp, err := api.do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -111,8 +183,6 @@ func (api *ApiClient) ParseBody(resp *http.Response) (map[string]interface{}, er
 		return nil, err
 	}
 
-	logger.Printf("BODY %s", body)
-
 	var data map[string]interface{}
 	if err := json.Unmarshal(body, &data); err != nil {
 		return nil, err
@@ -121,6 +191,20 @@ func (api *ApiClient) ParseBody(resp *http.Response) (map[string]interface{}, er
 	return data, nil
 }
 
+func (api *ApiClient) ParseListBody(resp *http.Response) ([]map[string]interface{}, error) {
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var data []map[string]interface{}
+	if err := json.Unmarshal(body, &data); err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
 func assertStatusCode(t *testing.T, resp *http.Response, expected int) {
 	t.Helper()
 	if resp.StatusCode != expected {
@@ -0,0 +1,194 @@
+package e2e
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/felipeversiane/picpay-golang.git/internal/entity/request"
+	"github.com/google/uuid"
+)
+
+func billSplitOwner() request.UserRequest {
+	return request.UserRequest{
+		Email:      "billsplitowner@example.com",
+		Password:   "passwor8!F",
+		FirstName:  "Helena",
+		LastName:   "Souza",
+		Document:   "14123456774",
+		Balance:    0.00,
+		IsMerchant: false,
+	}
+}
+
+func billSplitFirstParticipant() request.UserRequest {
+	return request.UserRequest{
+		Email:      "billsplitfirst@example.com",
+		Password:   "passwor8!F",
+		FirstName:  "Igor",
+		LastName:   "Lima",
+		Document:   "15123456791",
+		Balance:    300.00,
+		IsMerchant: false,
+	}
+}
+
+func billSplitSecondParticipant() request.UserRequest {
+	return request.UserRequest{
+		Email:      "billsplitsecond@example.com",
+		Password:   "passwor8!F",
+		FirstName:  "Julia",
+		LastName:   "Rocha",
+		Document:   "16123456709",
+		Balance:    300.00,
+		IsMerchant: false,
+	}
+}
+
+func TestInsertBillSplit_ShouldReturnStatusBadRequest_WhenItHasInvalidData(t *testing.T) {
+	t.Log("*** Test Insert Bill Split with Invalid Data")
+
+	api := NewApiClient()
+	participants := []map[string]interface{}{{"user_id": uuid.NewString()}}
+	params := []map[string]interface{}{
+		nil,
+		{},
+		{"other": "value"},
+		{"owner": "not", "description": "Dinner", "total_amount": 90.00, "mode": "equal", "participants": participants},
+		{"owner": uuid.NewString(), "description": "Dinner", "total_amount": 90.00, "mode": "other", "participants": participants},
+		{"owner": uuid.NewString(), "description": "Dinner", "total_amount": 90.00, "mode": "equal", "participants": []map[string]interface{}{}},
+		{"owner": uuid.NewString(), "description": "Dinner", "total_amount": 90.00, "mode": "equal", "participants": []map[string]interface{}{{"user_id": "not"}}},
+		{"owner": uuid.NewString(), "description": "Dinner", "total_amount": 90.00, "mode": "equal", "participants": participants},
+	}
+
+	for _, p := range params {
+		resp, err := api.Post("/bill_split", p)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		defer resp.Body.Close()
+		assertStatusCode(t, resp, http.StatusBadRequest)
+	}
+}
+
+func insertBillSplitSuccessfully(owner string, participants []string, t *testing.T) (string, []string) {
+	t.Log("*** Insert Bill Split Successfully")
+
+	api := NewApiClient()
+
+	shares := []map[string]interface{}{}
+	for _, participant := range participants {
+		shares = append(shares, map[string]interface{}{"user_id": participant})
+	}
+
+	payload := map[string]interface{}{
+		"owner":        owner,
+		"description":  "Dinner",
+		"total_amount": 100.00,
+		"mode":         "equal",
+		"participants": shares,
+	}
+
+	resp, err := api.Post("/bill_split", payload)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer resp.Body.Close()
+
+	assertStatusCode(t, resp, http.StatusCreated)
+
+	res, err := api.ParseBody(resp)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if res["status"].(string) != "open" {
+		t.Fatalf("Invalid Status. Expected open and received %s", res["status"])
+	}
+
+	expectedShares := []float64{33.34, 33.33}
+	ids := []string{}
+	for i, share := range res["shares"].([]interface{}) {
+		share := share.(map[string]interface{})
+		if share["amount"].(float64) != expectedShares[i] {
+			t.Fatalf("Invalid Share. Expected %f and received %f", expectedShares[i], share["amount"])
+		}
+		ids = append(ids, share["id"].(string))
+	}
+	if len(ids) != len(participants) {
+		t.Fatalf("Invalid Shares. Expected %d and received %d", len(participants), len(ids))
+	}
+
+	return res["id"].(string), ids
+}
+
+func remindBillSplitSuccessfully(id string, expected int, t *testing.T) {
+	t.Log("*** Remind Bill Split Participants Successfully")
+
+	api := NewApiClient()
+
+	resp, err := api.Post("/bill_split/"+id+"/remind", nil)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer resp.Body.Close()
+
+	assertStatusCode(t, resp, http.StatusOK)
+
+	res, err := api.ParseBody(resp)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if int(res["reminded"].(float64)) != expected {
+		t.Fatalf("Invalid Reminders. Expected %d and received %v", expected, res["reminded"])
+	}
+}
+
+func findBillSplitSuccessfully(id string, expectedStatus string, expectedPaid float64, t *testing.T) {
+	t.Log("*** Find Bill Split Successfully")
+
+	api := NewApiClient()
+
+	resp, err := api.Get("/bill_split/" + id)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer resp.Body.Close()
+
+	assertStatusCode(t, resp, http.StatusOK)
+
+	res, err := api.ParseBody(resp)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if res["status"].(string) != expectedStatus {
+		t.Fatalf("Invalid Status. Expected %s and received %s", expectedStatus, res["status"])
+	}
+	if res["paid_amount"].(float64) != expectedPaid {
+		t.Fatalf("Invalid Paid Amount. Expected %f and received %f", expectedPaid, res["paid_amount"])
+	}
+}
+
+func TestBillSplitFlow(t *testing.T) {
+	t.Log("*** Start Bill Split Flow")
+
+	ownerID := insertOrderUserSuccessfully(billSplitOwner(), t)
+	firstID := insertOrderUserSuccessfully(billSplitFirstParticipant(), t)
+	secondID := insertOrderUserSuccessfully(billSplitSecondParticipant(), t)
+
+	splitID, shareIDs := insertBillSplitSuccessfully(ownerID, []string{firstID, secondID}, t)
+
+	remindBillSplitSuccessfully(splitID, 2, t)
+	acceptPaymentRequestSuccessfully(shareIDs[0], ownerID, 33.34, t)
+	findBillSplitSuccessfully(splitID, "open", 33.34, t)
+	remindBillSplitSuccessfully(splitID, 1, t)
+	acceptPaymentRequestSuccessfully(shareIDs[1], ownerID, 33.33, t)
+	findBillSplitSuccessfully(splitID, "settled", 66.67, t)
+
+	deleteOrderUserSuccessfully(ownerID, t)
+	deleteOrderUserSuccessfully(firstID, t)
+	deleteOrderUserSuccessfully(secondID, t)
+
+	t.Log("*** End Bill Split Flow Successful")
+}
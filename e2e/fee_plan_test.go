@@ -0,0 +1,214 @@
+package e2e
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/felipeversiane/picpay-golang.git/internal/entity/request"
+	"github.com/google/uuid"
+)
+
+func feeMerchant() request.UserRequest {
+	return request.UserRequest{
+		Email:            "feemerchant@example.com",
+		Password:         "passwor8!F",
+		FirstName:        "Padaria",
+		LastName:         "Central",
+		Document:         "88123456000190",
+		Balance:          0.00,
+		IsMerchant:       true,
+		MerchantCategory: "bakery",
+	}
+}
+
+func feeCustomer() request.UserRequest {
+	return request.UserRequest{
+		Email:      "feecustomer@example.com",
+		Password:   "passwor8!F",
+		FirstName:  "Lucas",
+		LastName:   "Pereira",
+		Document:   "88123456891",
+		Balance:    300.00,
+		IsMerchant: false,
+	}
+}
+
+func TestInsertFeePlan_ShouldReturnStatusBadRequest_WhenItHasInvalidData(t *testing.T) {
+	t.Log("*** Test Insert Fee Plan with Invalid Data")
+
+	api := NewAdminClient()
+	params := []map[string]interface{}{
+		nil,
+		{},
+		{"other": "value"},
+		{"name": "Plan", "percentage": 101.00, "fixed_fee": 0.50},
+		{"name": "Plan", "percentage": 2.00, "fixed_fee": -0.50},
+		{"name": "Plan", "merchant_id": "not", "percentage": 2.00, "fixed_fee": 0.50},
+		{"name": "Plan", "merchant_id": uuid.NewString(), "category": "bakery", "percentage": 2.00, "fixed_fee": 0.50},
+		{"name": "Plan", "merchant_id": uuid.NewString(), "percentage": 2.00, "fixed_fee": 0.50},
+	}
+
+	for _, p := range params {
+		resp, err := api.Post("/fee_plan", p)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		defer resp.Body.Close()
+		assertStatusCode(t, resp, http.StatusBadRequest)
+	}
+}
+
+func TestInsertFeePlan_ShouldRejectRequestsWithoutTheAdminToken(t *testing.T) {
+	t.Log("*** Test Insert Fee Plan Without Admin Token")
+
+	payload := map[string]interface{}{"name": "Plan", "percentage": 2.00, "fixed_fee": 0.50}
+
+	public := NewApiClient()
+	resp, err := public.Post("/fee_plan", payload)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusCreated {
+		t.Fatal("Fee plans must not be created through the public API")
+	}
+
+	anonymous := NewAdminClient()
+	anonymous.token = ""
+	resp, err = anonymous.Post("/fee_plan", payload)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("Invalid Status Code. Expected 401 and received %d", resp.StatusCode)
+	}
+}
+
+func TestFindFeePlan_ShouldReturnStatusNotFound_WhenFeePlanIdIsNotOnDatabase(t *testing.T) {
+	t.Log("*** Test Find Fee Plan when Fee Plan is not on Database")
+
+	api := NewApiClient()
+
+	resp, err := api.Get("/fee_plan/" + uuid.NewString())
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer resp.Body.Close()
+	assertStatusCode(t, resp, http.StatusNotFound)
+}
+
+func insertFeePlanSuccessfully(merchant string, t *testing.T) string {
+	t.Log("*** Insert Fee Plan Successfully")
+
+	api := NewAdminClient()
+
+	payload := map[string]interface{}{
+		"name":        "Merchant plan",
+		"merchant_id": merchant,
+		"percentage":  2.00,
+		"fixed_fee":   0.50,
+	}
+
+	resp, err := api.Post("/fee_plan", payload)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer resp.Body.Close()
+
+	assertStatusCode(t, resp, http.StatusCreated)
+
+	res, err := api.ParseBody(resp)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	id := res["id"].(string)
+	if id == "" {
+		t.Fatal("Invalid ID")
+	}
+	if res["merchant_id"].(string) != merchant {
+		t.Fatal("Invalid Merchant")
+	}
+
+	return id
+}
+
+func insertOrderWithFeeSuccessfully(payer string, payee string, t *testing.T) {
+	t.Log("*** Insert Order with Merchant Fee Successfully")
+
+	api := NewApiClient()
+
+	payload := map[string]interface{}{
+		"amount": 100.00,
+		"payer":  payer,
+		"payee":  payee,
+	}
+
+	initialPayeeBalance := getUserBalance(payee, t)
+
+	for {
+		resp, err := api.Post("/order", payload)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		defer resp.Body.Close()
+
+		res, err := api.ParseBody(resp)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		if resp.StatusCode == http.StatusBadRequest && res["message"] == "Order not authorized" {
+			t.Log("Order not authorized, retrying...")
+			continue
+		}
+
+		assertStatusCode(t, resp, http.StatusCreated)
+
+		if res["amount"].(float64) != 100.00 {
+			t.Fatal("Invalid Gross Amount")
+		}
+		if res["fee"].(float64) != 2.50 {
+			t.Fatalf("Invalid Fee. Expected 2.50 and received %v", res["fee"])
+		}
+		if res["net_amount"].(float64) != 97.50 {
+			t.Fatalf("Invalid Net Amount. Expected 97.50 and received %v", res["net_amount"])
+		}
+
+		finalPayeeBalance := getUserBalance(payee, t)
+		if finalPayeeBalance != initialPayeeBalance+97.50 {
+			t.Fatalf("Payee balance incorrect. Expected %f but got %f", initialPayeeBalance+97.50, finalPayeeBalance)
+		}
+		return
+	}
+}
+
+func deleteFeePlanSuccessfully(id string, t *testing.T) {
+	t.Log("*** Delete Fee Plan Successfully")
+
+	api := NewAdminClient()
+
+	resp, err := api.Delete("/fee_plan/" + id)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	assertStatusCode(t, resp, http.StatusNoContent)
+}
+
+func TestFeePlanFlow(t *testing.T) {
+	t.Log("*** Start Fee Plan Flow")
+
+	merchantID := insertOrderUserSuccessfully(feeMerchant(), t)
+	customerID := insertOrderUserSuccessfully(feeCustomer(), t)
+
+	feePlanID := insertFeePlanSuccessfully(merchantID, t)
+	insertOrderWithFeeSuccessfully(customerID, merchantID, t)
+	deleteFeePlanSuccessfully(feePlanID, t)
+
+	deleteOrderUserSuccessfully(merchantID, t)
+	deleteOrderUserSuccessfully(customerID, t)
+
+	t.Log("*** End Fee Plan Flow Successful")
+}
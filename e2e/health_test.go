@@ -0,0 +1,72 @@
+package e2e
+
+import (
+	"net/http"
+	"testing"
+)
+
+func newHealthClient() ApiClient {
+	return ApiClient{
+		baseUrl: "http://localhost:8000",
+	}
+}
+
+func TestLiveness_ShouldReturnStatusOK(t *testing.T) {
+	t.Log("*** Test Liveness")
+
+	api := newHealthClient()
+
+	for _, path := range []string{"/health", "/health/live"} {
+		resp, err := api.Get(path)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		defer resp.Body.Close()
+
+		assertStatusCode(t, resp, http.StatusOK)
+
+		res, err := api.ParseBody(resp)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		if res["status"].(string) != "up" {
+			t.Fatalf("Invalid Status. Expected up and received %v", res["status"])
+		}
+	}
+}
+
+func TestReadiness_ShouldReportEveryComponent(t *testing.T) {
+	t.Log("*** Test Readiness")
+
+	api := newHealthClient()
+
+	resp, err := api.Get("/health/ready")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer resp.Body.Close()
+
+	assertStatusCode(t, resp, http.StatusOK)
+
+	res, err := api.ParseBody(resp)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if res["status"].(string) != "up" {
+		t.Fatalf("Invalid Status. Expected up and received %v", res["status"])
+	}
+
+	components := res["components"].(map[string]interface{})
+	for _, name := range []string{"database", "migrations", "authorizer"} {
+		component, ok := components[name].(map[string]interface{})
+		if !ok {
+			t.Fatalf("Component %s missing from readiness report", name)
+		}
+		if component["status"].(string) != "up" {
+			t.Fatalf("Component %s is %v: %v", name, component["status"], component["error"])
+		}
+		if _, ok := component["latency_ms"].(float64); !ok {
+			t.Fatalf("Component %s has no latency", name)
+		}
+	}
+}
@@ -0,0 +1,207 @@
+package e2e
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/felipeversiane/picpay-golang.git/internal/entity/request"
+	"github.com/google/uuid"
+)
+
+func invoiceMerchant() request.UserRequest {
+	return request.UserRequest{
+		Email:            "invoicemerchant@example.com",
+		Password:         "passwor8!F",
+		FirstName:        "Academia",
+		LastName:         "Forte",
+		Document:         "13876543000119",
+		Balance:          0.00,
+		IsMerchant:       true,
+		MerchantCategory: "services",
+	}
+}
+
+func invoiceCustomer() request.UserRequest {
+	return request.UserRequest{
+		Email:      "invoicecustomer@example.com",
+		Password:   "passwor8!F",
+		FirstName:  "Lucas",
+		LastName:   "Barbosa",
+		Document:   "18123456743",
+		Balance:    300.00,
+		IsMerchant: false,
+	}
+}
+
+func invoiceItems() []map[string]interface{} {
+	return []map[string]interface{}{
+		{"description": "Monthly plan", "quantity": 2, "unit_price": 20.00},
+		{"description": "Towel", "quantity": 1, "unit_price": 15.50},
+	}
+}
+
+func TestInsertInvoice_ShouldReturnStatusBadRequest_WhenItHasInvalidData(t *testing.T) {
+	t.Log("*** Test Insert Invoice with Invalid Data")
+
+	api := NewApiClient()
+	dueDate := time.Now().AddDate(0, 0, 7).Format("2006-01-02")
+	params := []map[string]interface{}{
+		nil,
+		{},
+		{"other": "value"},
+		{"merchant_id": "not", "customer_id": uuid.NewString(), "due_date": dueDate, "items": invoiceItems()},
+		{"merchant_id": uuid.NewString(), "customer_id": uuid.NewString(), "due_date": "10/08/2024", "items": invoiceItems()},
+		{"merchant_id": uuid.NewString(), "customer_id": uuid.NewString(), "due_date": dueDate, "items": []map[string]interface{}{}},
+		{"merchant_id": uuid.NewString(), "customer_id": uuid.NewString(), "due_date": dueDate, "items": []map[string]interface{}{{"description": "Plan", "quantity": 0, "unit_price": 10.00}}},
+		{"merchant_id": uuid.NewString(), "customer_id": uuid.NewString(), "due_date": dueDate, "late_fee": 50.00, "items": invoiceItems()},
+		{"merchant_id": uuid.NewString(), "customer_id": uuid.NewString(), "due_date": "2020-01-01", "items": invoiceItems()},
+	}
+
+	for _, p := range params {
+		resp, err := api.Post("/invoice", p)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		defer resp.Body.Close()
+		assertStatusCode(t, resp, http.StatusBadRequest)
+	}
+}
+
+func insertInvoiceSuccessfully(merchant string, customer string, t *testing.T) string {
+	t.Log("*** Insert Invoice Successfully")
+
+	api := NewApiClient()
+
+	payload := map[string]interface{}{
+		"merchant_id":    merchant,
+		"customer_id":    customer,
+		"due_date":       time.Now().AddDate(0, 0, 7).Format("2006-01-02"),
+		"late_fee":       2.00,
+		"daily_interest": 0.033,
+		"items":          invoiceItems(),
+	}
+
+	resp, err := api.Post("/invoice", payload)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer resp.Body.Close()
+
+	assertStatusCode(t, resp, http.StatusCreated)
+
+	res, err := api.ParseBody(resp)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if res["status"].(string) != "open" {
+		t.Fatalf("Invalid Status. Expected open and received %s", res["status"])
+	}
+	if res["amount"].(float64) != 55.50 {
+		t.Fatalf("Invalid Amount. Expected 55.50 and received %f", res["amount"])
+	}
+	if res["amount_due"].(float64) != 55.50 {
+		t.Fatalf("Invalid Amount Due. Expected 55.50 and received %f", res["amount_due"])
+	}
+
+	return res["id"].(string)
+}
+
+func payInvoiceSuccessfully(id string, t *testing.T) {
+	t.Log("*** Pay Invoice Successfully")
+
+	api := NewApiClient()
+
+	for {
+		resp, err := api.Put("/invoice/"+id+"/pay", nil)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		defer resp.Body.Close()
+
+		res, err := api.ParseBody(resp)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		if resp.StatusCode == http.StatusBadRequest && res["message"] == "Order not authorized" {
+			t.Log("Order not authorized, retrying...")
+			continue
+		}
+
+		assertStatusCode(t, resp, http.StatusOK)
+
+		if res["status"].(string) != "paid" {
+			t.Fatalf("Invalid Status. Expected paid and received %s", res["status"])
+		}
+		if res["paid_amount"].(float64) != 55.50 {
+			t.Fatalf("Invalid Paid Amount. Expected 55.50 and received %v", res["paid_amount"])
+		}
+		if res["order_id"] == nil {
+			t.Fatal("Order not linked to the invoice")
+		}
+		return
+	}
+}
+
+func changeInvoiceStatus(id string, action string, expectedStatus int, t *testing.T) {
+	t.Logf("*** Change Invoice Status to %s", action)
+
+	api := NewApiClient()
+
+	resp, err := api.Put("/invoice/"+id+"/"+action, nil)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer resp.Body.Close()
+
+	assertStatusCode(t, resp, expectedStatus)
+}
+
+func findUserInvoicesSuccessfully(user string, expected int, t *testing.T) {
+	t.Log("*** Find User Invoices Successfully")
+
+	api := NewApiClient()
+
+	resp, err := api.Get("/user/" + user + "/invoices")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer resp.Body.Close()
+
+	assertStatusCode(t, resp, http.StatusOK)
+
+	res, err := api.ParseListBody(resp)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if len(res) != expected {
+		t.Fatalf("Invalid Invoices. Expected %d and received %d", expected, len(res))
+	}
+}
+
+func TestInvoiceFlow(t *testing.T) {
+	t.Log("*** Start Invoice Flow")
+
+	merchantID := insertOrderUserSuccessfully(invoiceMerchant(), t)
+	customerID := insertOrderUserSuccessfully(invoiceCustomer(), t)
+
+	paidID := insertInvoiceSuccessfully(merchantID, customerID, t)
+	cancelledID := insertInvoiceSuccessfully(merchantID, customerID, t)
+
+	payInvoiceSuccessfully(paidID, t)
+	changeInvoiceStatus(paidID, "pay", http.StatusBadRequest, t)
+	changeInvoiceStatus(paidID, "cancel", http.StatusBadRequest, t)
+	changeInvoiceStatus(cancelledID, "cancel", http.StatusOK, t)
+	changeInvoiceStatus(cancelledID, "pay", http.StatusBadRequest, t)
+
+	findUserInvoicesSuccessfully(merchantID, 2, t)
+	findUserInvoicesSuccessfully(customerID, 2, t)
+
+	deleteOrderUserSuccessfully(customerID, t)
+	deleteOrderUserSuccessfully(merchantID, t)
+
+	t.Log("*** End Invoice Flow Successful")
+}
@@ -0,0 +1,45 @@
+package e2e
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestMetrics_ShouldExposePrometheusMetrics(t *testing.T) {
+	t.Log("*** Test Metrics")
+
+	api := NewApiClient()
+	resp, err := api.Get("/user/not-an-id")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	resp.Body.Close()
+
+	root := newHealthClient()
+	resp, err = root.Get("/metrics")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer resp.Body.Close()
+
+	assertStatusCode(t, resp, http.StatusOK)
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	expected := []string{
+		`picpay_http_requests_total{method="GET",route="/api/v1/user/:id",status="400"}`,
+		"picpay_http_request_duration_seconds_bucket",
+		"picpay_db_pool_max_connections",
+		"go_goroutines",
+	}
+	for _, metric := range expected {
+		if !strings.Contains(string(body), metric) {
+			t.Fatalf("Metric %s missing from /metrics", metric)
+		}
+	}
+}
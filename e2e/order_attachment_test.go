@@ -0,0 +1,305 @@
+package e2e
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/felipeversiane/picpay-golang.git/internal/entity/request"
+	"github.com/google/uuid"
+)
+
+var attachmentPDF = []byte("%PDF-1.4\n1 0 obj << /Type /Catalog >> endobj\ntrailer << /Root 1 0 R >>\n%%EOF\n")
+
+func attachmentPayer() request.UserRequest {
+	return request.UserRequest{
+		Email:      "attachmentpayer@example.com",
+		Password:   "passwor8!F",
+		FirstName:  "Helena",
+		LastName:   "Dias",
+		Document:   "20123456703",
+		Balance:    100.00,
+		IsMerchant: false,
+	}
+}
+
+func attachmentPayee() request.UserRequest {
+	return request.UserRequest{
+		Email:      "attachmentpayee@example.com",
+		Password:   "passwor8!F",
+		FirstName:  "Caio",
+		LastName:   "Moreira",
+		Document:   "21123456720",
+		Balance:    0.00,
+		IsMerchant: false,
+	}
+}
+
+func TestInsertOrderAttachment_ShouldReturnStatusBadRequest_WhenItHasInvalidData(t *testing.T) {
+	t.Log("*** Test Insert Order Attachment with Invalid Data")
+
+	api := NewApiClient()
+
+	resp, err := api.PostFile("/order/not/attachments", map[string]string{"user_id": uuid.NewString()}, "receipt.pdf", attachmentPDF)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer resp.Body.Close()
+	assertStatusCode(t, resp, http.StatusBadRequest)
+
+	resp, err = api.PostFile("/order/"+uuid.NewString()+"/attachments", map[string]string{"user_id": "not"}, "receipt.pdf", attachmentPDF)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer resp.Body.Close()
+	assertStatusCode(t, resp, http.StatusBadRequest)
+
+	resp, err = api.PostFile("/order/"+uuid.NewString()+"/attachments", map[string]string{"user_id": uuid.NewString()}, "receipt.pdf", nil)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer resp.Body.Close()
+	assertStatusCode(t, resp, http.StatusBadRequest)
+}
+
+func insertOrderWithMessageSuccessfully(payer string, payee string, t *testing.T) string {
+	t.Log("*** Insert Order With Message Successfully")
+
+	api := NewApiClient()
+
+	payload := map[string]interface{}{
+		"amount":  10.00,
+		"payer":   payer,
+		"payee":   payee,
+		"message": "  Valeu pelo almoço,   porra!  ",
+	}
+
+	for {
+		resp, err := api.Post("/order", payload)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		defer resp.Body.Close()
+
+		res, err := api.ParseBody(resp)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		if resp.StatusCode == http.StatusBadRequest && res["message"] == "Order not authorized" {
+			t.Log("Order not authorized, retrying...")
+			continue
+		}
+		if resp.StatusCode != http.StatusCreated {
+			t.Fatalf("Invalid Status Code. Expected %d and received %d: %v", http.StatusCreated, resp.StatusCode, res["message"])
+		}
+
+		if res["message"].(string) != "Valeu pelo almoço, *****!" {
+			t.Fatalf("Invalid Message. Received %q", res["message"])
+		}
+
+		return res["id"].(string)
+	}
+}
+
+func insertOrderWithLinkInMessage(payer string, payee string, t *testing.T) {
+	t.Log("*** Insert Order With Link In Message")
+
+	api := NewApiClient()
+
+	payload := map[string]interface{}{
+		"amount":  10.00,
+		"payer":   payer,
+		"payee":   payee,
+		"message": "Pay the rest at www.example.com",
+	}
+
+	resp, err := api.Post("/order", payload)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer resp.Body.Close()
+
+	assertStatusCode(t, resp, http.StatusBadRequest)
+}
+
+func insertOrderAttachmentSuccessfully(orderID string, userID string, t *testing.T) string {
+	t.Log("*** Insert Order Attachment Successfully")
+
+	api := NewApiClient()
+
+	resp, err := api.PostFile("/order/"+orderID+"/attachments", map[string]string{"user_id": userID}, "receipt.pdf", attachmentPDF)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer resp.Body.Close()
+
+	assertStatusCode(t, resp, http.StatusCreated)
+
+	res, err := api.ParseBody(resp)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if res["content_type"].(string) != "application/pdf" {
+		t.Fatalf("Invalid Content Type. Expected application/pdf and received %v", res["content_type"])
+	}
+	if res["file_name"].(string) != "receipt.pdf" {
+		t.Fatalf("Invalid File Name. Expected receipt.pdf and received %v", res["file_name"])
+	}
+	if res["size"].(float64) != float64(len(attachmentPDF)) {
+		t.Fatalf("Invalid Size. Expected %d and received %v", len(attachmentPDF), res["size"])
+	}
+
+	return res["id"].(string)
+}
+
+func insertOrderAttachmentForbidden(orderID string, t *testing.T) {
+	t.Log("*** Insert Order Attachment By Another User")
+
+	api := NewApiClient()
+
+	resp, err := api.PostFile("/order/"+orderID+"/attachments", map[string]string{"user_id": uuid.NewString()}, "receipt.pdf", attachmentPDF)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer resp.Body.Close()
+
+	assertStatusCode(t, resp, http.StatusForbidden)
+}
+
+func insertOrderAttachmentWithInvalidType(orderID string, userID string, t *testing.T) {
+	t.Log("*** Insert Order Attachment With Invalid Type")
+
+	api := NewApiClient()
+
+	resp, err := api.PostFile("/order/"+orderID+"/attachments", map[string]string{"user_id": userID}, "receipt.pdf", []byte("#!/bin/sh\necho hi\n"))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer resp.Body.Close()
+
+	assertStatusCode(t, resp, http.StatusBadRequest)
+}
+
+func findOrderAttachmentsSuccessfully(orderID string, attachmentID string, userID string, t *testing.T) {
+	t.Log("*** Find Order Attachments Successfully")
+
+	api := NewApiClient()
+
+	resp, err := api.Get("/order/" + orderID + "/attachments?user_id=" + userID)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer resp.Body.Close()
+
+	assertStatusCode(t, resp, http.StatusOK)
+
+	res, err := api.ParseListBody(resp)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(res) != 1 || res[0]["id"].(string) != attachmentID {
+		t.Fatalf("Invalid Attachments. Expected %s and received %v", attachmentID, res)
+	}
+
+	resp, err = api.Get("/order/" + orderID + "/attachments/" + attachmentID + "?user_id=" + userID)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer resp.Body.Close()
+
+	assertStatusCode(t, resp, http.StatusOK)
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if !bytes.Equal(body, attachmentPDF) {
+		t.Fatal("Invalid Attachment Content")
+	}
+
+	resp, err = api.Get("/order/" + orderID + "/attachments/" + uuid.NewString() + "?user_id=" + userID)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer resp.Body.Close()
+
+	assertStatusCode(t, resp, http.StatusNotFound)
+}
+
+func findOrderAttachmentsForbidden(orderID string, attachmentID string, t *testing.T) {
+	t.Log("*** Find Order Attachments By Another User")
+
+	api := NewApiClient()
+
+	resp, err := api.Get("/order/" + orderID + "/attachments?user_id=" + uuid.NewString())
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer resp.Body.Close()
+
+	assertStatusCode(t, resp, http.StatusForbidden)
+
+	resp, err = api.Get("/order/" + orderID + "/attachments/" + attachmentID + "?user_id=" + uuid.NewString())
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer resp.Body.Close()
+
+	assertStatusCode(t, resp, http.StatusForbidden)
+
+	resp, err = api.Get("/order/" + orderID + "/attachments")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer resp.Body.Close()
+
+	assertStatusCode(t, resp, http.StatusBadRequest)
+}
+
+func findOrderWithMessageSuccessfully(orderID string, t *testing.T) {
+	t.Log("*** Find Order With Message Successfully")
+
+	api := NewApiClient()
+
+	resp, err := api.Get("/order/" + orderID)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer resp.Body.Close()
+
+	assertStatusCode(t, resp, http.StatusOK)
+
+	var res map[string]interface{}
+	if err := json.NewDecoder(resp.Body).Decode(&res); err != nil {
+		t.Fatal(err.Error())
+	}
+	if res["message"].(string) != "Valeu pelo almoço, *****!" {
+		t.Fatalf("Invalid Message. Received %q", res["message"])
+	}
+}
+
+func TestOrderAttachmentFlow(t *testing.T) {
+	t.Log("*** Start Order Attachment Flow")
+
+	payerID := insertOrderUserSuccessfully(attachmentPayer(), t)
+	payeeID := insertOrderUserSuccessfully(attachmentPayee(), t)
+
+	insertOrderWithLinkInMessage(payerID, payeeID, t)
+	orderID := insertOrderWithMessageSuccessfully(payerID, payeeID, t)
+	findOrderWithMessageSuccessfully(orderID, t)
+
+	insertOrderAttachmentForbidden(orderID, t)
+	insertOrderAttachmentWithInvalidType(orderID, payeeID, t)
+	attachmentID := insertOrderAttachmentSuccessfully(orderID, payerID, t)
+	findOrderAttachmentsSuccessfully(orderID, attachmentID, payeeID, t)
+	findOrderAttachmentsForbidden(orderID, attachmentID, t)
+
+	deleteOrderUserSuccessfully(payerID, t)
+	deleteOrderUserSuccessfully(payeeID, t)
+
+	t.Log("*** End Order Attachment Flow Successful")
+}
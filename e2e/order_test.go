@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"io"
 	"net/http"
+	"strings"
 	"testing"
 
 	"github.com/felipeversiane/picpay-golang.git/internal/entity/request"
@@ -16,7 +17,7 @@ func firstUser() request.UserRequest {
 		Password:   "passwor8!F",
 		FirstName:  "Oliveira",
 		LastName:   "Silva",
-		Document:   "992375832",
+		Document:   "99.237.583/0001-36",
 		Balance:    1000.00,
 		IsMerchant: true,
 	}
@@ -28,11 +29,23 @@ func secondUser() request.UserRequest {
 		Password:   "passwor8!F",
 		FirstName:  "Pedro",
 		LastName:   "Silva",
-		Document:   "323467222",
+		Document:   "32346722294",
 		Balance:    200.00,
 		IsMerchant: false,
 	}
 }
+func thirdUser() request.UserRequest {
+	return request.UserRequest{
+		Email:      "marianalimits@example.com",
+		Password:   "passwor8!F",
+		FirstName:  "Mariana",
+		LastName:   "Costa",
+		Document:   "77531024861",
+		Balance:    5000.00,
+		IsMerchant: false,
+	}
+}
+
 func TestInsertOrder_ShouldReturnStatusBadRequest_WhenItHasInvalidData(t *testing.T) {
 	t.Log("*** Test Insert Order with Invalid Data")
 
@@ -44,6 +57,7 @@ func TestInsertOrder_ShouldReturnStatusBadRequest_WhenItHasInvalidData(t *testin
 		{"payee": uuid.NewString(), "payer": "not", "amount": 100.00},
 		{"payee": "not", "payer": uuid.NewString(), "amount": 100.00},
 		{"payee": uuid.NewString(), "payer": uuid.NewString(), "amount": -100.00},
+		{"payee": uuid.NewString(), "payer": uuid.NewString(), "amount": 100.00, "message": strings.Repeat("a", 141)},
 	}
 
 	for _, p := range params {
@@ -106,6 +120,42 @@ func InsertOrder_ShouldReturnStatusBadRequest_MerchantCannotSendMoney(payer stri
 	assertStatusCode(t, resp, http.StatusBadRequest)
 }
 
+func InsertOrder_ShouldReturnStatusUnprocessableEntity_PerTransactionLimitExceeded(payer string, payee string, t *testing.T) {
+	t.Log("*** Test Insert Order above the Per Transaction Limit")
+
+	api := NewApiClient()
+
+	payload := map[string]interface{}{
+		"amount": 1500.00,
+		"payer":  payer,
+		"payee":  payee,
+	}
+
+	for {
+		resp, err := api.Post("/order", payload)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		defer resp.Body.Close()
+
+		res, err := api.ParseBody(resp)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		if resp.StatusCode == http.StatusBadRequest && res["message"] == "Order not authorized" {
+			t.Log("Order not authorized, retrying...")
+			continue
+		}
+
+		assertStatusCode(t, resp, http.StatusUnprocessableEntity)
+		if res["error"] != "per_transaction_limit_exceeded" {
+			t.Fatalf("Invalid Error Code: %s", res["error"])
+		}
+		return
+	}
+}
+
 func insertOrderSuccessfully(payer string, payee string, t *testing.T) string {
 	t.Log("*** Insert Order Successfully")
 
@@ -228,13 +278,14 @@ func insertOrderUserSuccessfully(user request.UserRequest, t *testing.T) string
 	api := NewApiClient()
 
 	payload := map[string]interface{}{
-		"email":       user.Email,
-		"password":    user.Password,
-		"first_name":  user.FirstName,
-		"last_name":   user.LastName,
-		"document":    user.Document,
-		"balance":     user.Balance,
-		"is_merchant": user.IsMerchant,
+		"email":             user.Email,
+		"password":          user.Password,
+		"first_name":        user.FirstName,
+		"last_name":         user.LastName,
+		"document":          user.Document,
+		"balance":           user.Balance,
+		"is_merchant":       user.IsMerchant,
+		"merchant_category": user.MerchantCategory,
 	}
 
 	resp, err := api.Post("/user", payload)
@@ -306,15 +357,20 @@ func TestOrderFlow(t *testing.T) {
 	firstUser := firstUser()
 	secondUser := secondUser()
 
+	thirdUser := thirdUser()
+
 	firstID := insertOrderUserSuccessfully(firstUser, t)
 	secondID := insertOrderUserSuccessfully(secondUser, t)
+	thirdID := insertOrderUserSuccessfully(thirdUser, t)
 	InsertOrder_ShouldReturnStatusBadRequest_InsufficientBalance(secondID, firstID, t)
 	InsertOrder_ShouldReturnStatusBadRequest_MerchantCannotSendMoney(firstID, secondID, t)
+	InsertOrder_ShouldReturnStatusUnprocessableEntity_PerTransactionLimitExceeded(thirdID, firstID, t)
 	orderID := insertOrderSuccessfully(secondID, firstID, t)
 	findOrderSuccessfully(orderID, t)
 
 	deleteOrderUserSuccessfully(firstID, t)
 	deleteOrderUserSuccessfully(secondID, t)
+	deleteOrderUserSuccessfully(thirdID, t)
 
 	t.Log("*** End Order Flow Successful")
 }
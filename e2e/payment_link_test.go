@@ -0,0 +1,231 @@
+package e2e
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/felipeversiane/picpay-golang.git/internal/entity/request"
+	"github.com/google/uuid"
+)
+
+func paymentLinkMerchant() request.UserRequest {
+	return request.UserRequest{
+		Email:            "paymentlinkmerchant@example.com",
+		Password:         "passwor8!F",
+		FirstName:        "Doceria",
+		LastName:         "Bela",
+		Document:         "12876543000156",
+		Balance:          0.00,
+		IsMerchant:       true,
+		MerchantCategory: "food",
+	}
+}
+
+func paymentLinkCustomer() request.UserRequest {
+	return request.UserRequest{
+		Email:      "paymentlinkcustomer@example.com",
+		Password:   "passwor8!F",
+		FirstName:  "Karina",
+		LastName:   "Duarte",
+		Document:   "17123456726",
+		Balance:    300.00,
+		IsMerchant: false,
+	}
+}
+
+func TestInsertPaymentLink_ShouldReturnStatusBadRequest_WhenItHasInvalidData(t *testing.T) {
+	t.Log("*** Test Insert Payment Link with Invalid Data")
+
+	api := NewApiClient()
+	params := []map[string]interface{}{
+		nil,
+		{},
+		{"other": "value"},
+		{"merchant_id": "not", "description": "Cake"},
+		{"merchant_id": uuid.NewString()},
+		{"merchant_id": uuid.NewString(), "description": "Cake", "amount": -1},
+		{"merchant_id": uuid.NewString(), "description": "Cake", "expires_in": -1},
+	}
+
+	for _, p := range params {
+		resp, err := api.Post("/payment_link", p)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		defer resp.Body.Close()
+		assertStatusCode(t, resp, http.StatusBadRequest)
+	}
+}
+
+func TestResolvePaymentLink_ShouldReturnStatusNotFound_WhenLinkIsNotOnDatabase(t *testing.T) {
+	t.Log("*** Test Resolve Payment Link when Link is not on Database")
+
+	api := NewApiClient()
+
+	resp, err := api.Get("/payment_link/public/unknown")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer resp.Body.Close()
+	assertStatusCode(t, resp, http.StatusNotFound)
+}
+
+func insertPaymentLinkSuccessfully(payload map[string]interface{}, t *testing.T) (string, string) {
+	t.Log("*** Insert Payment Link Successfully")
+
+	api := NewApiClient()
+
+	resp, err := api.Post("/payment_link", payload)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer resp.Body.Close()
+
+	assertStatusCode(t, resp, http.StatusCreated)
+
+	res, err := api.ParseBody(resp)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if res["status"].(string) != "active" {
+		t.Fatalf("Invalid Status. Expected active and received %s", res["status"])
+	}
+
+	return res["id"].(string), res["slug"].(string)
+}
+
+func resolvePaymentLinkSuccessfully(slug string, t *testing.T) {
+	t.Log("*** Resolve Payment Link Successfully")
+
+	api := NewApiClient()
+
+	resp, err := api.Get("/payment_link/public/" + slug)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer resp.Body.Close()
+
+	assertStatusCode(t, resp, http.StatusOK)
+
+	res, err := api.ParseBody(resp)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if res["merchant_name"].(string) != "Doceria Bela" {
+		t.Fatalf("Invalid Merchant Name. Expected Doceria Bela and received %s", res["merchant_name"])
+	}
+	if res["amount"].(float64) != 45.00 {
+		t.Fatalf("Invalid Amount. Expected 45.00 and received %f", res["amount"])
+	}
+}
+
+func payPaymentLinkSuccessfully(slug string, payer string, payee string, amount float64, t *testing.T) {
+	t.Log("*** Pay Payment Link Successfully")
+
+	api := NewApiClient()
+
+	payload := map[string]interface{}{
+		"payer":  payer,
+		"amount": amount,
+	}
+
+	for {
+		resp, err := api.Post("/payment_link/public/"+slug+"/pay", payload)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		defer resp.Body.Close()
+
+		res, err := api.ParseBody(resp)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		if resp.StatusCode == http.StatusBadRequest && res["message"] == "Order not authorized" {
+			t.Log("Order not authorized, retrying...")
+			continue
+		}
+
+		assertStatusCode(t, resp, http.StatusCreated)
+
+		if res["payee"].(string) != payee {
+			t.Fatal("Invalid Payee")
+		}
+		if res["amount"].(float64) != amount {
+			t.Fatalf("Invalid Amount. Expected %f and received %f", amount, res["amount"])
+		}
+		return
+	}
+}
+
+func payPaymentLinkShouldFail(slug string, payer string, t *testing.T) {
+	t.Log("*** Pay Single-Use Payment Link Again should Fail")
+
+	api := NewApiClient()
+
+	resp, err := api.Post("/payment_link/public/"+slug+"/pay", map[string]interface{}{"payer": payer})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer resp.Body.Close()
+
+	assertStatusCode(t, resp, http.StatusBadRequest)
+}
+
+func findPaymentLinkPaymentsSuccessfully(id string, expected int, t *testing.T) {
+	t.Log("*** Find Payment Link Payments Successfully")
+
+	api := NewApiClient()
+
+	resp, err := api.Get("/payment_link/" + id + "/payments")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer resp.Body.Close()
+
+	assertStatusCode(t, resp, http.StatusOK)
+
+	res, err := api.ParseListBody(resp)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if len(res) != expected {
+		t.Fatalf("Invalid Payments. Expected %d and received %d", expected, len(res))
+	}
+}
+
+func TestPaymentLinkFlow(t *testing.T) {
+	t.Log("*** Start Payment Link Flow")
+
+	merchantID := insertOrderUserSuccessfully(paymentLinkMerchant(), t)
+	customerID := insertOrderUserSuccessfully(paymentLinkCustomer(), t)
+
+	fixedID, fixedSlug := insertPaymentLinkSuccessfully(map[string]interface{}{
+		"merchant_id": merchantID,
+		"description": "Chocolate cake",
+		"amount":      45.00,
+		"expires_in":  3600,
+	}, t)
+	openID, openSlug := insertPaymentLinkSuccessfully(map[string]interface{}{
+		"merchant_id": merchantID,
+		"description": "Tips",
+		"reusable":    true,
+	}, t)
+
+	resolvePaymentLinkSuccessfully(fixedSlug, t)
+	payPaymentLinkSuccessfully(fixedSlug, customerID, merchantID, 45.00, t)
+	payPaymentLinkShouldFail(fixedSlug, customerID, t)
+	payPaymentLinkSuccessfully(openSlug, customerID, merchantID, 5.00, t)
+	payPaymentLinkSuccessfully(openSlug, customerID, merchantID, 7.50, t)
+
+	findPaymentLinkPaymentsSuccessfully(fixedID, 1, t)
+	findPaymentLinkPaymentsSuccessfully(openID, 2, t)
+
+	deleteOrderUserSuccessfully(customerID, t)
+	deleteOrderUserSuccessfully(merchantID, t)
+
+	t.Log("*** End Payment Link Flow Successful")
+}
@@ -0,0 +1,204 @@
+package e2e
+
+import (
+	"math"
+	"net/http"
+	"testing"
+
+	"github.com/felipeversiane/picpay-golang.git/internal/entity/request"
+	"github.com/google/uuid"
+)
+
+func paymentRequester() request.UserRequest {
+	return request.UserRequest{
+		Email:      "paymentrequester@example.com",
+		Password:   "passwor8!F",
+		FirstName:  "Carla",
+		LastName:   "Mendes",
+		Document:   "12123456730",
+		Balance:    0.00,
+		IsMerchant: false,
+	}
+}
+
+func paymentRequestPayer() request.UserRequest {
+	return request.UserRequest{
+		Email:      "paymentrequestpayer@example.com",
+		Password:   "passwor8!F",
+		FirstName:  "Diego",
+		LastName:   "Almeida",
+		Document:   "13123456757",
+		Balance:    300.00,
+		IsMerchant: false,
+	}
+}
+
+func TestInsertPaymentRequest_ShouldReturnStatusBadRequest_WhenItHasInvalidData(t *testing.T) {
+	t.Log("*** Test Insert Payment Request with Invalid Data")
+
+	api := NewApiClient()
+	params := []map[string]interface{}{
+		nil,
+		{},
+		{"other": "value"},
+		{"requester": "not", "payer": uuid.NewString(), "amount": 10.00},
+		{"requester": uuid.NewString(), "payer": uuid.NewString(), "amount": 0},
+		{"requester": uuid.NewString(), "payer": uuid.NewString(), "amount": 10.00, "expires_in": -1},
+		{"requester": uuid.NewString(), "payer": uuid.NewString(), "amount": 10.00},
+	}
+
+	for _, p := range params {
+		resp, err := api.Post("/payment_request", p)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		defer resp.Body.Close()
+		assertStatusCode(t, resp, http.StatusBadRequest)
+	}
+}
+
+func TestFindPaymentRequest_ShouldReturnStatusNotFound_WhenRequestIsNotOnDatabase(t *testing.T) {
+	t.Log("*** Test Find Payment Request when Request is not on Database")
+
+	api := NewApiClient()
+
+	resp, err := api.Get("/payment_request/" + uuid.NewString())
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer resp.Body.Close()
+	assertStatusCode(t, resp, http.StatusNotFound)
+}
+
+func insertPaymentRequestSuccessfully(requester string, payer string, amount float64, t *testing.T) string {
+	t.Log("*** Insert Payment Request Successfully")
+
+	api := NewApiClient()
+
+	payload := map[string]interface{}{
+		"requester": requester,
+		"payer":     payer,
+		"amount":    amount,
+		"note":      "Pizza on friday",
+	}
+
+	resp, err := api.Post("/payment_request", payload)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer resp.Body.Close()
+
+	assertStatusCode(t, resp, http.StatusCreated)
+
+	res, err := api.ParseBody(resp)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if res["status"].(string) != "pending" {
+		t.Fatalf("Invalid Status. Expected pending and received %s", res["status"])
+	}
+
+	return res["id"].(string)
+}
+
+func findPendingPaymentRequestsSuccessfully(user string, expected int, t *testing.T) {
+	t.Log("*** Find Pending Payment Requests Successfully")
+
+	api := NewApiClient()
+
+	resp, err := api.Get("/user/" + user + "/payment_requests")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer resp.Body.Close()
+
+	assertStatusCode(t, resp, http.StatusOK)
+
+	res, err := api.ParseListBody(resp)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if len(res) != expected {
+		t.Fatalf("Invalid Pending Requests. Expected %d and received %d", expected, len(res))
+	}
+}
+
+func acceptPaymentRequestSuccessfully(id string, requester string, amount float64, t *testing.T) {
+	t.Log("*** Accept Payment Request Successfully")
+
+	api := NewApiClient()
+
+	initialRequesterBalance := getUserBalance(requester, t)
+
+	for {
+		resp, err := api.Put("/payment_request/"+id+"/accept", nil)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		defer resp.Body.Close()
+
+		res, err := api.ParseBody(resp)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		if resp.StatusCode == http.StatusBadRequest && res["message"] == "Order not authorized" {
+			t.Log("Order not authorized, retrying...")
+			continue
+		}
+
+		assertStatusCode(t, resp, http.StatusOK)
+
+		if res["status"].(string) != "accepted" {
+			t.Fatalf("Invalid Status. Expected accepted and received %s", res["status"])
+		}
+		if res["order_id"] == nil {
+			t.Fatal("Order not linked to the payment request")
+		}
+		if finalRequesterBalance := getUserBalance(requester, t); math.Round(finalRequesterBalance*100) != math.Round((initialRequesterBalance+amount)*100) {
+			t.Fatalf("Requester balance incorrect. Expected %f but got %f", initialRequesterBalance+amount, finalRequesterBalance)
+		}
+		return
+	}
+}
+
+func changePaymentRequestStatus(id string, action string, expectedStatus int, t *testing.T) {
+	t.Logf("*** Change Payment Request Status to %s", action)
+
+	api := NewApiClient()
+
+	resp, err := api.Put("/payment_request/"+id+"/"+action, nil)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer resp.Body.Close()
+
+	assertStatusCode(t, resp, expectedStatus)
+}
+
+func TestPaymentRequestFlow(t *testing.T) {
+	t.Log("*** Start Payment Request Flow")
+
+	requesterID := insertOrderUserSuccessfully(paymentRequester(), t)
+	payerID := insertOrderUserSuccessfully(paymentRequestPayer(), t)
+
+	acceptedID := insertPaymentRequestSuccessfully(requesterID, payerID, 35.00, t)
+	declinedID := insertPaymentRequestSuccessfully(requesterID, payerID, 20.00, t)
+
+	findPendingPaymentRequestsSuccessfully(requesterID, 2, t)
+	findPendingPaymentRequestsSuccessfully(payerID, 2, t)
+
+	acceptPaymentRequestSuccessfully(acceptedID, requesterID, 35.00, t)
+	changePaymentRequestStatus(acceptedID, "accept", http.StatusBadRequest, t)
+	changePaymentRequestStatus(declinedID, "decline", http.StatusOK, t)
+	changePaymentRequestStatus(declinedID, "accept", http.StatusBadRequest, t)
+
+	findPendingPaymentRequestsSuccessfully(payerID, 0, t)
+
+	deleteOrderUserSuccessfully(requesterID, t)
+	deleteOrderUserSuccessfully(payerID, t)
+
+	t.Log("*** End Payment Request Flow Successful")
+}
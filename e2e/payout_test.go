@@ -0,0 +1,288 @@
+package e2e
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/felipeversiane/picpay-golang.git/internal/entity/request"
+	"github.com/google/uuid"
+)
+
+func payoutMerchant() request.UserRequest {
+	return request.UserRequest{
+		Email:            "payoutmerchant@example.com",
+		Password:         "passwor8!F",
+		FirstName:        "Mercado",
+		LastName:         "Central",
+		Document:         "14876543000181",
+		Balance:          100.00,
+		IsMerchant:       true,
+		MerchantCategory: "marketplace",
+	}
+}
+
+func payoutSeller() request.UserRequest {
+	return request.UserRequest{
+		Email:      "payoutseller@example.com",
+		Password:   "passwor8!F",
+		FirstName:  "Paula",
+		LastName:   "Ramos",
+		Document:   "19123456760",
+		Balance:    0.00,
+		IsMerchant: false,
+	}
+}
+
+func payoutOtherSeller() request.UserRequest {
+	return request.UserRequest{
+		Email:      "payoutotherseller@example.com",
+		Password:   "passwor8!F",
+		FirstName:  "Bruno",
+		LastName:   "Teixeira",
+		Document:   "66123456750",
+		Balance:    0.00,
+		IsMerchant: false,
+	}
+}
+
+func TestInsertPayoutBatch_ShouldReturnStatusBadRequest_WhenItHasInvalidData(t *testing.T) {
+	t.Log("*** Test Insert Payout Batch with Invalid Data")
+
+	api := NewApiClient()
+	items := []map[string]interface{}{{"payee": uuid.NewString(), "amount": 10.00}}
+	params := []map[string]interface{}{
+		nil,
+		{},
+		{"other": "value"},
+		{"payer_id": "not", "items": items},
+		{"payer_id": uuid.NewString(), "items": []map[string]interface{}{}},
+		{"payer_id": uuid.NewString(), "mode": "some", "items": items},
+		{"payer_id": uuid.NewString(), "items": []map[string]interface{}{{"payee": "not", "amount": 10.00}}},
+		{"payer_id": uuid.NewString(), "items": []map[string]interface{}{{"payee": uuid.NewString(), "amount": 0}}},
+		{"payer_id": uuid.NewString(), "items": []map[string]interface{}{{"payee": uuid.NewString(), "amount": 10.001}}},
+	}
+
+	for _, p := range params {
+		resp, err := api.Post("/payouts/batch", p)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		defer resp.Body.Close()
+		assertStatusCode(t, resp, http.StatusBadRequest)
+	}
+
+	files := []string{
+		"",
+		"payee,amount\n",
+		"id,value\n" + uuid.NewString() + ",10.00\n",
+		"payee,amount\n" + uuid.NewString() + ",ten\n",
+		"payee,amount\n" + uuid.NewString() + "\n",
+	}
+
+	for _, f := range files {
+		resp, err := api.PostCSV("/payouts/batch?payer_id="+uuid.NewString(), f)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		defer resp.Body.Close()
+		assertStatusCode(t, resp, http.StatusBadRequest)
+	}
+}
+
+func insertPayoutBatchSuccessfully(merchant string, seller string, otherSeller string, t *testing.T) string {
+	t.Log("*** Insert Payout Batch Successfully")
+
+	api := NewApiClient()
+
+	payload := map[string]interface{}{
+		"payer_id": merchant,
+		"items": []map[string]interface{}{
+			{"payee": seller, "amount": 60.00},
+			{"payee": otherSeller, "amount": 70.00},
+		},
+	}
+
+	resp, err := api.Post("/payouts/batch", payload)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer resp.Body.Close()
+
+	assertStatusCode(t, resp, http.StatusAccepted)
+
+	res, err := api.ParseBody(resp)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if res["mode"].(string) != "partial" {
+		t.Fatalf("Invalid Mode. Expected partial and received %v", res["mode"])
+	}
+	if res["total_items"].(float64) != 2 || res["total_amount"].(float64) != 130.00 {
+		t.Fatalf("Invalid Totals. Expected 2 items of 130.00 and received %v items of %v", res["total_items"], res["total_amount"])
+	}
+
+	return res["id"].(string)
+}
+
+func insertPayoutBatchFromCSVSuccessfully(merchant string, seller string, otherSeller string, t *testing.T) string {
+	t.Log("*** Insert Payout Batch From CSV Successfully")
+
+	api := NewApiClient()
+
+	file := "payee,amount\n" + seller + ",10.00\n" + otherSeller + ",20.00\n"
+
+	resp, err := api.PostCSV("/payouts/batch?payer_id="+merchant+"&mode=all_or_nothing", file)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer resp.Body.Close()
+
+	assertStatusCode(t, resp, http.StatusAccepted)
+
+	res, err := api.ParseBody(resp)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if res["mode"].(string) != "all_or_nothing" {
+		t.Fatalf("Invalid Mode. Expected all_or_nothing and received %v", res["mode"])
+	}
+
+	return res["id"].(string)
+}
+
+func insertPayoutBatchWithInsufficientBalance(merchant string, seller string, t *testing.T) {
+	t.Log("*** Insert All Or Nothing Payout Batch With Insufficient Balance")
+
+	api := NewApiClient()
+
+	payload := map[string]interface{}{
+		"payer_id": merchant,
+		"mode":     "all_or_nothing",
+		"items":    []map[string]interface{}{{"payee": seller, "amount": 50.00}},
+	}
+
+	resp, err := api.Post("/payouts/batch", payload)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer resp.Body.Close()
+
+	assertStatusCode(t, resp, http.StatusBadRequest)
+
+	payload["items"] = []map[string]interface{}{{"payee": merchant, "amount": 1.00}}
+
+	resp, err = api.Post("/payouts/batch", payload)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer resp.Body.Close()
+
+	assertStatusCode(t, resp, http.StatusBadRequest)
+}
+
+func waitPayoutBatchCompleted(id string, succeeded float64, failed float64, t *testing.T) {
+	t.Log("*** Wait Payout Batch Completed")
+
+	api := NewApiClient()
+
+	for attempt := 0; attempt < 30; attempt++ {
+		resp, err := api.Get("/payouts/batch/" + id)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		assertStatusCode(t, resp, http.StatusOK)
+
+		res, err := api.ParseBody(resp)
+		resp.Body.Close()
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		if res["status"].(string) == "completed" {
+			if res["succeeded_items"].(float64) != succeeded || res["failed_items"].(float64) != failed {
+				t.Fatalf("Invalid Item Counts. Expected %v/%v and received %v/%v", succeeded, failed, res["succeeded_items"], res["failed_items"])
+			}
+			return
+		}
+		if res["status"].(string) == "failed" {
+			t.Fatal("Payout batch failed")
+		}
+
+		time.Sleep(time.Second)
+	}
+
+	t.Fatal("Payout batch was not processed in time")
+}
+
+func downloadPayoutBatchResultSuccessfully(id string, expected []string, t *testing.T) {
+	t.Log("*** Download Payout Batch Result Successfully")
+
+	api := NewApiClient()
+
+	resp, err := api.Get("/payouts/batch/" + id + "/result")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer resp.Body.Close()
+
+	assertStatusCode(t, resp, http.StatusOK)
+
+	if !strings.HasPrefix(resp.Header.Get("Content-Disposition"), "attachment") {
+		t.Fatalf("Invalid Content-Disposition. Received %q", resp.Header.Get("Content-Disposition"))
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	lines := strings.Split(strings.TrimSpace(string(body)), "\n")
+	if len(lines) != len(expected)+1 {
+		t.Fatalf("Invalid number of result lines. Expected %d and received %d", len(expected)+1, len(lines))
+	}
+	for i, suffix := range expected {
+		if !strings.HasSuffix(lines[i+1], suffix) {
+			t.Fatalf("Invalid result line %d. Expected suffix %q and received %q", i, suffix, lines[i+1])
+		}
+	}
+}
+
+func TestPayoutFlow(t *testing.T) {
+	t.Log("*** Start Payout Flow")
+
+	merchantID := insertOrderUserSuccessfully(payoutMerchant(), t)
+	sellerID := insertOrderUserSuccessfully(payoutSeller(), t)
+	otherSellerID := insertOrderUserSuccessfully(payoutOtherSeller(), t)
+
+	batchID := insertPayoutBatchSuccessfully(merchantID, sellerID, otherSellerID, t)
+	waitPayoutBatchCompleted(batchID, 1, 1, t)
+	downloadPayoutBatchResultSuccessfully(batchID, []string{",60.00,succeeded,", ",70.00,failed,Insufficient balance"}, t)
+
+	if balance := getUserBalance(merchantID, t); balance != 40.00 {
+		t.Fatalf("Merchant balance incorrect. Expected 40.00 but got %v", balance)
+	}
+
+	csvBatchID := insertPayoutBatchFromCSVSuccessfully(merchantID, sellerID, otherSellerID, t)
+	waitPayoutBatchCompleted(csvBatchID, 2, 0, t)
+	downloadPayoutBatchResultSuccessfully(csvBatchID, []string{",10.00,succeeded,", ",20.00,succeeded,"}, t)
+
+	if balance := getUserBalance(sellerID, t); balance != 70.00 {
+		t.Fatalf("Seller balance incorrect. Expected 70.00 but got %v", balance)
+	}
+	if balance := getUserBalance(otherSellerID, t); balance != 20.00 {
+		t.Fatalf("Other seller balance incorrect. Expected 20.00 but got %v", balance)
+	}
+
+	insertPayoutBatchWithInsufficientBalance(merchantID, sellerID, t)
+
+	deleteOrderUserSuccessfully(merchantID, t)
+	deleteOrderUserSuccessfully(sellerID, t)
+	deleteOrderUserSuccessfully(otherSellerID, t)
+
+	t.Log("*** End Payout Flow Successful")
+}
@@ -0,0 +1,252 @@
+package e2e
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/felipeversiane/picpay-golang.git/internal/entity/request"
+	"github.com/google/uuid"
+)
+
+func pixKeyPayer() request.UserRequest {
+	return request.UserRequest{
+		Email:      "pixkeypayer@example.com",
+		Password:   "passwor8!F",
+		FirstName:  "Ana",
+		LastName:   "Ribeiro",
+		Document:   "44123456707",
+		Balance:    300.00,
+		IsMerchant: false,
+	}
+}
+
+func pixKeyPayee() request.UserRequest {
+	return request.UserRequest{
+		Email:      "pixkeypayee@example.com",
+		Password:   "passwor8!F",
+		FirstName:  "Bruno",
+		LastName:   "Teixeira",
+		Document:   "44123456880",
+		Balance:    0.00,
+		IsMerchant: false,
+	}
+}
+
+func TestInsertPixKey_ShouldReturnStatusBadRequest_WhenItHasInvalidData(t *testing.T) {
+	t.Log("*** Test Insert Key with Invalid Data")
+
+	api := NewApiClient()
+	params := []map[string]interface{}{
+		nil,
+		{},
+		{"other": "value"},
+		{"user_id": "not", "key_type": "evp"},
+		{"user_id": uuid.NewString(), "key_type": "bitcoin"},
+		{"user_id": uuid.NewString(), "key_type": "email"},
+		{"user_id": uuid.NewString(), "key_type": "phone"},
+	}
+
+	for _, p := range params {
+		resp, err := api.Post("/pix_key", p)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		defer resp.Body.Close()
+		assertStatusCode(t, resp, http.StatusBadRequest)
+	}
+}
+
+func TestLookupPixKey_ShouldReturnStatusNotFound_WhenKeyIsNotOnDatabase(t *testing.T) {
+	t.Log("*** Test Lookup Key when Key is not on Database")
+
+	api := NewApiClient()
+
+	resp, err := api.Get("/pix_key/lookup/" + uuid.NewString())
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer resp.Body.Close()
+	assertStatusCode(t, resp, http.StatusNotFound)
+}
+
+func insertPixKeySuccessfully(user string, keyType string, key string, expectedStatus string, t *testing.T) (string, string) {
+	t.Logf("*** Insert %s Key Successfully", keyType)
+
+	api := NewApiClient()
+
+	payload := map[string]interface{}{
+		"user_id":  user,
+		"key_type": keyType,
+		"key":      key,
+	}
+
+	resp, err := api.Post("/pix_key", payload)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer resp.Body.Close()
+
+	assertStatusCode(t, resp, http.StatusCreated)
+
+	res, err := api.ParseBody(resp)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if res["status"].(string) != expectedStatus {
+		t.Fatalf("Invalid Status. Expected %s and received %s", expectedStatus, res["status"])
+	}
+
+	return res["id"].(string), res["key"].(string)
+}
+
+func verifyPixKeyWithInvalidCode(id string, t *testing.T) {
+	t.Log("*** Verify Key with Invalid Code")
+
+	api := NewApiClient()
+
+	resp, err := api.Put("/pix_key/"+id+"/verify", map[string]interface{}{"code": "000000"})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer resp.Body.Close()
+
+	assertStatusCode(t, resp, http.StatusBadRequest)
+}
+
+func lookupPixKeySuccessfully(key string, t *testing.T) {
+	t.Log("*** Lookup Key Successfully")
+
+	api := NewApiClient()
+
+	resp, err := api.Get("/pix_key/lookup/" + key)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer resp.Body.Close()
+
+	assertStatusCode(t, resp, http.StatusOK)
+
+	res, err := api.ParseBody(resp)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if res["owner_name"].(string) != "Bruno T." {
+		t.Fatalf("Invalid Owner Name. Expected Bruno T. and received %s", res["owner_name"])
+	}
+	if res["owner_document"].(string) != "***.234.568-**" {
+		t.Fatalf("Invalid Owner Document. Expected ***.234.568-** and received %s", res["owner_document"])
+	}
+}
+
+func insertOrderWithPixKeySuccessfully(payer string, payee string, key string, t *testing.T) {
+	t.Log("*** Insert Order with Payee Key Successfully")
+
+	api := NewApiClient()
+
+	payload := map[string]interface{}{
+		"amount":    50.00,
+		"payer":     payer,
+		"payee_key": key,
+	}
+
+	initialPayeeBalance := getUserBalance(payee, t)
+
+	for {
+		resp, err := api.Post("/order", payload)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		defer resp.Body.Close()
+
+		res, err := api.ParseBody(resp)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		if resp.StatusCode == http.StatusBadRequest && res["message"] == "Order not authorized" {
+			t.Log("Order not authorized, retrying...")
+			continue
+		}
+
+		assertStatusCode(t, resp, http.StatusCreated)
+
+		if res["payee"].(string) != payee {
+			t.Fatal("Invalid Payee")
+		}
+		if finalPayeeBalance := getUserBalance(payee, t); finalPayeeBalance != initialPayeeBalance+50.00 {
+			t.Fatalf("Payee balance incorrect. Expected %f but got %f", initialPayeeBalance+50.00, finalPayeeBalance)
+		}
+		return
+	}
+}
+
+func TestCancelPixKeyClaim_ShouldRequireTheCallingUser(t *testing.T) {
+	t.Log("*** Test Cancel Key Claim without and with user_id")
+
+	api := NewApiClient()
+
+	resp, err := api.Put("/pix_key/claim/"+uuid.NewString()+"/cancel", nil)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer resp.Body.Close()
+	assertStatusCode(t, resp, http.StatusBadRequest)
+
+	resp, err = api.Put("/pix_key/claim/"+uuid.NewString()+"/cancel?user_id="+uuid.NewString(), nil)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer resp.Body.Close()
+	assertStatusCode(t, resp, http.StatusNotFound)
+}
+
+func claimDocumentKeyShouldFail(claimer string, key string, t *testing.T) {
+	t.Log("*** Claim Document Key should Fail")
+
+	api := NewApiClient()
+
+	resp, err := api.Post("/pix_key/claim", map[string]interface{}{"claimer_id": claimer, "key": key})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer resp.Body.Close()
+
+	assertStatusCode(t, resp, http.StatusBadRequest)
+}
+
+func deletePixKeySuccessfully(id string, t *testing.T) {
+	t.Log("*** Delete Key Successfully")
+
+	api := NewApiClient()
+
+	resp, err := api.Delete("/pix_key/" + id)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	assertStatusCode(t, resp, http.StatusNoContent)
+}
+
+func TestPixKeyFlow(t *testing.T) {
+	t.Log("*** Start Key Flow")
+
+	payerID := insertOrderUserSuccessfully(pixKeyPayer(), t)
+	payeeID := insertOrderUserSuccessfully(pixKeyPayee(), t)
+
+	_, documentKey := insertPixKeySuccessfully(payeeID, "document", "441.234.568-80", "active", t)
+	evpID, evpKey := insertPixKeySuccessfully(payeeID, "evp", "", "active", t)
+	emailID, _ := insertPixKeySuccessfully(payeeID, "email", "PixKeyPayee@Example.com", "pending", t)
+
+	verifyPixKeyWithInvalidCode(emailID, t)
+	lookupPixKeySuccessfully("441.234.568-80", t)
+	insertOrderWithPixKeySuccessfully(payerID, payeeID, evpKey, t)
+	claimDocumentKeyShouldFail(payerID, documentKey, t)
+	deletePixKeySuccessfully(evpID, t)
+
+	deleteOrderUserSuccessfully(payerID, t)
+	deleteOrderUserSuccessfully(payeeID, t)
+
+	t.Log("*** End Key Flow Successful")
+}
@@ -0,0 +1,206 @@
+package e2e
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/felipeversiane/picpay-golang.git/internal/entity/request"
+	"github.com/google/uuid"
+)
+
+func qrCodeMerchant() request.UserRequest {
+	return request.UserRequest{
+		Email:            "qrcodemerchant@example.com",
+		Password:         "passwor8!F",
+		FirstName:        "Padaria",
+		LastName:         "Central",
+		Document:         "55876543000169",
+		Balance:          0.00,
+		IsMerchant:       true,
+		MerchantCategory: "food",
+	}
+}
+
+func qrCodeCustomer() request.UserRequest {
+	return request.UserRequest{
+		Email:      "qrcodecustomer@example.com",
+		Password:   "passwor8!F",
+		FirstName:  "Luiza",
+		LastName:   "Martins",
+		Document:   "55123456724",
+		Balance:    300.00,
+		IsMerchant: false,
+	}
+}
+
+func TestInsertQRCode_ShouldReturnStatusBadRequest_WhenItHasInvalidData(t *testing.T) {
+	t.Log("*** Test Insert QR Code with Invalid Data")
+
+	api := NewApiClient()
+	params := []map[string]interface{}{
+		nil,
+		{},
+		{"other": "value"},
+		{"merchant_id": "not", "type": "static"},
+		{"merchant_id": uuid.NewString(), "type": "other"},
+		{"merchant_id": uuid.NewString(), "type": "dynamic"},
+		{"merchant_id": uuid.NewString(), "type": "dynamic", "amount": 10.00, "expires_in": -1},
+	}
+
+	for _, p := range params {
+		resp, err := api.Post("/qr_code", p)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		defer resp.Body.Close()
+		assertStatusCode(t, resp, http.StatusBadRequest)
+	}
+}
+
+func TestPayQRCode_ShouldReturnStatusBadRequest_WhenPayloadIsInvalid(t *testing.T) {
+	t.Log("*** Test Pay QR Code with Invalid Payload")
+
+	api := NewApiClient()
+	params := []map[string]interface{}{
+		nil,
+		{},
+		{"payer": uuid.NewString()},
+		{"payer": uuid.NewString(), "payload": "invalid"},
+		{"payer": uuid.NewString(), "payload": "00020101021126360014br.gov.bcb.pix0114+55119999999996304FFFF"},
+	}
+
+	for _, p := range params {
+		resp, err := api.Post("/order/qr", p)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		defer resp.Body.Close()
+		assertStatusCode(t, resp, http.StatusBadRequest)
+	}
+}
+
+func insertQRCodeSuccessfully(payload map[string]interface{}, t *testing.T) (string, string) {
+	t.Logf("*** Insert %s QR Code Successfully", payload["type"])
+
+	api := NewApiClient()
+
+	resp, err := api.Post("/qr_code", payload)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer resp.Body.Close()
+
+	assertStatusCode(t, resp, http.StatusCreated)
+
+	res, err := api.ParseBody(resp)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if res["status"].(string) != "active" {
+		t.Fatalf("Invalid Status. Expected active and received %s", res["status"])
+	}
+	if res["payload"].(string) == "" {
+		t.Fatal("Invalid Payload")
+	}
+
+	return res["id"].(string), res["payload"].(string)
+}
+
+func findQRCodeImageSuccessfully(id string, t *testing.T) {
+	t.Log("*** Find QR Code Image Successfully")
+
+	api := NewApiClient()
+
+	resp, err := api.Get("/qr_code/" + id + "/image")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer resp.Body.Close()
+
+	assertStatusCode(t, resp, http.StatusOK)
+
+	if contentType := resp.Header.Get("Content-Type"); contentType != "image/png" {
+		t.Fatalf("Invalid Content-Type. Expected image/png and received %s", contentType)
+	}
+}
+
+func payQRCodeSuccessfully(payer string, payee string, payload map[string]interface{}, amount float64, t *testing.T) {
+	t.Log("*** Pay QR Code Successfully")
+
+	api := NewApiClient()
+
+	payload["payer"] = payer
+
+	for {
+		resp, err := api.Post("/order/qr", payload)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		defer resp.Body.Close()
+
+		res, err := api.ParseBody(resp)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		if resp.StatusCode == http.StatusBadRequest && res["message"] == "Order not authorized" {
+			t.Log("Order not authorized, retrying...")
+			continue
+		}
+
+		assertStatusCode(t, resp, http.StatusCreated)
+
+		if res["payee"].(string) != payee {
+			t.Fatal("Invalid Payee")
+		}
+		if res["amount"].(float64) != amount {
+			t.Fatalf("Invalid Amount. Expected %f and received %f", amount, res["amount"])
+		}
+		return
+	}
+}
+
+func payQRCodeAgainShouldFail(payer string, payload string, t *testing.T) {
+	t.Log("*** Pay Dynamic QR Code Again should Fail")
+
+	api := NewApiClient()
+
+	resp, err := api.Post("/order/qr", map[string]interface{}{"payer": payer, "payload": payload})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer resp.Body.Close()
+
+	assertStatusCode(t, resp, http.StatusBadRequest)
+}
+
+func TestQRCodeFlow(t *testing.T) {
+	t.Log("*** Start QR Code Flow")
+
+	merchantID := insertOrderUserSuccessfully(qrCodeMerchant(), t)
+	customerID := insertOrderUserSuccessfully(qrCodeCustomer(), t)
+
+	dynamicID, dynamicPayload := insertQRCodeSuccessfully(map[string]interface{}{
+		"merchant_id": merchantID,
+		"type":        "dynamic",
+		"amount":      25.00,
+		"expires_in":  600,
+	}, t)
+	_, staticPayload := insertQRCodeSuccessfully(map[string]interface{}{
+		"merchant_id": merchantID,
+		"type":        "static",
+		"city":        "Curitiba",
+	}, t)
+
+	findQRCodeImageSuccessfully(dynamicID, t)
+	payQRCodeSuccessfully(customerID, merchantID, map[string]interface{}{"payload": dynamicPayload}, 25.00, t)
+	payQRCodeAgainShouldFail(customerID, dynamicPayload, t)
+	payQRCodeSuccessfully(customerID, merchantID, map[string]interface{}{"payload": staticPayload, "amount": 12.50}, 12.50, t)
+	payQRCodeSuccessfully(customerID, merchantID, map[string]interface{}{"payload": staticPayload, "amount": 7.50}, 7.50, t)
+
+	deleteOrderUserSuccessfully(customerID, t)
+	deleteOrderUserSuccessfully(merchantID, t)
+
+	t.Log("*** End QR Code Flow Successful")
+}
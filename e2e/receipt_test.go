@@ -0,0 +1,195 @@
+package e2e
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/felipeversiane/picpay-golang.git/internal/entity/request"
+	"github.com/google/uuid"
+)
+
+func receiptPayer() request.UserRequest {
+	return request.UserRequest{
+		Email:      "receiptpayer@example.com",
+		Password:   "passwor8!F",
+		FirstName:  "Renata",
+		LastName:   "Lopes",
+		Document:   "22123456748",
+		Balance:    100.00,
+		IsMerchant: false,
+	}
+}
+
+func receiptPayee() request.UserRequest {
+	return request.UserRequest{
+		Email:      "receiptpayee@example.com",
+		Password:   "passwor8!F",
+		FirstName:  "Gustavo",
+		LastName:   "Pires",
+		Document:   "23123456765",
+		Balance:    0.00,
+		IsMerchant: false,
+	}
+}
+
+func TestVerifyReceipt_ShouldReturnStatusBadRequest_WhenItHasInvalidData(t *testing.T) {
+	t.Log("*** Test Verify Receipt with Invalid Data")
+
+	api := NewApiClient()
+	params := []map[string]interface{}{
+		nil,
+		{},
+		{"other": "value"},
+		{"order_id": "not", "authentication_code": "AAAA"},
+		{"order_id": uuid.NewString()},
+		{"order_id": uuid.NewString(), "authentication_code": strings.Repeat("A", 65)},
+	}
+
+	for _, p := range params {
+		resp, err := api.Post("/receipts/verify", p)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		defer resp.Body.Close()
+		assertStatusCode(t, resp, http.StatusBadRequest)
+	}
+}
+
+func TestFindReceipt_ShouldReturnStatusNotFound_WhenOrderIdIsNotOnDatabase(t *testing.T) {
+	t.Log("*** Test Find Receipt when Order is not on Database")
+
+	api := NewApiClient()
+
+	resp, err := api.Get("/order/" + uuid.NewString() + "/receipt")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer resp.Body.Close()
+
+	assertStatusCode(t, resp, http.StatusNotFound)
+}
+
+func findReceiptSuccessfully(orderID string, t *testing.T) string {
+	t.Log("*** Find Receipt Successfully")
+
+	api := NewApiClient()
+
+	resp, err := api.Get("/order/" + orderID + "/receipt")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer resp.Body.Close()
+
+	assertStatusCode(t, resp, http.StatusOK)
+
+	res, err := api.ParseBody(resp)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if res["order_id"].(string) != orderID {
+		t.Fatal("Invalid Order ID")
+	}
+	if res["amount"].(float64) != 100.00 {
+		t.Fatalf("Invalid Amount. Expected 100.00 and received %v", res["amount"])
+	}
+
+	payer := res["payer"].(map[string]interface{})
+	if payer["name"].(string) != "Renata L." || payer["document"].(string) != "***.234.567-**" {
+		t.Fatalf("Invalid Payer Masking. Received %v", payer)
+	}
+	payee := res["payee"].(map[string]interface{})
+	if payee["name"].(string) != "Gustavo P." || payee["document"].(string) != "***.234.567-**" {
+		t.Fatalf("Invalid Payee Masking. Received %v", payee)
+	}
+
+	code := res["authentication_code"].(string)
+	if len(code) != 39 {
+		t.Fatalf("Invalid Authentication Code. Received %q", code)
+	}
+
+	return code
+}
+
+func findReceiptPDFSuccessfully(orderID string, t *testing.T) {
+	t.Log("*** Find Receipt PDF Successfully")
+
+	api := NewApiClient()
+
+	resp, err := api.Get("/order/" + orderID + "/receipt?format=pdf")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer resp.Body.Close()
+
+	assertStatusCode(t, resp, http.StatusOK)
+
+	if resp.Header.Get("Content-Type") != "application/pdf" {
+		t.Fatalf("Invalid Content-Type. Received %q", resp.Header.Get("Content-Type"))
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if !strings.HasPrefix(string(body), "%PDF-") {
+		t.Fatal("Invalid PDF")
+	}
+}
+
+func verifyReceiptSuccessfully(orderID string, code string, t *testing.T) {
+	t.Log("*** Verify Receipt Successfully")
+
+	api := NewApiClient()
+
+	cases := []struct {
+		orderID string
+		code    string
+		valid   bool
+	}{
+		{orderID, code, true},
+		{orderID, strings.ToLower(strings.ReplaceAll(code, "-", "")), true},
+		{orderID, "0000" + code[4:], false},
+		{uuid.NewString(), code, false},
+	}
+
+	for _, c := range cases {
+		resp, err := api.Post("/receipts/verify", map[string]interface{}{"order_id": c.orderID, "authentication_code": c.code})
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		defer resp.Body.Close()
+
+		assertStatusCode(t, resp, http.StatusOK)
+
+		res, err := api.ParseBody(resp)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		if res["valid"].(bool) != c.valid {
+			t.Fatalf("Invalid Verification of %q. Expected %v and received %v", c.code, c.valid, res["valid"])
+		}
+		if c.valid && res["receipt"].(map[string]interface{})["order_id"].(string) != orderID {
+			t.Fatal("Invalid Verified Receipt")
+		}
+	}
+}
+
+func TestReceiptFlow(t *testing.T) {
+	t.Log("*** Start Receipt Flow")
+
+	payerID := insertOrderUserSuccessfully(receiptPayer(), t)
+	payeeID := insertOrderUserSuccessfully(receiptPayee(), t)
+
+	orderID := insertOrderSuccessfully(payerID, payeeID, t)
+	code := findReceiptSuccessfully(orderID, t)
+	findReceiptPDFSuccessfully(orderID, t)
+	verifyReceiptSuccessfully(orderID, code, t)
+
+	deleteOrderUserSuccessfully(payerID, t)
+	deleteOrderUserSuccessfully(payeeID, t)
+
+	t.Log("*** End Receipt Flow Successful")
+}
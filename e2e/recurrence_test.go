@@ -0,0 +1,182 @@
+package e2e
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/felipeversiane/picpay-golang.git/internal/entity/request"
+	"github.com/google/uuid"
+)
+
+func recurrencePayer() request.UserRequest {
+	return request.UserRequest{
+		Email:      "recurrencepayer@example.com",
+		Password:   "passwor8!F",
+		FirstName:  "Joana",
+		LastName:   "Souza",
+		Document:   "55321987130",
+		Balance:    500.00,
+		IsMerchant: false,
+	}
+}
+
+func recurrencePayee() request.UserRequest {
+	return request.UserRequest{
+		Email:      "recurrencepayee@example.com",
+		Password:   "passwor8!F",
+		FirstName:  "Carlos",
+		LastName:   "Souza",
+		Document:   "55321987211",
+		Balance:    0.00,
+		IsMerchant: false,
+	}
+}
+
+func TestInsertRecurrence_ShouldReturnStatusBadRequest_WhenItHasInvalidData(t *testing.T) {
+	t.Log("*** Test Insert Recurrence with Invalid Data")
+
+	api := NewApiClient()
+	params := []map[string]interface{}{
+		nil,
+		{},
+		{"other": "value"},
+		{"payee": uuid.NewString(), "payer": "not", "amount": 100.00, "schedule": "@monthly", "on_insufficient_funds": "skip"},
+		{"payee": "not", "payer": uuid.NewString(), "amount": 100.00, "schedule": "@monthly", "on_insufficient_funds": "skip"},
+		{"payee": uuid.NewString(), "payer": uuid.NewString(), "amount": -100.00, "schedule": "@monthly", "on_insufficient_funds": "skip"},
+		{"payee": uuid.NewString(), "payer": uuid.NewString(), "amount": 100.00, "schedule": "@monthly", "on_insufficient_funds": "ignore"},
+		{"payee": uuid.NewString(), "payer": uuid.NewString(), "amount": 100.00, "schedule": "61 * * * *", "on_insufficient_funds": "skip"},
+		{"payee": uuid.NewString(), "payer": uuid.NewString(), "amount": 100.00, "schedule": "@monthly", "on_insufficient_funds": "skip"},
+	}
+
+	for _, p := range params {
+		resp, err := api.Post("/recurrence", p)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		defer resp.Body.Close()
+		assertStatusCode(t, resp, http.StatusBadRequest)
+	}
+}
+
+func TestFindRecurrence_ShouldReturnStatusNotFound_WhenRecurrenceIdIsNotOnDatabase(t *testing.T) {
+	t.Log("*** Test Find Recurrence when Recurrence is not on Database")
+
+	api := NewApiClient()
+	id := uuid.NewString()
+
+	resp, err := api.Get("/recurrence/" + id)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer resp.Body.Close()
+	assertStatusCode(t, resp, http.StatusNotFound)
+}
+
+func insertRecurrenceSuccessfully(payer string, payee string, t *testing.T) string {
+	t.Log("*** Insert Recurrence Successfully")
+
+	api := NewApiClient()
+
+	payload := map[string]interface{}{
+		"amount":                50.00,
+		"payer":                 payer,
+		"payee":                 payee,
+		"schedule":              "0 9 * * 1",
+		"on_insufficient_funds": "retry",
+		"max_retries":           3,
+	}
+
+	resp, err := api.Post("/recurrence", payload)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer resp.Body.Close()
+
+	assertStatusCode(t, resp, http.StatusCreated)
+
+	res, err := api.ParseBody(resp)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	id := res["id"].(string)
+	if id == "" {
+		t.Fatal("Invalid ID")
+	}
+	if res["status"].(string) != "active" {
+		t.Fatal("Invalid Status")
+	}
+	if res["next_run_at"].(string) == "0001-01-01T00:00:00Z" {
+		t.Fatal("Invalid NextRunAt")
+	}
+
+	return id
+}
+
+func changeRecurrenceStatusSuccessfully(id string, action string, expected string, t *testing.T) {
+	t.Logf("*** Change Recurrence Status Successfully (%s)", action)
+
+	api := NewApiClient()
+
+	resp, err := api.Put("/recurrence/"+id+"/"+action, nil)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer resp.Body.Close()
+
+	assertStatusCode(t, resp, http.StatusOK)
+
+	res, err := api.ParseBody(resp)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if res["status"].(string) != expected {
+		t.Fatalf("Invalid Status. Expected %s and received %s", expected, res["status"])
+	}
+}
+
+func findRecurrenceExecutionsSuccessfully(id string, t *testing.T) {
+	t.Log("*** Find Recurrence Executions Successfully")
+
+	api := NewApiClient()
+
+	resp, err := api.Get("/recurrence/" + id + "/executions")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer resp.Body.Close()
+
+	assertStatusCode(t, resp, http.StatusOK)
+}
+
+func cancelRecurrenceSuccessfully(id string, t *testing.T) {
+	t.Log("*** Cancel Recurrence Successfully")
+
+	api := NewApiClient()
+
+	resp, err := api.Delete("/recurrence/" + id)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	assertStatusCode(t, resp, http.StatusOK)
+}
+
+func TestRecurrenceFlow(t *testing.T) {
+	t.Log("*** Start Recurrence Flow")
+
+	payerID := insertOrderUserSuccessfully(recurrencePayer(), t)
+	payeeID := insertOrderUserSuccessfully(recurrencePayee(), t)
+
+	recurrenceID := insertRecurrenceSuccessfully(payerID, payeeID, t)
+	findRecurrenceExecutionsSuccessfully(recurrenceID, t)
+	changeRecurrenceStatusSuccessfully(recurrenceID, "pause", "paused", t)
+	changeRecurrenceStatusSuccessfully(recurrenceID, "resume", "active", t)
+	cancelRecurrenceSuccessfully(recurrenceID, t)
+
+	deleteOrderUserSuccessfully(payerID, t)
+	deleteOrderUserSuccessfully(payeeID, t)
+
+	t.Log("*** End Recurrence Flow Successful")
+}
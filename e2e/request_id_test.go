@@ -0,0 +1,54 @@
+package e2e
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestRequestID_ShouldEchoTheClientRequestID(t *testing.T) {
+	t.Log("*** Test Request ID Echo")
+
+	req, err := http.NewRequest(http.MethodGet, "http://localhost:8000/health/live", nil)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	req.Header.Set("X-Request-ID", "e2e-request-id-1")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer resp.Body.Close()
+
+	assertStatusCode(t, resp, http.StatusOK)
+
+	if id := resp.Header.Get("X-Request-ID"); id != "e2e-request-id-1" {
+		t.Fatalf("Invalid Request ID. Expected e2e-request-id-1 and received %q", id)
+	}
+}
+
+func TestRequestID_ShouldAssignARequestID_WhenItIsMissingOrInvalid(t *testing.T) {
+	t.Log("*** Test Request ID Assignment")
+
+	for _, sent := range []string{"", strings.Repeat("a", 200), "has space"} {
+		req, err := http.NewRequest(http.MethodGet, "http://localhost:8000/health/live", nil)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		if sent != "" {
+			req.Header.Set("X-Request-ID", sent)
+		}
+
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		resp.Body.Close()
+
+		id := resp.Header.Get("X-Request-ID")
+		if id == "" || id == sent {
+			t.Fatalf("Invalid Request ID. Expected a new ID for %q and received %q", sent, id)
+		}
+	}
+}
@@ -0,0 +1,165 @@
+package e2e
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/felipeversiane/picpay-golang.git/internal/entity/request"
+	"github.com/google/uuid"
+)
+
+func settlementMerchant() request.UserRequest {
+	return request.UserRequest{
+		Email:            "settlementmerchant@example.com",
+		Password:         "passwor8!F",
+		FirstName:        "Mercado",
+		LastName:         "Bairro",
+		Document:         "77123456000173",
+		Balance:          0.00,
+		IsMerchant:       true,
+		MerchantCategory: "grocery",
+	}
+}
+
+func settlementCustomer() request.UserRequest {
+	return request.UserRequest{
+		Email:      "settlementcustomer@example.com",
+		Password:   "passwor8!F",
+		FirstName:  "Beatriz",
+		LastName:   "Lima",
+		Document:   "77123456866",
+		Balance:    300.00,
+		IsMerchant: false,
+	}
+}
+
+func TestFindSettlements_ShouldReturnStatusNotFound_WhenUserIdIsNotOnDatabase(t *testing.T) {
+	t.Log("*** Test Find Settlements when User is not on Database")
+
+	api := NewApiClient()
+
+	for _, path := range []string{"/settlements", "/settlements/schedule"} {
+		resp, err := api.Get("/user/" + uuid.NewString() + path)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		defer resp.Body.Close()
+		assertStatusCode(t, resp, http.StatusNotFound)
+	}
+}
+
+func insertSettlementFeePlanSuccessfully(merchant string, t *testing.T) string {
+	t.Log("*** Insert D+30 Fee Plan Successfully")
+
+	api := NewAdminClient()
+
+	payload := map[string]interface{}{
+		"name":            "Merchant D+30 plan",
+		"merchant_id":     merchant,
+		"percentage":      2.00,
+		"fixed_fee":       0.50,
+		"settlement_days": 30,
+	}
+
+	resp, err := api.Post("/fee_plan", payload)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer resp.Body.Close()
+
+	assertStatusCode(t, resp, http.StatusCreated)
+
+	res, err := api.ParseBody(resp)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if res["settlement_days"].(float64) != 30 {
+		t.Fatal("Invalid Settlement Days")
+	}
+
+	return res["id"].(string)
+}
+
+func insertOrderWithSettlementSuccessfully(payer string, payee string, t *testing.T) {
+	t.Log("*** Insert Order with Pending Settlement Successfully")
+
+	api := NewApiClient()
+
+	payload := map[string]interface{}{
+		"amount": 100.00,
+		"payer":  payer,
+		"payee":  payee,
+	}
+
+	for {
+		resp, err := api.Post("/order", payload)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		defer resp.Body.Close()
+
+		res, err := api.ParseBody(resp)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		if resp.StatusCode == http.StatusBadRequest && res["message"] == "Order not authorized" {
+			t.Log("Order not authorized, retrying...")
+			continue
+		}
+
+		assertStatusCode(t, resp, http.StatusCreated)
+		break
+	}
+
+	resp, err := api.Get("/user/" + payee)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer resp.Body.Close()
+
+	res, err := api.ParseBody(resp)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if res["balance"].(float64) != 0.00 {
+		t.Fatalf("Available balance incorrect. Expected 0.00 but got %v", res["balance"])
+	}
+	if res["pending_balance"].(float64) != 97.50 {
+		t.Fatalf("Pending balance incorrect. Expected 97.50 but got %v", res["pending_balance"])
+	}
+}
+
+func findSettlementsSuccessfully(merchant string, t *testing.T) {
+	t.Log("*** Find Settlements Successfully")
+
+	api := NewApiClient()
+
+	for _, path := range []string{"/settlements", "/settlements/schedule"} {
+		resp, err := api.Get("/user/" + merchant + path)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		defer resp.Body.Close()
+		assertStatusCode(t, resp, http.StatusOK)
+	}
+}
+
+func TestSettlementFlow(t *testing.T) {
+	t.Log("*** Start Settlement Flow")
+
+	merchantID := insertOrderUserSuccessfully(settlementMerchant(), t)
+	customerID := insertOrderUserSuccessfully(settlementCustomer(), t)
+
+	feePlanID := insertSettlementFeePlanSuccessfully(merchantID, t)
+	insertOrderWithSettlementSuccessfully(customerID, merchantID, t)
+	findSettlementsSuccessfully(merchantID, t)
+	deleteFeePlanSuccessfully(feePlanID, t)
+
+	deleteOrderUserSuccessfully(merchantID, t)
+	deleteOrderUserSuccessfully(customerID, t)
+
+	t.Log("*** End Settlement Flow Successful")
+}
@@ -14,7 +14,7 @@ func happyData() request.UserRequest {
 		Password:   "passwor8!F",
 		FirstName:  "Pedro",
 		LastName:   "Silva",
-		Document:   "0234021111",
+		Document:   "023.402.111-07",
 		Balance:    1000.00,
 		IsMerchant: false,
 	}
@@ -34,6 +34,9 @@ func TestInsertUser_ShouldReturnStatusBadRequest_WhenItHasInvalidData(t *testing
 		{"email": "jhondoe@jhondoe.com", "password": "passwordD12!", "first_name": "Jhon", "last_name": "", "document": "12345678910", "balance": 200.00, "is_merchant": false},
 		{"email": "jhondoe@jhondoe.com", "password": "passwordD12!", "first_name": "Jhon", "last_name": "Doe", "document": "12344567810022", "balance": 200.00, "is_merchant": false},
 		{"email": "jhondoe@jhondoe.com", "password": "passwordD12!", "first_name": "Jhon", "last_name": "Doe", "document": "12345678910", "balance": -200.00, "is_merchant": false},
+		{"email": "jhondoe@jhondoe.com", "password": "passwordD12!", "first_name": "Jhon", "last_name": "Doe", "document": "111.111.111-11", "balance": 200.00, "is_merchant": false},
+		{"email": "jhondoe@jhondoe.com", "password": "passwordD12!", "first_name": "Jhon", "last_name": "Doe", "document": "11.222.333/0001-80", "balance": 200.00, "is_merchant": false},
+		{"email": "jhondoe@jhondoe.com", "password": "passwordD12!", "first_name": "Jhon", "last_name": "Doe", "document": "529.982.247-25", "balance": 200.00, "is_merchant": true},
 	}
 
 	for _, p := range params {
@@ -110,6 +113,22 @@ func TestFindUserByDocument_ShouldReturnStatusNotFound_WhenUserIsNotOnDatabase(t
 	assertStatusCode(t, resp, http.StatusNotFound)
 }
 
+func TestFindTransferLimits_ShouldReturnStatusNotFound_WhenUserIsNotOnDatabase(t *testing.T) {
+	t.Log("*** Test Find Transfer Limits when User is not on Database")
+
+	api := NewApiClient()
+	id := uuid.NewString()
+
+	resp, err := api.Get("/user/" + id + "/limits")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer resp.Body.Close()
+
+	assertStatusCode(t, resp, http.StatusNotFound)
+}
+
 func insertUserSuccessfully(user request.UserRequest, t *testing.T) string {
 	t.Log("*** Insert User Successfully")
 
@@ -145,6 +164,12 @@ func insertUserSuccessfully(user request.UserRequest, t *testing.T) string {
 	if res["email"].(string) != user.Email {
 		t.Fatal("Invalid Email")
 	}
+	if res["document"].(string) != "02340211107" {
+		t.Fatal("Invalid Document. Expected the normalized CPF")
+	}
+	if res["document_type"].(string) != "cpf" {
+		t.Fatal("Invalid Document Type")
+	}
 	if res["created_at"].(string) == "0001-01-01T00:00:00Z" {
 		t.Fatal("Invalid CreatedAt")
 	}
@@ -215,6 +240,31 @@ func updateUserSuccessfully(id string, t *testing.T) {
 	}
 }
 
+func findTransferLimitsSuccessfully(id string, t *testing.T) {
+	t.Log("*** Find Transfer Limits Successfully")
+	api := NewApiClient()
+
+	resp, err := api.Get("/user/" + id + "/limits")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	assertStatusCode(t, resp, http.StatusOK)
+
+	res, err := api.ParseBody(resp)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if res["tier"].(string) != "basic" {
+		t.Fatal("Invalid Tier")
+	}
+	if res["daily_used"].(float64) != 0 {
+		t.Fatal("Invalid Daily Usage")
+	}
+}
+
 func deleteUserSuccessfully(id string, t *testing.T) {
 	t.Log("*** Delete User Successfully")
 	api := NewApiClient()
@@ -234,6 +284,7 @@ func TestUserFlow(t *testing.T) {
 	user := happyData()
 	id := insertUserSuccessfully(user, t)
 	findUserSuccessfully(id, t)
+	findTransferLimitsSuccessfully(id, t)
 	updateUserSuccessfully(id, t)
 	deleteUserSuccessfully(id, t)
 
@@ -0,0 +1,114 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	BillSplitModeEqual  = "equal"
+	BillSplitModeCustom = "custom"
+
+	BillSplitStatusOpen    = "open"
+	BillSplitStatusSettled = "settled"
+)
+
+// BillSplitShare is the part of the bill owed by one participant.
+type BillSplitShare struct {
+	Payer  uuid.UUID
+	Amount float64
+}
+
+type billSplitDomain struct {
+	id          uuid.UUID
+	owner       uuid.UUID
+	description string
+	totalAmount float64
+	mode        string
+	shares      []BillSplitShare
+	expiresAt   time.Time
+	status      string
+	createdAt   time.Time
+	updatedAt   time.Time
+}
+
+type BillSplitDomainInterface interface {
+	GetID() uuid.UUID
+	GetOwner() uuid.UUID
+	GetDescription() string
+	GetTotalAmount() float64
+	GetMode() string
+	GetShares() []BillSplitShare
+	SetShares(shares []BillSplitShare)
+	GetExpiresAt() time.Time
+	GetStatus() string
+	GetCreatedAt() time.Time
+	GetUpdatedAt() time.Time
+}
+
+func NewBillSplitDomain(
+	owner uuid.UUID,
+	description string,
+	totalAmount float64,
+	mode string,
+	shares []BillSplitShare,
+	expiresAt time.Time,
+) *billSplitDomain {
+	return &billSplitDomain{
+		id:          uuid.New(),
+		owner:       owner,
+		description: description,
+		totalAmount: totalAmount,
+		mode:        mode,
+		shares:      shares,
+		expiresAt:   expiresAt,
+		status:      BillSplitStatusOpen,
+		createdAt:   time.Now(),
+		updatedAt:   time.Now(),
+	}
+}
+
+func (b *billSplitDomain) GetID() uuid.UUID {
+	return b.id
+}
+
+func (b *billSplitDomain) GetOwner() uuid.UUID {
+	return b.owner
+}
+
+func (b *billSplitDomain) GetDescription() string {
+	return b.description
+}
+
+func (b *billSplitDomain) GetTotalAmount() float64 {
+	return b.totalAmount
+}
+
+func (b *billSplitDomain) GetMode() string {
+	return b.mode
+}
+
+func (b *billSplitDomain) GetShares() []BillSplitShare {
+	return b.shares
+}
+
+func (b *billSplitDomain) SetShares(shares []BillSplitShare) {
+	b.shares = shares
+}
+
+func (b *billSplitDomain) GetExpiresAt() time.Time {
+	return b.expiresAt
+}
+
+func (b *billSplitDomain) GetStatus() string {
+	return b.status
+}
+
+func (b *billSplitDomain) GetCreatedAt() time.Time {
+	return b.createdAt
+}
+
+func (b *billSplitDomain) GetUpdatedAt() time.Time {
+	return b.updatedAt
+}
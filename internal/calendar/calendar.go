@@ -0,0 +1,18 @@
+package calendar
+
+import "time"
+
+// Location is the Brasília time zone. Brazil has not observed daylight
+// saving time since 2019, so a fixed offset avoids depending on tzdata
+// being available in the container.
+var Location = time.FixedZone("America/Sao_Paulo", -3*60*60)
+
+func StartOfDay(t time.Time) time.Time {
+	t = t.In(Location)
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, Location)
+}
+
+func StartOfMonth(t time.Time) time.Time {
+	t = t.In(Location)
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, Location)
+}
@@ -0,0 +1,83 @@
+package calendar
+
+import "time"
+
+type holiday struct {
+	month time.Month
+	day   int
+}
+
+var fixedHolidays = []holiday{
+	{time.January, 1},
+	{time.April, 21},
+	{time.May, 1},
+	{time.September, 7},
+	{time.October, 12},
+	{time.November, 2},
+	{time.November, 15},
+	{time.November, 20},
+	{time.December, 25},
+}
+
+// IsHoliday reports whether t falls on a Brazilian national banking
+// holiday, including Carnival, Good Friday and Corpus Christi.
+func IsHoliday(t time.Time) bool {
+	day := StartOfDay(t)
+
+	for _, h := range fixedHolidays {
+		if day.Month() == h.month && day.Day() == h.day {
+			return true
+		}
+	}
+
+	easter := easterSunday(day.Year())
+	for _, offset := range []int{-48, -47, -2, 60} {
+		movable := easter.AddDate(0, 0, offset)
+		if day.Month() == movable.Month() && day.Day() == movable.Day() {
+			return true
+		}
+	}
+
+	return false
+}
+
+func IsBusinessDay(t time.Time) bool {
+	weekday := t.In(Location).Weekday()
+	return weekday != time.Saturday && weekday != time.Sunday && !IsHoliday(t)
+}
+
+// NextBusinessDay returns the start of t's day when it is a business day,
+// otherwise the start of the following business day.
+func NextBusinessDay(t time.Time) time.Time {
+	day := StartOfDay(t)
+	for !IsBusinessDay(day) {
+		day = day.AddDate(0, 0, 1)
+	}
+	return day
+}
+
+// SettlementDate returns the D+days settlement date of a payment made at t,
+// moved forward to the next business day when needed.
+func SettlementDate(t time.Time, days int) time.Time {
+	return NextBusinessDay(StartOfDay(t).AddDate(0, 0, days))
+}
+
+// easterSunday implements the anonymous Gregorian algorithm.
+func easterSunday(year int) time.Time {
+	a := year % 19
+	b := year / 100
+	c := year % 100
+	d := b / 4
+	e := b % 4
+	f := (b + 8) / 25
+	g := (b - f + 1) / 3
+	h := (19*a + b - d - g + 15) % 30
+	i := c / 4
+	k := c % 4
+	l := (32 + 2*e + 2*i - h - k) % 7
+	m := (a + 11*h + 22*l) / 451
+	month := (h + l - 7*m + 114) / 31
+	day := (h+l-7*m+114)%31 + 1
+
+	return time.Date(year, time.Month(month), day, 0, 0, 0, 0, Location)
+}
@@ -0,0 +1,95 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 12, 0, 0, 0, Location)
+}
+
+func TestEasterSunday(t *testing.T) {
+	tests := []struct {
+		year     int
+		expected time.Time
+	}{
+		{2019, time.Date(2019, time.April, 21, 0, 0, 0, 0, Location)},
+		{2024, time.Date(2024, time.March, 31, 0, 0, 0, 0, Location)},
+		{2025, time.Date(2025, time.April, 20, 0, 0, 0, 0, Location)},
+		{2038, time.Date(2038, time.April, 25, 0, 0, 0, 0, Location)},
+	}
+
+	for _, tt := range tests {
+		if got := easterSunday(tt.year); !got.Equal(tt.expected) {
+			t.Errorf("Easter of %d: expected %s and received %s", tt.year, tt.expected, got)
+		}
+	}
+}
+
+func TestIsHoliday(t *testing.T) {
+	tests := []struct {
+		name     string
+		day      time.Time
+		expected bool
+	}{
+		{"new year", date(2024, time.January, 1), true},
+		{"tiradentes", date(2024, time.April, 21), true},
+		{"independence", date(2024, time.September, 7), true},
+		{"black consciousness", date(2024, time.November, 20), true},
+		{"christmas", date(2024, time.December, 25), true},
+		{"carnival monday", date(2024, time.February, 12), true},
+		{"carnival tuesday", date(2024, time.February, 13), true},
+		{"ash wednesday", date(2024, time.February, 14), false},
+		{"good friday", date(2024, time.March, 29), true},
+		{"corpus christi", date(2024, time.May, 30), true},
+		{"carnival of another year", date(2025, time.March, 4), true},
+		{"regular day", date(2024, time.March, 5), false},
+		{"late night in UTC is the holiday in Brasília", time.Date(2024, time.December, 26, 2, 0, 0, 0, time.UTC), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsHoliday(tt.day); got != tt.expected {
+				t.Errorf("Expected %v and received %v for %s", tt.expected, got, tt.day)
+			}
+		})
+	}
+}
+
+func TestSettlementDate(t *testing.T) {
+	tests := []struct {
+		name     string
+		paidAt   time.Time
+		days     int
+		expected time.Time
+	}{
+		{"D+0 on a business day", date(2024, time.March, 5), 0, StartOfDay(date(2024, time.March, 5))},
+		{"D+1 on a business day", date(2024, time.March, 5), 1, StartOfDay(date(2024, time.March, 6))},
+		{"D+1 falls on saturday", date(2024, time.March, 8), 1, StartOfDay(date(2024, time.March, 11))},
+		{"D+0 on sunday", date(2024, time.March, 10), 0, StartOfDay(date(2024, time.March, 11))},
+		{"D+1 falls on good friday", date(2024, time.March, 28), 1, StartOfDay(date(2024, time.April, 1))},
+		{"D+1 falls on carnival", date(2024, time.February, 9), 1, StartOfDay(date(2024, time.February, 14))},
+		{"D+30 across months", date(2024, time.January, 2), 30, StartOfDay(date(2024, time.February, 1))},
+		{"D+1 over christmas", date(2024, time.December, 24), 1, StartOfDay(date(2024, time.December, 26))},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SettlementDate(tt.paidAt, tt.days); !got.Equal(tt.expected) {
+				t.Errorf("Expected %s and received %s", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestStartOfDayAndMonth_ShouldUseBrasiliaTime(t *testing.T) {
+	instant := time.Date(2024, time.March, 1, 1, 30, 0, 0, time.UTC)
+
+	if got, expected := StartOfDay(instant), time.Date(2024, time.February, 29, 0, 0, 0, 0, Location); !got.Equal(expected) {
+		t.Errorf("StartOfDay: expected %s and received %s", expected, got)
+	}
+	if got, expected := StartOfMonth(instant), time.Date(2024, time.February, 1, 0, 0, 0, 0, Location); !got.Equal(expected) {
+		t.Errorf("StartOfMonth: expected %s and received %s", expected, got)
+	}
+}
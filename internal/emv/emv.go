@@ -0,0 +1,184 @@
+// Package emv builds and parses EMV Merchant-Presented Mode (MPM) QR code
+// payloads in the BR Code layout.
+package emv
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	idPayloadFormat       = "00"
+	idPointOfInitiation   = "01"
+	idMerchantAccount     = "26"
+	idMerchantCategory    = "52"
+	idCurrency            = "53"
+	idAmount              = "54"
+	idCountry             = "58"
+	idMerchantName        = "59"
+	idMerchantCity        = "60"
+	idAdditionalData      = "62"
+	idCRC                 = "63"
+	idAccountGUI          = "00"
+	idAccountMerchant     = "01"
+	idAdditionalReference = "05"
+
+	payloadFormat = "01"
+	staticCode    = "11"
+	dynamicCode   = "12"
+	currencyBRL   = "986"
+	countryBR     = "BR"
+	categoryCode  = "0000"
+	noReference   = "***"
+
+	// GUI identifies this institution in the merchant account information.
+	GUI = "br.com.picpay-golang"
+)
+
+var (
+	ErrInvalidPayload = errors.New("invalid EMV payload")
+	ErrInvalidCRC     = errors.New("invalid EMV payload checksum")
+)
+
+// Payload holds the fields of a QR code that matter for a payment.
+type Payload struct {
+	Dynamic      bool
+	MerchantID   string
+	MerchantName string
+	MerchantCity string
+	Amount       float64
+	Reference    string
+}
+
+// Encode renders the payload, truncating the merchant name and city to the
+// sizes allowed by the specification, and appends its CRC16.
+func Encode(p Payload) string {
+	var b strings.Builder
+
+	b.WriteString(field(idPayloadFormat, payloadFormat))
+	if p.Dynamic {
+		b.WriteString(field(idPointOfInitiation, dynamicCode))
+	} else {
+		b.WriteString(field(idPointOfInitiation, staticCode))
+	}
+	b.WriteString(field(idMerchantAccount, field(idAccountGUI, GUI)+field(idAccountMerchant, p.MerchantID)))
+	b.WriteString(field(idMerchantCategory, categoryCode))
+	b.WriteString(field(idCurrency, currencyBRL))
+	if p.Amount > 0 {
+		b.WriteString(field(idAmount, strconv.FormatFloat(p.Amount, 'f', 2, 64)))
+	}
+	b.WriteString(field(idCountry, countryBR))
+	b.WriteString(field(idMerchantName, truncate(p.MerchantName, 25)))
+	b.WriteString(field(idMerchantCity, truncate(p.MerchantCity, 15)))
+
+	reference := p.Reference
+	if reference == "" {
+		reference = noReference
+	}
+	b.WriteString(field(idAdditionalData, field(idAdditionalReference, reference)))
+
+	b.WriteString(idCRC + "04")
+	return b.String() + fmt.Sprintf("%04X", CRC16(b.String()))
+}
+
+// Decode validates the checksum of a payload and extracts its fields.
+func Decode(payload string) (Payload, error) {
+	payload = strings.TrimSpace(payload)
+	if len(payload) < 8 || payload[len(payload)-8:len(payload)-4] != idCRC+"04" {
+		return Payload{}, ErrInvalidPayload
+	}
+
+	expected := fmt.Sprintf("%04X", CRC16(payload[:len(payload)-4]))
+	if !strings.EqualFold(expected, payload[len(payload)-4:]) {
+		return Payload{}, ErrInvalidCRC
+	}
+
+	fields, err := parseFields(payload[:len(payload)-8])
+	if err != nil {
+		return Payload{}, err
+	}
+	if fields[idPayloadFormat] != payloadFormat || fields[idCurrency] != currencyBRL {
+		return Payload{}, ErrInvalidPayload
+	}
+
+	account, err := parseFields(fields[idMerchantAccount])
+	if err != nil {
+		return Payload{}, err
+	}
+	if !strings.EqualFold(account[idAccountGUI], GUI) || account[idAccountMerchant] == "" {
+		return Payload{}, ErrInvalidPayload
+	}
+
+	p := Payload{
+		Dynamic:      fields[idPointOfInitiation] == dynamicCode,
+		MerchantID:   account[idAccountMerchant],
+		MerchantName: fields[idMerchantName],
+		MerchantCity: fields[idMerchantCity],
+	}
+
+	if amount, ok := fields[idAmount]; ok {
+		p.Amount, err = strconv.ParseFloat(amount, 64)
+		if err != nil || p.Amount <= 0 {
+			return Payload{}, ErrInvalidPayload
+		}
+	}
+
+	if additional, ok := fields[idAdditionalData]; ok {
+		data, err := parseFields(additional)
+		if err != nil {
+			return Payload{}, err
+		}
+		if data[idAdditionalReference] != noReference {
+			p.Reference = data[idAdditionalReference]
+		}
+	}
+
+	return p, nil
+}
+
+// CRC16 computes the CRC-16/CCITT-FALSE checksum (polynomial 0x1021,
+// initial value 0xFFFF) required by the specification.
+func CRC16(data string) uint16 {
+	crc := uint16(0xFFFF)
+	for i := 0; i < len(data); i++ {
+		crc ^= uint16(data[i]) << 8
+		for bit := 0; bit < 8; bit++ {
+			if crc&0x8000 != 0 {
+				crc = crc<<1 ^ 0x1021
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+	return crc
+}
+
+func parseFields(data string) (map[string]string, error) {
+	fields := map[string]string{}
+	for len(data) > 0 {
+		if len(data) < 4 {
+			return nil, ErrInvalidPayload
+		}
+		size, err := strconv.Atoi(data[2:4])
+		if err != nil || len(data) < 4+size {
+			return nil, ErrInvalidPayload
+		}
+		fields[data[:2]] = data[4 : 4+size]
+		data = data[4+size:]
+	}
+	return fields, nil
+}
+
+func field(id string, value string) string {
+	return fmt.Sprintf("%s%02d%s", id, len(value), value)
+}
+
+func truncate(value string, size int) string {
+	runes := []rune(value)
+	if len(runes) > size {
+		return string(runes[:size])
+	}
+	return value
+}
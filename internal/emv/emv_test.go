@@ -0,0 +1,162 @@
+package emv
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func withCRC(body string) string {
+	body += idCRC + "04"
+	return body + fmt.Sprintf("%04X", CRC16(body))
+}
+
+func TestCRC16(t *testing.T) {
+	tests := []struct {
+		data     string
+		expected uint16
+	}{
+		{"", 0xFFFF},
+		{"123456789", 0x29B1},
+		{"00020126580014br.gov.bcb.pix0136123e4567-e12b-12d1-a456-4266554400005204000053039865802BR5913Fulano de Tal6008BRASILIA62070503***6304", 0x1D3D},
+	}
+
+	for _, tt := range tests {
+		if got := CRC16(tt.data); got != tt.expected {
+			t.Errorf("CRC16(%q): expected %04X and received %04X", tt.data, tt.expected, got)
+		}
+	}
+}
+
+func TestField(t *testing.T) {
+	tests := []struct {
+		id       string
+		value    string
+		expected string
+	}{
+		{"00", "01", "000201"},
+		{"59", "Loja", "5904Loja"},
+		{"62", "", "6200"},
+		{"60", "São Paulo", "6010São Paulo"},
+	}
+
+	for _, tt := range tests {
+		if got := field(tt.id, tt.value); got != tt.expected {
+			t.Errorf("field(%q, %q): expected %q and received %q", tt.id, tt.value, tt.expected, got)
+		}
+	}
+}
+
+func TestParseFields(t *testing.T) {
+	tests := []struct {
+		data     string
+		expected map[string]string
+		err      error
+	}{
+		{"", map[string]string{}, nil},
+		{"000201", map[string]string{"00": "01"}, nil},
+		{"0002015904Loja6200", map[string]string{"00": "01", "59": "Loja", "62": ""}, nil},
+		{"000", nil, ErrInvalidPayload},
+		{"000501", nil, ErrInvalidPayload},
+		{"00xx01", nil, ErrInvalidPayload},
+	}
+
+	for _, tt := range tests {
+		got, err := parseFields(tt.data)
+		if err != tt.err {
+			t.Errorf("parseFields(%q): expected error %v and received %v", tt.data, tt.err, err)
+			continue
+		}
+		if err == nil && !reflect.DeepEqual(got, tt.expected) {
+			t.Errorf("parseFields(%q): expected %v and received %v", tt.data, tt.expected, got)
+		}
+	}
+}
+
+func TestEncode(t *testing.T) {
+	payload := Encode(Payload{
+		Dynamic:      true,
+		MerchantID:   "abc",
+		MerchantName: "Loja",
+		MerchantCity: "Sao Paulo",
+		Amount:       10.5,
+		Reference:    "ref1",
+	})
+
+	expected := "00020101021226310020br.com.picpay-golang0103abc520400005303986540510.505802BR5904Loja6009Sao Paulo62080504ref16304339F"
+	if payload != expected {
+		t.Errorf("Encode: expected %q and received %q", expected, payload)
+	}
+}
+
+func TestEncodeDecode(t *testing.T) {
+	tests := []struct {
+		name     string
+		payload  Payload
+		expected Payload
+	}{
+		{
+			name:     "static without amount",
+			payload:  Payload{MerchantID: "m1", MerchantName: "Loja", MerchantCity: "Recife"},
+			expected: Payload{MerchantID: "m1", MerchantName: "Loja", MerchantCity: "Recife"},
+		},
+		{
+			name:     "dynamic with amount and reference",
+			payload:  Payload{Dynamic: true, MerchantID: "m2", MerchantName: "Loja", MerchantCity: "Recife", Amount: 99.9, Reference: "abc"},
+			expected: Payload{Dynamic: true, MerchantID: "m2", MerchantName: "Loja", MerchantCity: "Recife", Amount: 99.9, Reference: "abc"},
+		},
+		{
+			name:     "long name and city are truncated",
+			payload:  Payload{MerchantID: "m3", MerchantName: "Comércio de Alimentos do Nordeste", MerchantCity: "São José dos Campos"},
+			expected: Payload{MerchantID: "m3", MerchantName: "Comércio de Alimentos do ", MerchantCity: "São José dos Ca"},
+		},
+	}
+
+	for _, tt := range tests {
+		got, err := Decode(Encode(tt.payload))
+		if err != nil {
+			t.Errorf("%s: expected no error and received %v", tt.name, err)
+			continue
+		}
+		if got != tt.expected {
+			t.Errorf("%s: expected %+v and received %+v", tt.name, tt.expected, got)
+		}
+	}
+}
+
+func TestDecodeInvalid(t *testing.T) {
+	valid := Encode(Payload{MerchantID: "m1", MerchantName: "Loja", MerchantCity: "Recife", Amount: 10})
+	account := field(idMerchantAccount, field(idAccountGUI, GUI)+field(idAccountMerchant, "m1"))
+
+	tests := []struct {
+		name    string
+		payload string
+		err     error
+	}{
+		{"empty", "", ErrInvalidPayload},
+		{"missing checksum", valid[:len(valid)-8], ErrInvalidPayload},
+		{"wrong checksum", valid[:len(valid)-4] + "0000", ErrInvalidCRC},
+		{"truncated field", withCRC("000201" + "5910Loja"), ErrInvalidPayload},
+		{"wrong format", withCRC("000202" + account + "5303986"), ErrInvalidPayload},
+		{"wrong currency", withCRC("000201" + account + "5303840"), ErrInvalidPayload},
+		{"other institution", withCRC("000201" + field(idMerchantAccount, field(idAccountGUI, "br.gov.bcb.pix")+field(idAccountMerchant, "m1")) + "5303986"), ErrInvalidPayload},
+		{"missing merchant", withCRC("000201" + field(idMerchantAccount, field(idAccountGUI, GUI)) + "5303986"), ErrInvalidPayload},
+		{"invalid amount", withCRC("000201" + account + "5303986" + field(idAmount, "abc")), ErrInvalidPayload},
+		{"negative amount", withCRC("000201" + account + "5303986" + field(idAmount, "-1.00")), ErrInvalidPayload},
+	}
+
+	for _, tt := range tests {
+		if _, err := Decode(tt.payload); err != tt.err {
+			t.Errorf("%s: expected error %v and received %v", tt.name, tt.err, err)
+		}
+	}
+}
+
+func TestDecodeAcceptsLowercaseChecksum(t *testing.T) {
+	body := Encode(Payload{MerchantID: "m1", MerchantName: "Loja", MerchantCity: "Recife"})
+	lower := body[:len(body)-4] + fmt.Sprintf("%04x", CRC16(body[:len(body)-4]))
+
+	if _, err := Decode(lower); err != nil {
+		t.Errorf("expected no error and received %v", err)
+	}
+}
@@ -0,0 +1,5 @@
+package request
+
+type AnticipationRequest struct {
+	SettlementIDs []string `json:"settlement_ids" binding:"required,min=1,dive,uuid"`
+}
@@ -0,0 +1,15 @@
+package request
+
+type BillSplitRequest struct {
+	Owner        string                        `json:"owner" binding:"required,uuid"`
+	Description  string                        `json:"description" binding:"required,max=140"`
+	TotalAmount  float64                       `json:"total_amount" binding:"required,numeric,min=0.01"`
+	Mode         string                        `json:"mode" binding:"required,oneof=equal custom"`
+	Participants []BillSplitParticipantRequest `json:"participants" binding:"required,min=1,max=20,dive"`
+	ExpiresIn    int                           `json:"expires_in" binding:"min=0,max=2592000"`
+}
+
+type BillSplitParticipantRequest struct {
+	UserID string  `json:"user_id" binding:"required,uuid"`
+	Amount float64 `json:"amount" binding:"min=0"`
+}
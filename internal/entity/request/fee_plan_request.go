@@ -0,0 +1,10 @@
+package request
+
+type FeePlanRequest struct {
+	Name           string  `json:"name" binding:"required,max=100"`
+	MerchantID     string  `json:"merchant_id" binding:"omitempty,uuid"`
+	Category       string  `json:"category" binding:"omitempty,max=50,excluded_with=MerchantID"`
+	Percentage     float64 `json:"percentage" binding:"numeric,min=0,max=100"`
+	FixedFee       float64 `json:"fixed_fee" binding:"numeric,min=0"`
+	SettlementDays int     `json:"settlement_days" binding:"min=0,max=365"`
+}
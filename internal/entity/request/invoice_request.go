@@ -0,0 +1,16 @@
+package request
+
+type InvoiceRequest struct {
+	MerchantID    string               `json:"merchant_id" binding:"required,uuid"`
+	CustomerID    string               `json:"customer_id" binding:"required,uuid"`
+	DueDate       string               `json:"due_date" binding:"required,datetime=2006-01-02"`
+	LateFee       float64              `json:"late_fee" binding:"min=0,max=20"`
+	DailyInterest float64              `json:"daily_interest" binding:"min=0,max=1"`
+	Items         []InvoiceItemRequest `json:"items" binding:"required,min=1,max=50,dive"`
+}
+
+type InvoiceItemRequest struct {
+	Description string  `json:"description" binding:"required,max=140"`
+	Quantity    int     `json:"quantity" binding:"required,min=1,max=10000"`
+	UnitPrice   float64 `json:"unit_price" binding:"required,numeric,min=0.01"`
+}
@@ -0,0 +1,5 @@
+package request
+
+type OrderAttachmentRequest struct {
+	UserID string `form:"user_id" binding:"required,uuid"`
+}
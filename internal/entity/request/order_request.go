@@ -1,7 +1,9 @@
 package request
 
 type OrderRequest struct {
-	Amount float64 `json:"amount" binding:"required,numeric,min=0.01"`
-	Payee  string  `json:"payee" binding:"required"`
-	Payer  string  `json:"payer" binding:"required"`
+	Amount   float64 `json:"amount" binding:"required,numeric,min=0.01"`
+	Payee    string  `json:"payee" binding:"required_without=PayeeKey,excluded_with=PayeeKey"`
+	PayeeKey string  `json:"payee_key" binding:"required_without=Payee,max=255"`
+	Payer    string  `json:"payer" binding:"required"`
+	Message  string  `json:"message" binding:"max=140"`
 }
@@ -0,0 +1,14 @@
+package request
+
+type PaymentLinkRequest struct {
+	MerchantID  string  `json:"merchant_id" binding:"required,uuid"`
+	Description string  `json:"description" binding:"required,max=140"`
+	Amount      float64 `json:"amount" binding:"min=0"`
+	Reusable    bool    `json:"reusable"`
+	ExpiresIn   int     `json:"expires_in" binding:"min=0,max=31536000"`
+}
+
+type PaymentLinkOrderRequest struct {
+	Payer  string  `json:"payer" binding:"required,uuid"`
+	Amount float64 `json:"amount" binding:"min=0"`
+}
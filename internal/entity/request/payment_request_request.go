@@ -0,0 +1,9 @@
+package request
+
+type PaymentRequestRequest struct {
+	Requester string  `json:"requester" binding:"required,uuid"`
+	Payer     string  `json:"payer" binding:"required,uuid"`
+	Amount    float64 `json:"amount" binding:"required,numeric,min=0.01"`
+	Note      string  `json:"note" binding:"max=140"`
+	ExpiresIn int     `json:"expires_in" binding:"min=0,max=2592000"`
+}
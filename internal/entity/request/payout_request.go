@@ -0,0 +1,12 @@
+package request
+
+type PayoutBatchRequest struct {
+	PayerID string              `json:"payer_id" binding:"required,uuid"`
+	Mode    string              `json:"mode" binding:"omitempty,oneof=partial all_or_nothing"`
+	Items   []PayoutItemRequest `json:"items" binding:"required,min=1,max=1000"`
+}
+
+type PayoutItemRequest struct {
+	Payee  string  `json:"payee"`
+	Amount float64 `json:"amount"`
+}
@@ -0,0 +1,16 @@
+package request
+
+type PixKeyRequest struct {
+	UserID  string `json:"user_id" binding:"required,uuid"`
+	KeyType string `json:"key_type" binding:"required,oneof=email phone document evp"`
+	Key     string `json:"key" binding:"required_if=KeyType email,required_if=KeyType phone,max=255"`
+}
+
+type PixKeyClaimRequest struct {
+	ClaimerID string `json:"claimer_id" binding:"required,uuid"`
+	Key       string `json:"key" binding:"required,max=255"`
+}
+
+type PixKeyVerificationRequest struct {
+	Code string `json:"code" binding:"required,len=6,numeric"`
+}
@@ -0,0 +1,15 @@
+package request
+
+type QRCodeRequest struct {
+	MerchantID string  `json:"merchant_id" binding:"required,uuid"`
+	Type       string  `json:"type" binding:"required,oneof=static dynamic"`
+	Amount     float64 `json:"amount" binding:"required_if=Type dynamic,min=0"`
+	ExpiresIn  int     `json:"expires_in" binding:"min=0,max=604800"`
+	City       string  `json:"city" binding:"omitempty,max=15"`
+}
+
+type QRCodeOrderRequest struct {
+	Payer   string  `json:"payer" binding:"required,uuid"`
+	Payload string  `json:"payload" binding:"required,max=512"`
+	Amount  float64 `json:"amount" binding:"min=0"`
+}
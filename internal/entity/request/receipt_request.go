@@ -0,0 +1,6 @@
+package request
+
+type ReceiptVerificationRequest struct {
+	OrderID            string `json:"order_id" binding:"required,uuid"`
+	AuthenticationCode string `json:"authentication_code" binding:"required,max=64"`
+}
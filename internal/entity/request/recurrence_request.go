@@ -0,0 +1,10 @@
+package request
+
+type RecurrenceRequest struct {
+	Amount              float64 `json:"amount" binding:"required,numeric,min=0.01"`
+	Payee               string  `json:"payee" binding:"required"`
+	Payer               string  `json:"payer" binding:"required"`
+	Schedule            string  `json:"schedule" binding:"required,max=100"`
+	OnInsufficientFunds string  `json:"on_insufficient_funds" binding:"required,oneof=skip retry pause"`
+	MaxRetries          int     `json:"max_retries" binding:"min=0,max=10"`
+}
@@ -0,0 +1,35 @@
+package response
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type AnticipationItemResponse struct {
+	SettlementID uuid.UUID `json:"settlement_id"`
+	ScheduledFor time.Time `json:"scheduled_for"`
+	Days         int       `json:"days"`
+	Amount       float64   `json:"amount"`
+	Fee          float64   `json:"fee"`
+}
+
+type AnticipationQuoteResponse struct {
+	MerchantID  uuid.UUID                  `json:"merchant_id"`
+	MonthlyRate float64                    `json:"monthly_rate"`
+	GrossAmount float64                    `json:"gross_amount"`
+	Fee         float64                    `json:"fee"`
+	NetAmount   float64                    `json:"net_amount"`
+	Settlements []AnticipationItemResponse `json:"settlements"`
+}
+
+type AnticipationResponse struct {
+	ID          uuid.UUID                  `json:"id"`
+	MerchantID  uuid.UUID                  `json:"merchant_id"`
+	MonthlyRate float64                    `json:"monthly_rate"`
+	GrossAmount float64                    `json:"gross_amount"`
+	Fee         float64                    `json:"fee"`
+	NetAmount   float64                    `json:"net_amount"`
+	Settlements []AnticipationItemResponse `json:"settlements"`
+	CreatedAt   time.Time                  `json:"created_at"`
+}
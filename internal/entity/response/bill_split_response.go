@@ -0,0 +1,25 @@
+package response
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type BillSplitResponse struct {
+	ID          uuid.UUID                `json:"id"`
+	Owner       uuid.UUID                `json:"owner"`
+	Description string                   `json:"description"`
+	TotalAmount float64                  `json:"total_amount"`
+	Mode        string                   `json:"mode"`
+	Status      string                   `json:"status"`
+	PaidAmount  float64                  `json:"paid_amount"`
+	Shares      []PaymentRequestResponse `json:"shares"`
+	SettledAt   *time.Time               `json:"settled_at"`
+	CreatedAt   time.Time                `json:"created_at"`
+	UpdatedAt   time.Time                `json:"updated_at"`
+}
+
+type BillSplitReminderResponse struct {
+	Reminded int `json:"reminded"`
+}
@@ -0,0 +1,19 @@
+package response
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type FeePlanResponse struct {
+	ID             uuid.UUID  `json:"id"`
+	Name           string     `json:"name"`
+	MerchantID     *uuid.UUID `json:"merchant_id"`
+	Category       *string    `json:"category"`
+	Percentage     float64    `json:"percentage"`
+	FixedFee       float64    `json:"fixed_fee"`
+	SettlementDays int        `json:"settlement_days"`
+	CreatedAt      time.Time  `json:"created_at"`
+	UpdatedAt      time.Time  `json:"updated_at"`
+}
@@ -0,0 +1,12 @@
+package response
+
+type HealthResponse struct {
+	Status     string                     `json:"status"`
+	Components map[string]ComponentHealth `json:"components,omitempty"`
+}
+
+type ComponentHealth struct {
+	Status    string  `json:"status"`
+	LatencyMs float64 `json:"latency_ms"`
+	Error     string  `json:"error,omitempty"`
+}
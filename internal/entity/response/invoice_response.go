@@ -0,0 +1,32 @@
+package response
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type InvoiceResponse struct {
+	ID            uuid.UUID             `json:"id"`
+	MerchantID    uuid.UUID             `json:"merchant_id"`
+	CustomerID    uuid.UUID             `json:"customer_id"`
+	Items         []InvoiceItemResponse `json:"items"`
+	Amount        float64               `json:"amount"`
+	LateFee       float64               `json:"late_fee"`
+	DailyInterest float64               `json:"daily_interest"`
+	DueDate       time.Time             `json:"due_date"`
+	Status        string                `json:"status"`
+	AmountDue     float64               `json:"amount_due"`
+	PaidAmount    *float64              `json:"paid_amount"`
+	OrderID       *uuid.UUID            `json:"order_id"`
+	PaidAt        *time.Time            `json:"paid_at"`
+	CreatedAt     time.Time             `json:"created_at"`
+	UpdatedAt     time.Time             `json:"updated_at"`
+}
+
+type InvoiceItemResponse struct {
+	Description string  `json:"description"`
+	Quantity    int     `json:"quantity"`
+	UnitPrice   float64 `json:"unit_price"`
+	Amount      float64 `json:"amount"`
+}
@@ -0,0 +1,18 @@
+package response
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type OrderAttachmentResponse struct {
+	ID          uuid.UUID `json:"id"`
+	OrderID     uuid.UUID `json:"order_id"`
+	UploadedBy  uuid.UUID `json:"uploaded_by"`
+	FileName    string    `json:"file_name"`
+	ContentType string    `json:"content_type"`
+	Size        int64     `json:"size"`
+	StorageKey  string    `json:"-"`
+	CreatedAt   time.Time `json:"created_at"`
+}
@@ -9,8 +9,11 @@ import (
 type OrderResponse struct {
 	ID         uuid.UUID `json:"id"`
 	Amount     float64   `json:"amount"`
+	Fee        float64   `json:"fee"`
+	NetAmount  float64   `json:"net_amount"`
 	Payee      uuid.UUID `json:"payee"`
 	Payer      uuid.UUID `json:"payer"`
+	Message    string    `json:"message"`
 	CreatedAt  time.Time `json:"created_at"`
 	IsReversed time.Time `json:"is_reversed"`
 }
@@ -0,0 +1,39 @@
+package response
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type PaymentLinkResponse struct {
+	ID          uuid.UUID  `json:"id"`
+	MerchantID  uuid.UUID  `json:"merchant_id"`
+	Slug        string     `json:"slug"`
+	Description string     `json:"description"`
+	Amount      *float64   `json:"amount"`
+	Reusable    bool       `json:"reusable"`
+	Status      string     `json:"status"`
+	ExpiresAt   *time.Time `json:"expires_at"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+}
+
+type PaymentLinkPublicResponse struct {
+	Slug         string     `json:"slug"`
+	MerchantName string     `json:"merchant_name"`
+	Description  string     `json:"description"`
+	Amount       *float64   `json:"amount"`
+	Reusable     bool       `json:"reusable"`
+	Status       string     `json:"status"`
+	ExpiresAt    *time.Time `json:"expires_at"`
+}
+
+type PaymentLinkPaymentResponse struct {
+	ID            uuid.UUID `json:"id"`
+	PaymentLinkID uuid.UUID `json:"payment_link_id"`
+	OrderID       uuid.UUID `json:"order_id"`
+	Payer         uuid.UUID `json:"payer"`
+	Amount        float64   `json:"amount"`
+	CreatedAt     time.Time `json:"created_at"`
+}
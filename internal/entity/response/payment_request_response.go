@@ -0,0 +1,21 @@
+package response
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type PaymentRequestResponse struct {
+	ID        uuid.UUID  `json:"id"`
+	Requester uuid.UUID  `json:"requester"`
+	Payer     uuid.UUID  `json:"payer"`
+	Amount    float64    `json:"amount"`
+	Note      string     `json:"note"`
+	SplitID   *uuid.UUID `json:"split_id"`
+	Status    string     `json:"status"`
+	ExpiresAt time.Time  `json:"expires_at"`
+	OrderID   *uuid.UUID `json:"order_id"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+}
@@ -0,0 +1,33 @@
+package response
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type PayoutBatchResponse struct {
+	ID             uuid.UUID  `json:"id"`
+	PayerID        uuid.UUID  `json:"payer_id"`
+	Mode           string     `json:"mode"`
+	Status         string     `json:"status"`
+	TotalItems     int        `json:"total_items"`
+	TotalAmount    float64    `json:"total_amount"`
+	SucceededItems int        `json:"succeeded_items"`
+	FailedItems    int        `json:"failed_items"`
+	CompletedAt    *time.Time `json:"completed_at"`
+	CreatedAt      time.Time  `json:"created_at"`
+	UpdatedAt      time.Time  `json:"updated_at"`
+}
+
+type PayoutItemResponse struct {
+	ID          uuid.UUID  `json:"id"`
+	BatchID     uuid.UUID  `json:"batch_id"`
+	Position    int        `json:"position"`
+	Payee       uuid.UUID  `json:"payee"`
+	Amount      float64    `json:"amount"`
+	Status      string     `json:"status"`
+	Message     string     `json:"message"`
+	OrderID     *uuid.UUID `json:"order_id"`
+	ProcessedAt *time.Time `json:"processed_at"`
+}
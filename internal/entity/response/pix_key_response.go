@@ -0,0 +1,37 @@
+package response
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type PixKeyResponse struct {
+	ID        uuid.UUID `json:"id"`
+	UserID    uuid.UUID `json:"user_id"`
+	KeyType   string    `json:"key_type"`
+	Key       string    `json:"key"`
+	Status    string    `json:"status"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type PixKeyLookupResponse struct {
+	KeyType       string `json:"key_type"`
+	Key           string `json:"key"`
+	OwnerName     string `json:"owner_name"`
+	OwnerDocument string `json:"owner_document"`
+	IsMerchant    bool   `json:"is_merchant"`
+}
+
+type PixKeyClaimResponse struct {
+	ID          uuid.UUID  `json:"id"`
+	KeyID       uuid.UUID  `json:"key_id"`
+	Key         string     `json:"key"`
+	ClaimerID   uuid.UUID  `json:"claimer_id"`
+	DonorID     uuid.UUID  `json:"donor_id"`
+	Status      string     `json:"status"`
+	ClaimableAt time.Time  `json:"claimable_at"`
+	CreatedAt   time.Time  `json:"created_at"`
+	ResolvedAt  *time.Time `json:"resolved_at,omitempty"`
+}
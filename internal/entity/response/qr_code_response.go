@@ -0,0 +1,20 @@
+package response
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type QRCodeResponse struct {
+	ID         uuid.UUID  `json:"id"`
+	MerchantID uuid.UUID  `json:"merchant_id"`
+	Type       string     `json:"type"`
+	Amount     *float64   `json:"amount"`
+	Payload    string     `json:"payload"`
+	Status     string     `json:"status"`
+	ExpiresAt  *time.Time `json:"expires_at"`
+	OrderID    *uuid.UUID `json:"order_id"`
+	CreatedAt  time.Time  `json:"created_at"`
+	UpdatedAt  time.Time  `json:"updated_at"`
+}
@@ -0,0 +1,27 @@
+package response
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ReceiptResponse struct {
+	OrderID            uuid.UUID            `json:"order_id"`
+	Amount             float64              `json:"amount"`
+	Message            string               `json:"message"`
+	Payer              ReceiptPartyResponse `json:"payer"`
+	Payee              ReceiptPartyResponse `json:"payee"`
+	CreatedAt          time.Time            `json:"created_at"`
+	AuthenticationCode string               `json:"authentication_code"`
+}
+
+type ReceiptPartyResponse struct {
+	Name     string `json:"name"`
+	Document string `json:"document"`
+}
+
+type ReceiptVerificationResponse struct {
+	Valid   bool             `json:"valid"`
+	Receipt *ReceiptResponse `json:"receipt,omitempty"`
+}
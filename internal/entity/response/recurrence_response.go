@@ -0,0 +1,31 @@
+package response
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type RecurrenceResponse struct {
+	ID                  uuid.UUID `json:"id"`
+	Amount              float64   `json:"amount"`
+	Payee               uuid.UUID `json:"payee"`
+	Payer               uuid.UUID `json:"payer"`
+	Schedule            string    `json:"schedule"`
+	OnInsufficientFunds string    `json:"on_insufficient_funds"`
+	MaxRetries          int       `json:"max_retries"`
+	RetryCount          int       `json:"retry_count"`
+	Status              string    `json:"status"`
+	NextRunAt           time.Time `json:"next_run_at"`
+	CreatedAt           time.Time `json:"created_at"`
+	UpdatedAt           time.Time `json:"updated_at"`
+}
+
+type RecurrenceExecutionResponse struct {
+	ID           uuid.UUID  `json:"id"`
+	RecurrenceID uuid.UUID  `json:"recurrence_id"`
+	OrderID      *uuid.UUID `json:"order_id"`
+	Status       string     `json:"status"`
+	Message      string     `json:"message"`
+	ExecutedAt   time.Time  `json:"executed_at"`
+}
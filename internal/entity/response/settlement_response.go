@@ -0,0 +1,24 @@
+package response
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type SettlementResponse struct {
+	ID           uuid.UUID  `json:"id"`
+	MerchantID   uuid.UUID  `json:"merchant_id"`
+	OrderID      uuid.UUID  `json:"order_id"`
+	Amount       float64    `json:"amount"`
+	ScheduledFor time.Time  `json:"scheduled_for"`
+	Status       string     `json:"status"`
+	SettledAt    *time.Time `json:"settled_at,omitempty"`
+	CreatedAt    time.Time  `json:"created_at"`
+}
+
+type SettlementScheduleResponse struct {
+	Date   time.Time `json:"date"`
+	Amount float64   `json:"amount"`
+	Count  int       `json:"count"`
+}
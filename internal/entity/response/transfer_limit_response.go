@@ -0,0 +1,23 @@
+package response
+
+import "github.com/google/uuid"
+
+type TransferLimitsResponse struct {
+	UserID                   uuid.UUID `json:"user_id"`
+	Tier                     string    `json:"tier"`
+	IsNight                  bool      `json:"is_night"`
+	MaxPerTransaction        float64   `json:"max_per_transaction"`
+	DailyLimit               float64   `json:"daily_limit"`
+	DailyUsed                float64   `json:"daily_used"`
+	DailyRemaining           float64   `json:"daily_remaining"`
+	MonthlyLimit             float64   `json:"monthly_limit"`
+	MonthlyUsed              float64   `json:"monthly_used"`
+	MonthlyRemaining         float64   `json:"monthly_remaining"`
+	NightlyLimit             float64   `json:"nightly_limit"`
+	NightlyUsed              float64   `json:"nightly_used"`
+	NightlyRemaining         float64   `json:"nightly_remaining"`
+	MaxTransfersPerHour      int       `json:"max_transfers_per_hour"`
+	TransfersLastHour        int       `json:"transfers_last_hour"`
+	HourlyTransfersRemaining int       `json:"hourly_transfers_remaining"`
+	AvailableForTransfer     float64   `json:"available_for_transfer"`
+}
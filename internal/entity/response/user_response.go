@@ -7,14 +7,18 @@ import (
 )
 
 type UserResponse struct {
-	ID         uuid.UUID `json:"id"`
-	Email      string    `json:"email"`
-	FirstName  string    `json:"first_name"`
-	LastName   string    `json:"last_name"`
-	Password   string    `json:"password"`
-	Balance    float64   `json:"balance"`
-	IsMerchant bool      `json:"is_merchant"`
-	Document   string    `json:"document"`
-	CreatedAt  time.Time `json:"created_at"`
-	UpdatedAt  time.Time `json:"updated_at"`
+	ID               uuid.UUID `json:"id"`
+	Email            string    `json:"email"`
+	FirstName        string    `json:"first_name"`
+	LastName         string    `json:"last_name"`
+	Password         string    `json:"password"`
+	Balance          float64   `json:"balance"`
+	PendingBalance   float64   `json:"pending_balance"`
+	IsMerchant       bool      `json:"is_merchant"`
+	MerchantCategory string    `json:"merchant_category"`
+	Tier             string    `json:"tier"`
+	Document         string    `json:"document"`
+	DocumentType     string    `json:"document_type"`
+	CreatedAt        time.Time `json:"created_at"`
+	UpdatedAt        time.Time `json:"updated_at"`
 }
@@ -0,0 +1,88 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type feePlanDomain struct {
+	id             uuid.UUID
+	name           string
+	merchantID     *uuid.UUID
+	category       *string
+	percentage     float64
+	fixedFee       float64
+	settlementDays int
+	createdAt      time.Time
+	updatedAt      time.Time
+}
+
+type FeePlanDomainInterface interface {
+	GetID() uuid.UUID
+	GetName() string
+	GetMerchantID() *uuid.UUID
+	GetCategory() *string
+	GetPercentage() float64
+	GetFixedFee() float64
+	GetSettlementDays() int
+	GetCreatedAt() time.Time
+	GetUpdatedAt() time.Time
+}
+
+func NewFeePlanDomain(
+	name string,
+	merchantID *uuid.UUID,
+	category *string,
+	percentage float64,
+	fixedFee float64,
+	settlementDays int,
+) *feePlanDomain {
+	return &feePlanDomain{
+		id:             uuid.New(),
+		name:           name,
+		merchantID:     merchantID,
+		category:       category,
+		percentage:     percentage,
+		fixedFee:       fixedFee,
+		settlementDays: settlementDays,
+		createdAt:      time.Now(),
+		updatedAt:      time.Now(),
+	}
+}
+
+func (f *feePlanDomain) GetID() uuid.UUID {
+	return f.id
+}
+
+func (f *feePlanDomain) GetName() string {
+	return f.name
+}
+
+func (f *feePlanDomain) GetMerchantID() *uuid.UUID {
+	return f.merchantID
+}
+
+func (f *feePlanDomain) GetCategory() *string {
+	return f.category
+}
+
+func (f *feePlanDomain) GetPercentage() float64 {
+	return f.percentage
+}
+
+func (f *feePlanDomain) GetFixedFee() float64 {
+	return f.fixedFee
+}
+
+func (f *feePlanDomain) GetSettlementDays() int {
+	return f.settlementDays
+}
+
+func (f *feePlanDomain) GetCreatedAt() time.Time {
+	return f.createdAt
+}
+
+func (f *feePlanDomain) GetUpdatedAt() time.Time {
+	return f.updatedAt
+}
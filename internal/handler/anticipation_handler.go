@@ -0,0 +1,121 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/felipeversiane/picpay-golang.git/config/http_error"
+	"github.com/felipeversiane/picpay-golang.git/config/logger"
+	"github.com/felipeversiane/picpay-golang.git/config/validation"
+	"github.com/felipeversiane/picpay-golang.git/internal/entity/request"
+	"github.com/felipeversiane/picpay-golang.git/internal/service"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type anticipationHandler struct {
+	anticipationService service.AnticipationService
+}
+
+func NewAnticipationHandler(
+	anticipationService service.AnticipationService,
+) AnticipationHandler {
+	return &anticipationHandler{
+		anticipationService,
+	}
+}
+
+type AnticipationHandler interface {
+	QuoteAnticipationHandler(c *gin.Context)
+	AcceptAnticipationHandler(c *gin.Context)
+}
+
+// QuoteAnticipationHandler calculates the discount for anticipating pending settlements.
+// @Summary Quote Anticipation
+// @Description Calculates the fee for receiving the selected pending settlements today. Each settlement is discounted pro rata by the days left until its scheduled date at ANTICIPATION_MONTHLY_RATE percent per 30 days. Nothing is changed.
+// @Tags Anticipations
+// @Accept json
+// @Produce json
+// @Param id path string true "ID of the merchant"
+// @Param anticipationRequest body request.AnticipationRequest true "Settlements to anticipate"
+// @Success 200 {object} response.AnticipationQuoteResponse
+// @Failure 400 {object} http_error.HttpError
+// @Failure 404 {object} http_error.HttpError
+// @Router /user/{id}/anticipation/quote [post]
+func (ah *anticipationHandler) QuoteAnticipationHandler(c *gin.Context) {
+	merchantID, settlementIDs, ok := bindAnticipationRequest(c, "quoteAnticipation")
+	if !ok {
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	quote, err := ah.anticipationService.QuoteAnticipationService(ctx, merchantID, settlementIDs)
+	if err != nil {
+		logger.FromContext(c.Request.Context()).Error("Error trying to call QuoteAnticipation service", err, zap.String("journey", "quoteAnticipation"))
+		c.JSON(err.Code, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, quote)
+}
+
+// AcceptAnticipationHandler anticipates pending settlements.
+// @Summary Accept Anticipation
+// @Description Atomically moves the selected pending settlements to the available balance, minus the anticipation fee that is credited to the platform revenue account.
+// @Tags Anticipations
+// @Accept json
+// @Produce json
+// @Param id path string true "ID of the merchant"
+// @Param anticipationRequest body request.AnticipationRequest true "Settlements to anticipate"
+// @Success 201 {object} response.AnticipationResponse
+// @Failure 400 {object} http_error.HttpError
+// @Failure 404 {object} http_error.HttpError
+// @Failure 500 {object} http_error.HttpError
+// @Router /user/{id}/anticipation [post]
+func (ah *anticipationHandler) AcceptAnticipationHandler(c *gin.Context) {
+	merchantID, settlementIDs, ok := bindAnticipationRequest(c, "acceptAnticipation")
+	if !ok {
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	result, err := ah.anticipationService.AcceptAnticipationService(ctx, merchantID, settlementIDs)
+	if err != nil {
+		logger.FromContext(c.Request.Context()).Error("Error trying to call AcceptAnticipation service", err, zap.String("journey", "acceptAnticipation"))
+		c.JSON(err.Code, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, result)
+}
+
+func bindAnticipationRequest(c *gin.Context, journey string) (uuid.UUID, []uuid.UUID, bool) {
+	merchantID, ok := parseIDParam(c, journey)
+	if !ok {
+		return uuid.UUID{}, nil, false
+	}
+
+	var anticipationRequest request.AnticipationRequest
+	if err := c.ShouldBindJSON(&anticipationRequest); err != nil {
+		logger.FromContext(c.Request.Context()).Error("Error trying to validate anticipation info", err,
+			zap.String("journey", journey))
+		errRest := validation.ValidateError(err)
+		c.JSON(errRest.Code, errRest)
+		return uuid.UUID{}, nil, false
+	}
+
+	settlementIDs := make([]uuid.UUID, 0, len(anticipationRequest.SettlementIDs))
+	for _, raw := range anticipationRequest.SettlementIDs {
+		id, err := uuid.Parse(raw)
+		if err != nil {
+			errMessage := http_error.NewBadRequestError("Invalid Settlement UUID")
+			c.JSON(errMessage.Code, errMessage)
+			return uuid.UUID{}, nil, false
+		}
+		settlementIDs = append(settlementIDs, id)
+	}
+
+	return merchantID, settlementIDs, true
+}
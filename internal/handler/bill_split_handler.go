@@ -0,0 +1,147 @@
+package handler
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/felipeversiane/picpay-golang.git/config/logger"
+	"github.com/felipeversiane/picpay-golang.git/config/validation"
+	domain "github.com/felipeversiane/picpay-golang.git/internal"
+	"github.com/felipeversiane/picpay-golang.git/internal/entity/request"
+	"github.com/felipeversiane/picpay-golang.git/internal/service"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type billSplitHandler struct {
+	billSplitService service.BillSplitService
+}
+
+func NewBillSplitHandler(
+	billSplitService service.BillSplitService,
+) BillSplitHandler {
+	return &billSplitHandler{
+		billSplitService,
+	}
+}
+
+type BillSplitHandler interface {
+	InsertBillSplitHandler(c *gin.Context)
+	FindBillSplitByIDHandler(c *gin.Context)
+	RemindBillSplitHandler(c *gin.Context)
+}
+
+// InsertBillSplitHandler splits a bill paid by the owner among participants.
+// @Summary Insert a new bill split
+// @Description Creates a payment request from the owner to every participant. Equal splits divide the total among the participants and the owner; custom splits use the amount of each participant and the owner covers the rest. The split is settled when every share is paid.
+// @Tags Bill Splits
+// @Accept json
+// @Produce json
+// @Param billSplitRequest body request.BillSplitRequest true "Bill split information"
+// @Success 201 {object} response.BillSplitResponse
+// @Failure 400 {object} http_error.HttpError
+// @Failure 500 {object} http_error.HttpError
+// @Router /bill_split [post]
+func (bh *billSplitHandler) InsertBillSplitHandler(c *gin.Context) {
+	var billSplitRequest request.BillSplitRequest
+
+	if err := c.ShouldBindJSON(&billSplitRequest); err != nil {
+		logger.FromContext(c.Request.Context()).Error("Error trying to validate bill split info", err,
+			zap.String("journey", "createBillSplit"))
+		errRest := validation.ValidateError(err)
+		c.JSON(errRest.Code, errRest)
+		return
+	}
+
+	shares := make([]domain.BillSplitShare, 0, len(billSplitRequest.Participants))
+	for _, participant := range billSplitRequest.Participants {
+		shares = append(shares, domain.BillSplitShare{
+			Payer:  uuid.MustParse(participant.UserID),
+			Amount: participant.Amount,
+		})
+	}
+
+	expiration := defaultPaymentRequestExpiration
+	if billSplitRequest.ExpiresIn > 0 {
+		expiration = time.Duration(billSplitRequest.ExpiresIn) * time.Second
+	}
+
+	billSplit := domain.NewBillSplitDomain(
+		uuid.MustParse(billSplitRequest.Owner),
+		billSplitRequest.Description,
+		billSplitRequest.TotalAmount,
+		billSplitRequest.Mode,
+		shares,
+		time.Now().Add(expiration),
+	)
+
+	ctx := c.Request.Context()
+
+	result, err := bh.billSplitService.InsertBillSplitService(ctx, billSplit)
+	if err != nil {
+		logger.FromContext(c.Request.Context()).Error(
+			"Error trying to call InsertBillSplit service",
+			err,
+			zap.String("journey", "createBillSplit"))
+		c.JSON(err.Code, err)
+		return
+	}
+	c.JSON(http.StatusCreated, result)
+}
+
+// FindBillSplitByIDHandler retrieves a bill split with its shares.
+// @Summary Find bill split by ID
+// @Description Retrieves the bill split, the payment request of every share and how much was already paid.
+// @Tags Bill Splits
+// @Accept json
+// @Produce json
+// @Param id path string true "ID of the bill split"
+// @Success 200 {object} response.BillSplitResponse
+// @Failure 400 {object} http_error.HttpError "Error: Invalid bill split ID"
+// @Failure 404 {object} http_error.HttpError "Bill split not found"
+// @Router /bill_split/{id} [get]
+func (bh *billSplitHandler) FindBillSplitByIDHandler(c *gin.Context) {
+	id, ok := parseIDParam(c, "findBillSplitByID")
+	if !ok {
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	result, err := bh.billSplitService.FindBillSplitByIDService(ctx, id)
+	if err != nil {
+		logger.FromContext(c.Request.Context()).Error("Error finding bill split", err, zap.String("journey", "findBillSplitByID"))
+		c.JSON(err.Code, err)
+		return
+	}
+	c.JSON(http.StatusOK, result)
+}
+
+// RemindBillSplitHandler reminds the participants that have not paid yet.
+// @Summary Remind bill split participants
+// @Description Sends a reminder to every participant whose share is still pending.
+// @Tags Bill Splits
+// @Accept json
+// @Produce json
+// @Param id path string true "ID of the bill split"
+// @Success 200 {object} response.BillSplitReminderResponse
+// @Failure 400 {object} http_error.HttpError
+// @Failure 404 {object} http_error.HttpError
+// @Router /bill_split/{id}/remind [post]
+func (bh *billSplitHandler) RemindBillSplitHandler(c *gin.Context) {
+	id, ok := parseIDParam(c, "remindBillSplit")
+	if !ok {
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	result, err := bh.billSplitService.RemindBillSplitService(ctx, id)
+	if err != nil {
+		logger.FromContext(c.Request.Context()).Error("Error reminding bill split participants", err, zap.String("journey", "remindBillSplit"))
+		c.JSON(err.Code, err)
+		return
+	}
+	c.JSON(http.StatusOK, result)
+}
@@ -0,0 +1,161 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/felipeversiane/picpay-golang.git/config/http_error"
+	"github.com/felipeversiane/picpay-golang.git/config/logger"
+	"github.com/felipeversiane/picpay-golang.git/config/validation"
+	domain "github.com/felipeversiane/picpay-golang.git/internal"
+	"github.com/felipeversiane/picpay-golang.git/internal/entity/request"
+	"github.com/felipeversiane/picpay-golang.git/internal/service"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type feePlanHandler struct {
+	feePlanService service.FeePlanService
+}
+
+func NewFeePlanHandler(
+	feePlanService service.FeePlanService,
+) FeePlanHandler {
+	return &feePlanHandler{
+		feePlanService,
+	}
+}
+
+type FeePlanHandler interface {
+	InsertFeePlanHandler(c *gin.Context)
+	FindFeePlanByIDHandler(c *gin.Context)
+	FindFeePlansHandler(c *gin.Context)
+	DeleteFeePlanHandler(c *gin.Context)
+}
+
+// InsertFeePlanHandler creates a merchant discount rate plan. It is an
+// admin route served at POST /admin/fee_plan, outside the public API
+// documented by Swagger.
+func (fh *feePlanHandler) InsertFeePlanHandler(c *gin.Context) {
+	var feePlanRequest request.FeePlanRequest
+
+	if err := c.ShouldBindJSON(&feePlanRequest); err != nil {
+		logger.FromContext(c.Request.Context()).Error("Error trying to validate fee plan info", err,
+			zap.String("journey", "createFeePlan"))
+		errRest := validation.ValidateError(err)
+		c.JSON(errRest.Code, errRest)
+		return
+	}
+
+	var merchantID *uuid.UUID
+	if feePlanRequest.MerchantID != "" {
+		id, parseErr := uuid.Parse(feePlanRequest.MerchantID)
+		if parseErr != nil {
+			logger.FromContext(c.Request.Context()).Error("Error trying to parse Merchant UUID", parseErr,
+				zap.String("journey", "createFeePlan"))
+			errMessage := http_error.NewBadRequestError("Invalid Merchant UUID")
+			c.JSON(errMessage.Code, errMessage)
+			return
+		}
+		merchantID = &id
+	}
+
+	var category *string
+	if feePlanRequest.Category != "" {
+		category = &feePlanRequest.Category
+	}
+
+	feePlan := domain.NewFeePlanDomain(
+		feePlanRequest.Name,
+		merchantID,
+		category,
+		feePlanRequest.Percentage,
+		feePlanRequest.FixedFee,
+		feePlanRequest.SettlementDays,
+	)
+
+	ctx := c.Request.Context()
+
+	result, err := fh.feePlanService.InsertFeePlanService(ctx, feePlan)
+	if err != nil {
+		logger.FromContext(c.Request.Context()).Error(
+			"Error trying to call InsertFeePlan service",
+			err,
+			zap.String("journey", "createFeePlan"))
+		c.JSON(err.Code, err)
+		return
+	}
+	c.JSON(http.StatusCreated, result)
+}
+
+// FindFeePlanByIDHandler retrieves fee plan information based on the provided ID.
+// @Summary Find Fee Plan by ID
+// @Description Retrieves fee plan details based on the fee plan ID provided as a parameter.
+// @Tags Fee Plans
+// @Accept json
+// @Produce json
+// @Param id path string true "ID of the fee plan to be retrieved"
+// @Success 200 {object} response.FeePlanResponse "Fee plan information retrieved successfully"
+// @Failure 400 {object} http_error.HttpError "Error: Invalid fee plan ID"
+// @Failure 404 {object} http_error.HttpError "Fee plan not found"
+// @Router /fee_plan/{id} [get]
+func (fh *feePlanHandler) FindFeePlanByIDHandler(c *gin.Context) {
+	id, ok := parseIDParam(c, "findFeePlanByID")
+	if !ok {
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	feePlan, err := fh.feePlanService.FindFeePlanByIDService(ctx, id)
+	if err != nil {
+		logger.FromContext(c.Request.Context()).Error("Error finding fee plan by ID", err, zap.String("journey", "findFeePlanByID"))
+		c.JSON(err.Code, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, feePlan)
+}
+
+// FindFeePlansHandler lists every fee plan.
+// @Summary List Fee Plans
+// @Description Retrieves every registered fee plan.
+// @Tags Fee Plans
+// @Accept json
+// @Produce json
+// @Success 200 {array} response.FeePlanResponse "Fee plans retrieved successfully"
+// @Failure 500 {object} http_error.HttpError
+// @Router /fee_plan [get]
+func (fh *feePlanHandler) FindFeePlansHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	feePlans, err := fh.feePlanService.FindFeePlansService(ctx)
+	if err != nil {
+		logger.FromContext(c.Request.Context()).Error("Error finding fee plans", err, zap.String("journey", "findFeePlans"))
+		c.JSON(err.Code, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, feePlans)
+}
+
+// DeleteFeePlanHandler deletes the fee plan with the ID in the path. Fees
+// already charged are kept in the fee ledger. It is an admin route served at
+// DELETE /admin/fee_plan/:id.
+func (fh *feePlanHandler) DeleteFeePlanHandler(c *gin.Context) {
+	id, ok := parseIDParam(c, "deleteFeePlan")
+	if !ok {
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	serviceError := fh.feePlanService.DeleteFeePlanService(ctx, id)
+	if serviceError != nil {
+		logger.FromContext(c.Request.Context()).Error("Error trying to call deleteFeePlan service", serviceError, zap.String("journey", "deleteFeePlan"))
+		c.JSON(serviceError.Code, serviceError)
+		return
+	}
+
+	c.JSON(http.StatusNoContent, gin.H{})
+}
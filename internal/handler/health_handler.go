@@ -0,0 +1,47 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/felipeversiane/picpay-golang.git/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+type healthHandler struct {
+	healthService service.HealthService
+}
+
+func NewHealthHandler(
+	healthService service.HealthService,
+) HealthHandler {
+	return &healthHandler{
+		healthService,
+	}
+}
+
+type HealthHandler interface {
+	LivenessHandler(c *gin.Context)
+	ReadinessHandler(c *gin.Context)
+}
+
+// LivenessHandler reports that the process is running. It never checks
+// dependencies, so an unreachable database does not get the process killed.
+// It is served at /health/live, outside of /api/v1.
+func (hh *healthHandler) LivenessHandler(c *gin.Context) {
+	c.JSON(http.StatusOK, hh.healthService.LivenessService())
+}
+
+// ReadinessHandler reports whether the instance can take traffic, with the
+// status and latency of each dependency. It answers 503 when any of them is
+// down or the server is shutting down. It is served at /health/ready,
+// outside of /api/v1.
+func (hh *healthHandler) ReadinessHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	result := hh.healthService.ReadinessService(ctx)
+	if result.Status != service.HealthStatusUp {
+		c.JSON(http.StatusServiceUnavailable, result)
+		return
+	}
+	c.JSON(http.StatusOK, result)
+}
@@ -0,0 +1,196 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/felipeversiane/picpay-golang.git/config/http_error"
+	"github.com/felipeversiane/picpay-golang.git/config/logger"
+	"github.com/felipeversiane/picpay-golang.git/config/validation"
+	domain "github.com/felipeversiane/picpay-golang.git/internal"
+	"github.com/felipeversiane/picpay-golang.git/internal/calendar"
+	"github.com/felipeversiane/picpay-golang.git/internal/entity/request"
+	"github.com/felipeversiane/picpay-golang.git/internal/entity/response"
+	"github.com/felipeversiane/picpay-golang.git/internal/service"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type invoiceHandler struct {
+	invoiceService service.InvoiceService
+}
+
+func NewInvoiceHandler(
+	invoiceService service.InvoiceService,
+) InvoiceHandler {
+	return &invoiceHandler{
+		invoiceService,
+	}
+}
+
+type InvoiceHandler interface {
+	InsertInvoiceHandler(c *gin.Context)
+	FindInvoiceByIDHandler(c *gin.Context)
+	FindInvoicesByUserHandler(c *gin.Context)
+	PayInvoiceHandler(c *gin.Context)
+	CancelInvoiceHandler(c *gin.Context)
+}
+
+// InsertInvoiceHandler issues an invoice from a merchant to a customer.
+// @Summary Insert a new invoice
+// @Description Issues an invoice whose amount is the sum of its items. After the due date the late_fee (percentage, charged once) and the daily_interest (percentage per day late) are added to the amount due.
+// @Tags Invoices
+// @Accept json
+// @Produce json
+// @Param invoiceRequest body request.InvoiceRequest true "Invoice information"
+// @Success 201 {object} response.InvoiceResponse
+// @Failure 400 {object} http_error.HttpError
+// @Failure 500 {object} http_error.HttpError
+// @Router /invoice [post]
+func (ih *invoiceHandler) InsertInvoiceHandler(c *gin.Context) {
+	var invoiceRequest request.InvoiceRequest
+
+	if err := c.ShouldBindJSON(&invoiceRequest); err != nil {
+		logger.FromContext(c.Request.Context()).Error("Error trying to validate invoice info", err,
+			zap.String("journey", "createInvoice"))
+		errRest := validation.ValidateError(err)
+		c.JSON(errRest.Code, errRest)
+		return
+	}
+
+	dueDate, dateErr := time.ParseInLocation("2006-01-02", invoiceRequest.DueDate, calendar.Location)
+	if dateErr != nil {
+		errMessage := http_error.NewBadRequestError("Invalid due date")
+		c.JSON(errMessage.Code, errMessage)
+		return
+	}
+
+	items := make([]domain.InvoiceItem, 0, len(invoiceRequest.Items))
+	for _, item := range invoiceRequest.Items {
+		items = append(items, domain.InvoiceItem{
+			Description: item.Description,
+			Quantity:    item.Quantity,
+			UnitPrice:   item.UnitPrice,
+		})
+	}
+
+	invoice := domain.NewInvoiceDomain(
+		uuid.MustParse(invoiceRequest.MerchantID),
+		uuid.MustParse(invoiceRequest.CustomerID),
+		items,
+		invoiceRequest.LateFee,
+		invoiceRequest.DailyInterest,
+		dueDate,
+	)
+
+	ctx := c.Request.Context()
+
+	result, err := ih.invoiceService.InsertInvoiceService(ctx, invoice)
+	if err != nil {
+		logger.FromContext(c.Request.Context()).Error(
+			"Error trying to call InsertInvoice service",
+			err,
+			zap.String("journey", "createInvoice"))
+		c.JSON(err.Code, err)
+		return
+	}
+	c.JSON(http.StatusCreated, result)
+}
+
+// FindInvoiceByIDHandler retrieves an invoice.
+// @Summary Find invoice by ID
+// @Description Retrieves the invoice with its items and the amount due right now.
+// @Tags Invoices
+// @Accept json
+// @Produce json
+// @Param id path string true "ID of the invoice"
+// @Success 200 {object} response.InvoiceResponse
+// @Failure 400 {object} http_error.HttpError "Error: Invalid invoice ID"
+// @Failure 404 {object} http_error.HttpError "Invoice not found"
+// @Router /invoice/{id} [get]
+func (ih *invoiceHandler) FindInvoiceByIDHandler(c *gin.Context) {
+	ih.handleInvoice(c, "findInvoiceByID", ih.invoiceService.FindInvoiceByIDService)
+}
+
+// FindInvoicesByUserHandler lists the invoices of a user.
+// @Summary Find user invoices
+// @Description Lists the invoices issued or received by the user.
+// @Tags Invoices
+// @Accept json
+// @Produce json
+// @Param id path string true "ID of the user"
+// @Success 200 {array} response.InvoiceResponse
+// @Failure 400 {object} http_error.HttpError "Error: Invalid user ID"
+// @Failure 404 {object} http_error.HttpError "User not found"
+// @Router /user/{id}/invoices [get]
+func (ih *invoiceHandler) FindInvoicesByUserHandler(c *gin.Context) {
+	id, ok := parseIDParam(c, "findInvoicesByUser")
+	if !ok {
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	result, err := ih.invoiceService.FindInvoicesByUserService(ctx, id)
+	if err != nil {
+		logger.FromContext(c.Request.Context()).Error("Error finding user invoices", err, zap.String("journey", "findInvoicesByUser"))
+		c.JSON(err.Code, err)
+		return
+	}
+	c.JSON(http.StatusOK, result)
+}
+
+// PayInvoiceHandler pays an open or overdue invoice.
+// @Summary Pay invoice
+// @Description Transfers the amount due, including late fee and interest, from the customer to the merchant through the regular order flow.
+// @Tags Invoices
+// @Accept json
+// @Produce json
+// @Param id path string true "ID of the invoice"
+// @Success 200 {object} response.InvoiceResponse
+// @Failure 400 {object} http_error.HttpError
+// @Failure 404 {object} http_error.HttpError
+// @Failure 422 {object} http_error.HttpError
+// @Router /invoice/{id}/pay [put]
+func (ih *invoiceHandler) PayInvoiceHandler(c *gin.Context) {
+	ih.handleInvoice(c, "payInvoice", ih.invoiceService.PayInvoiceService)
+}
+
+// CancelInvoiceHandler cancels an open or overdue invoice.
+// @Summary Cancel invoice
+// @Description Cancels the invoice so it can no longer be paid.
+// @Tags Invoices
+// @Accept json
+// @Produce json
+// @Param id path string true "ID of the invoice"
+// @Success 200 {object} response.InvoiceResponse
+// @Failure 400 {object} http_error.HttpError
+// @Failure 404 {object} http_error.HttpError
+// @Router /invoice/{id}/cancel [put]
+func (ih *invoiceHandler) CancelInvoiceHandler(c *gin.Context) {
+	ih.handleInvoice(c, "cancelInvoice", ih.invoiceService.CancelInvoiceService)
+}
+
+func (ih *invoiceHandler) handleInvoice(
+	c *gin.Context,
+	journey string,
+	call func(ctx context.Context, id uuid.UUID) (response.InvoiceResponse, *http_error.HttpError),
+) {
+	id, ok := parseIDParam(c, journey)
+	if !ok {
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	result, err := call(ctx, id)
+	if err != nil {
+		logger.FromContext(c.Request.Context()).Error("Error trying to call invoice service", err, zap.String("journey", journey))
+		c.JSON(err.Code, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
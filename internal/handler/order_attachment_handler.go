@@ -0,0 +1,234 @@
+package handler
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+
+	"github.com/felipeversiane/picpay-golang.git/config/http_error"
+	"github.com/felipeversiane/picpay-golang.git/config/logger"
+	"github.com/felipeversiane/picpay-golang.git/config/validation"
+	domain "github.com/felipeversiane/picpay-golang.git/internal"
+	"github.com/felipeversiane/picpay-golang.git/internal/entity/request"
+	"github.com/felipeversiane/picpay-golang.git/internal/service"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type orderAttachmentHandler struct {
+	orderAttachmentService service.OrderAttachmentService
+}
+
+func NewOrderAttachmentHandler(
+	orderAttachmentService service.OrderAttachmentService,
+) OrderAttachmentHandler {
+	return &orderAttachmentHandler{
+		orderAttachmentService,
+	}
+}
+
+type OrderAttachmentHandler interface {
+	InsertOrderAttachmentHandler(c *gin.Context)
+	FindOrderAttachmentsHandler(c *gin.Context)
+	DownloadOrderAttachmentHandler(c *gin.Context)
+}
+
+// InsertOrderAttachmentHandler attaches a receipt to an order.
+// @Summary Insert an order attachment
+// @Description Uploads a PDF, JPEG or PNG file of up to 5 MB as a receipt of the order. Only the payer or the payee can upload, and an order holds up to 5 attachments.
+// @Tags Orders
+// @Accept multipart/form-data
+// @Produce json
+// @Param id path string true "ID of the order"
+// @Param user_id formData string true "ID of the payer or payee uploading the file"
+// @Param file formData file true "Receipt file"
+// @Success 201 {object} response.OrderAttachmentResponse
+// @Failure 400 {object} http_error.HttpError
+// @Failure 403 {object} http_error.HttpError
+// @Failure 404 {object} http_error.HttpError
+// @Failure 500 {object} http_error.HttpError
+// @Router /order/{id}/attachments [post]
+func (ah *orderAttachmentHandler) InsertOrderAttachmentHandler(c *gin.Context) {
+	orderID, ok := parseIDParam(c, "createOrderAttachment")
+	if !ok {
+		return
+	}
+
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, domain.MaxOrderAttachmentSize+1<<20)
+
+	var attachmentRequest request.OrderAttachmentRequest
+	if err := c.ShouldBind(&attachmentRequest); err != nil {
+		logger.FromContext(c.Request.Context()).Error("Error trying to validate attachment info", err,
+			zap.String("journey", "createOrderAttachment"))
+		errRest := validation.ValidateError(err)
+		c.JSON(errRest.Code, errRest)
+		return
+	}
+	setLogUser(c, uuid.MustParse(attachmentRequest.UserID))
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		logger.FromContext(c.Request.Context()).Error("Error trying to read attachment file", err,
+			zap.String("journey", "createOrderAttachment"))
+		errMessage := http_error.NewBadRequestError("A file is required")
+		c.JSON(errMessage.Code, errMessage)
+		return
+	}
+	if fileHeader.Size == 0 || fileHeader.Size > domain.MaxOrderAttachmentSize {
+		errMessage := http_error.NewBadRequestError(fmt.Sprintf("Attachments must have between 1 byte and %d MB", domain.MaxOrderAttachmentSize>>20))
+		c.JSON(errMessage.Code, errMessage)
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		logger.FromContext(c.Request.Context()).Error("Error trying to open attachment file", err,
+			zap.String("journey", "createOrderAttachment"))
+		errMessage := http_error.NewBadRequestError("A file is required")
+		c.JSON(errMessage.Code, errMessage)
+		return
+	}
+	defer file.Close()
+
+	// The content type sent by the client is not trusted; it is detected
+	// from the first bytes of the file instead.
+	head := make([]byte, 512)
+	n, err := io.ReadFull(file, head)
+	if err != nil && err != io.ErrUnexpectedEOF {
+		logger.FromContext(c.Request.Context()).Error("Error trying to read attachment file", err,
+			zap.String("journey", "createOrderAttachment"))
+		errMessage := http_error.NewBadRequestError("A file is required")
+		c.JSON(errMessage.Code, errMessage)
+		return
+	}
+	head = head[:n]
+	contentType := http.DetectContentType(head)
+
+	attachment := domain.NewOrderAttachmentDomain(
+		orderID,
+		uuid.MustParse(attachmentRequest.UserID),
+		attachmentFileName(fileHeader.Filename),
+		contentType,
+		fileHeader.Size,
+	)
+
+	ctx := c.Request.Context()
+
+	result, errRest := ah.orderAttachmentService.InsertOrderAttachmentService(ctx, attachment, io.MultiReader(bytes.NewReader(head), file))
+	if errRest != nil {
+		logger.FromContext(c.Request.Context()).Error(
+			"Error trying to call InsertOrderAttachment service",
+			errRest,
+			zap.String("journey", "createOrderAttachment"))
+		c.JSON(errRest.Code, errRest)
+		return
+	}
+	c.JSON(http.StatusCreated, result)
+}
+
+// FindOrderAttachmentsHandler lists the attachments of an order.
+// @Summary Find order attachments
+// @Description Lists the receipts attached to the order.
+// @Tags Orders
+// @Accept json
+// @Produce json
+// @Param id path string true "ID of the order"
+// @Param user_id query string true "ID of the payer or payee"
+// @Success 200 {array} response.OrderAttachmentResponse
+// @Failure 400 {object} http_error.HttpError "Error: Invalid ID"
+// @Failure 403 {object} http_error.HttpError "User is not the payer or the payee"
+// @Failure 404 {object} http_error.HttpError "Order not found"
+// @Router /order/{id}/attachments [get]
+func (ah *orderAttachmentHandler) FindOrderAttachmentsHandler(c *gin.Context) {
+	orderID, ok := parseIDParam(c, "findOrderAttachments")
+	if !ok {
+		return
+	}
+	userID, ok := parseUserIDQuery(c, "findOrderAttachments")
+	if !ok {
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	result, err := ah.orderAttachmentService.FindOrderAttachmentsService(ctx, orderID, userID)
+	if err != nil {
+		logger.FromContext(c.Request.Context()).Error("Error finding order attachments", err, zap.String("journey", "findOrderAttachments"))
+		c.JSON(err.Code, err)
+		return
+	}
+	c.JSON(http.StatusOK, result)
+}
+
+// DownloadOrderAttachmentHandler downloads an attachment of an order.
+// @Summary Download order attachment
+// @Description Downloads the file of a receipt attached to the order.
+// @Tags Orders
+// @Produce octet-stream
+// @Param id path string true "ID of the order"
+// @Param attachment_id path string true "ID of the attachment"
+// @Param user_id query string true "ID of the payer or payee"
+// @Success 200 {file} file
+// @Failure 400 {object} http_error.HttpError "Error: Invalid ID"
+// @Failure 403 {object} http_error.HttpError "User is not the payer or the payee"
+// @Failure 404 {object} http_error.HttpError "Attachment not found"
+// @Router /order/{id}/attachments/{attachment_id} [get]
+func (ah *orderAttachmentHandler) DownloadOrderAttachmentHandler(c *gin.Context) {
+	orderID, ok := parseIDParam(c, "downloadOrderAttachment")
+	if !ok {
+		return
+	}
+	id, parseError := uuid.Parse(c.Param("attachment_id"))
+	if parseError != nil {
+		logger.FromContext(c.Request.Context()).Error("Error trying to validate attachment id",
+			parseError,
+			zap.String("journey", "downloadOrderAttachment"),
+		)
+		errorMessage := http_error.NewBadRequestError("The ID is not a valid id")
+		c.JSON(errorMessage.Code, errorMessage)
+		return
+	}
+	userID, ok := parseUserIDQuery(c, "downloadOrderAttachment")
+	if !ok {
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	attachment, content, err := ah.orderAttachmentService.OpenOrderAttachmentService(ctx, orderID, id, userID)
+	if err != nil {
+		logger.FromContext(c.Request.Context()).Error("Error opening order attachment", err, zap.String("journey", "downloadOrderAttachment"))
+		c.JSON(err.Code, err)
+		return
+	}
+	defer content.Close()
+
+	c.DataFromReader(http.StatusOK, attachment.Size, attachment.ContentType, content, map[string]string{
+		"Content-Disposition": fmt.Sprintf("attachment; filename=%q", attachment.FileName),
+	})
+}
+
+// attachmentFileName keeps only the base name of the uploaded file, without
+// characters that would break the Content-Disposition header.
+func attachmentFileName(name string) string {
+	name = filepath.Base(strings.ReplaceAll(name, "\\", "/"))
+	name = strings.Map(func(r rune) rune {
+		if r < 0x20 || r == '"' || r == 0x7f {
+			return -1
+		}
+		return r
+	}, name)
+
+	runes := []rune(name)
+	if len(runes) > 255 {
+		runes = runes[len(runes)-255:]
+	}
+	if len(runes) == 0 || string(runes) == "." || string(runes) == "/" {
+		return "attachment"
+	}
+	return string(runes)
+}
@@ -1,9 +1,7 @@
 package handler
 
 import (
-	"context"
 	"net/http"
-	"time"
 
 	"github.com/felipeversiane/picpay-golang.git/config/http_error"
 	"github.com/felipeversiane/picpay-golang.git/config/logger"
@@ -17,14 +15,17 @@ import (
 )
 
 type orderHandler struct {
-	orderService service.OrderService
+	orderService  service.OrderService
+	pixKeyService service.PixKeyService
 }
 
 func NewOrderHandler(
 	orderService service.OrderService,
+	pixKeyService service.PixKeyService,
 ) OrderHandler {
 	return &orderHandler{
 		orderService,
+		pixKeyService,
 	}
 }
 
@@ -35,7 +36,7 @@ type OrderHandler interface {
 
 // InsertOrderHandler Creates a new order
 // @Summary Insert a new order
-// @Description Insert a new order with the provided order information
+// @Description Insert a new order with the provided order information. The payee is identified either by its UUID (payee) or by one of its keys (payee_key). The optional message, up to 140 characters, is shown to both parties; profanity is masked and messages with links are refused.
 // @Tags Orders
 // @Accept json
 // @Produce json
@@ -48,43 +49,57 @@ func (oh *orderHandler) InsertOrderHandler(c *gin.Context) {
 	var orderRequest request.OrderRequest
 
 	if err := c.ShouldBindJSON(&orderRequest); err != nil {
-		logger.Error("Error trying to validate order info", err,
+		logger.FromContext(c.Request.Context()).Error("Error trying to validate order info", err,
 			zap.String("journey", "createOrder"))
 		errRest := validation.ValidateError(err)
 		c.JSON(errRest.Code, errRest)
 		return
 	}
 
-	payee, payeeErr := uuid.Parse(orderRequest.Payee)
-	if payeeErr != nil {
-		logger.Error("Error trying to parse Payee UUID", payeeErr,
-			zap.String("journey", "createOrder"))
-		errMessage := http_error.NewBadRequestError("Invalid Payee UUID")
-		c.JSON(errMessage.Code, errMessage)
-		return
-	}
-
 	payer, payerErr := uuid.Parse(orderRequest.Payer)
 	if payerErr != nil {
-		logger.Error("Error trying to parse Payer UUID", payerErr,
+		logger.FromContext(c.Request.Context()).Error("Error trying to parse Payer UUID", payerErr,
 			zap.String("journey", "createOrder"))
 		errMessage := http_error.NewBadRequestError("Invalid Payer UUID")
 		c.JSON(errMessage.Code, errMessage)
 		return
 	}
+	setLogUser(c, payer)
+
+	ctx := c.Request.Context()
+
+	var payee uuid.UUID
+	if orderRequest.PayeeKey != "" {
+		var keyErr *http_error.HttpError
+		payee, keyErr = oh.pixKeyService.ResolvePixKeyService(ctx, orderRequest.PayeeKey)
+		if keyErr != nil {
+			logger.FromContext(c.Request.Context()).Error("Error trying to resolve Payee key", keyErr,
+				zap.String("journey", "createOrder"))
+			c.JSON(keyErr.Code, keyErr)
+			return
+		}
+	} else {
+		var payeeErr error
+		payee, payeeErr = uuid.Parse(orderRequest.Payee)
+		if payeeErr != nil {
+			logger.FromContext(c.Request.Context()).Error("Error trying to parse Payee UUID", payeeErr,
+				zap.String("journey", "createOrder"))
+			errMessage := http_error.NewBadRequestError("Invalid Payee UUID")
+			c.JSON(errMessage.Code, errMessage)
+			return
+		}
+	}
 
 	order := domain.NewOrderDomain(
 		orderRequest.Amount,
 		payee,
 		payer,
 	)
+	order.SetMessage(orderRequest.Message)
 
-	ctxTimeout, cancel := context.WithTimeout(context.Background(), time.Second*10)
-	defer cancel()
-
-	result, err := oh.orderService.InsertOrderService(ctxTimeout, order)
+	result, err := oh.orderService.InsertOrderService(ctx, order)
 	if err != nil {
-		logger.Error(
+		logger.FromContext(c.Request.Context()).Error(
 			"Error trying to call InsertOrder service",
 			err,
 			zap.String("journey", "createOrder"))
@@ -108,7 +123,7 @@ func (oh *orderHandler) InsertOrderHandler(c *gin.Context) {
 func (oh *orderHandler) FindOrderByIDHandler(c *gin.Context) {
 	id, parseError := uuid.Parse(c.Param("id"))
 	if parseError != nil {
-		logger.Error("Error trying to validate orderId",
+		logger.FromContext(c.Request.Context()).Error("Error trying to validate orderId",
 			parseError,
 			zap.String("journey", "findOrderByID"),
 		)
@@ -120,12 +135,11 @@ func (oh *orderHandler) FindOrderByIDHandler(c *gin.Context) {
 		return
 	}
 
-	ctxTimeout, cancel := context.WithTimeout(context.Background(), time.Second*10)
-	defer cancel()
+	ctx := c.Request.Context()
 
-	order, err := oh.orderService.FindOrderByIDService(ctxTimeout, id)
+	order, err := oh.orderService.FindOrderByIDService(ctx, id)
 	if err != nil {
-		logger.Error("Error finding order by ID", err, zap.String("journey", "findOrderByID"))
+		logger.FromContext(c.Request.Context()).Error("Error finding order by ID", err, zap.String("journey", "findOrderByID"))
 		c.JSON(err.Code, err)
 		return
 	}
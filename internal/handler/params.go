@@ -0,0 +1,52 @@
+package handler
+
+import (
+	"github.com/felipeversiane/picpay-golang.git/config/http_error"
+	"github.com/felipeversiane/picpay-golang.git/config/logger"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+func parseIDParam(c *gin.Context, journey string) (uuid.UUID, bool) {
+	id, parseError := uuid.Parse(c.Param("id"))
+	if parseError != nil {
+		logger.FromContext(c.Request.Context()).Error("Error trying to validate id",
+			parseError,
+			zap.String("journey", journey),
+		)
+		errorMessage := http_error.NewBadRequestError(
+			"The ID is not a valid id",
+		)
+
+		c.JSON(errorMessage.Code, errorMessage)
+		return uuid.UUID{}, false
+	}
+	return id, true
+}
+
+// parseUserIDQuery reads the required user_id query parameter, which names
+// the user making a request that has no body.
+func parseUserIDQuery(c *gin.Context, journey string) (uuid.UUID, bool) {
+	id, parseError := uuid.Parse(c.Query("user_id"))
+	if parseError != nil {
+		logger.FromContext(c.Request.Context()).Error("Error trying to validate user_id",
+			parseError,
+			zap.String("journey", journey),
+		)
+		errorMessage := http_error.NewBadRequestError(
+			"The user_id is not a valid id",
+		)
+
+		c.JSON(errorMessage.Code, errorMessage)
+		return uuid.UUID{}, false
+	}
+	setLogUser(c, id)
+	return id, true
+}
+
+// setLogUser records the user acting in the request, so every line logged for
+// the rest of the request carries its ID.
+func setLogUser(c *gin.Context, id uuid.UUID) {
+	c.Request = c.Request.WithContext(logger.WithUserID(c.Request.Context(), id.String()))
+}
@@ -0,0 +1,203 @@
+package handler
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/felipeversiane/picpay-golang.git/config/logger"
+	"github.com/felipeversiane/picpay-golang.git/config/validation"
+	domain "github.com/felipeversiane/picpay-golang.git/internal"
+	"github.com/felipeversiane/picpay-golang.git/internal/entity/request"
+	"github.com/felipeversiane/picpay-golang.git/internal/service"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type paymentLinkHandler struct {
+	paymentLinkService service.PaymentLinkService
+}
+
+func NewPaymentLinkHandler(
+	paymentLinkService service.PaymentLinkService,
+) PaymentLinkHandler {
+	return &paymentLinkHandler{
+		paymentLinkService,
+	}
+}
+
+type PaymentLinkHandler interface {
+	InsertPaymentLinkHandler(c *gin.Context)
+	FindPaymentLinkByIDHandler(c *gin.Context)
+	FindPaymentLinkPaymentsHandler(c *gin.Context)
+	ResolvePaymentLinkHandler(c *gin.Context)
+	PayPaymentLinkHandler(c *gin.Context)
+}
+
+// InsertPaymentLinkHandler creates a shareable payment link for a merchant.
+// @Summary Insert a new payment link
+// @Description Creates a link identified by a public slug. Without an amount the payer chooses how much to pay. Single-use links can be paid once; links with expires_in (seconds) stop accepting payments after it.
+// @Tags Payment Links
+// @Accept json
+// @Produce json
+// @Param paymentLinkRequest body request.PaymentLinkRequest true "Payment link information"
+// @Success 201 {object} response.PaymentLinkResponse
+// @Failure 400 {object} http_error.HttpError
+// @Failure 404 {object} http_error.HttpError
+// @Failure 500 {object} http_error.HttpError
+// @Router /payment_link [post]
+func (ph *paymentLinkHandler) InsertPaymentLinkHandler(c *gin.Context) {
+	var paymentLinkRequest request.PaymentLinkRequest
+
+	if err := c.ShouldBindJSON(&paymentLinkRequest); err != nil {
+		logger.FromContext(c.Request.Context()).Error("Error trying to validate payment link info", err,
+			zap.String("journey", "createPaymentLink"))
+		errRest := validation.ValidateError(err)
+		c.JSON(errRest.Code, errRest)
+		return
+	}
+
+	var expiresAt *time.Time
+	if paymentLinkRequest.ExpiresIn > 0 {
+		at := time.Now().Add(time.Duration(paymentLinkRequest.ExpiresIn) * time.Second)
+		expiresAt = &at
+	}
+
+	paymentLink := domain.NewPaymentLinkDomain(
+		uuid.MustParse(paymentLinkRequest.MerchantID),
+		paymentLinkRequest.Description,
+		paymentLinkRequest.Amount,
+		paymentLinkRequest.Reusable,
+		expiresAt,
+	)
+
+	ctx := c.Request.Context()
+
+	result, err := ph.paymentLinkService.InsertPaymentLinkService(ctx, paymentLink)
+	if err != nil {
+		logger.FromContext(c.Request.Context()).Error(
+			"Error trying to call InsertPaymentLink service",
+			err,
+			zap.String("journey", "createPaymentLink"))
+		c.JSON(err.Code, err)
+		return
+	}
+	c.JSON(http.StatusCreated, result)
+}
+
+// FindPaymentLinkByIDHandler retrieves a payment link.
+// @Summary Find payment link by ID
+// @Description Retrieves the payment link with its slug and status.
+// @Tags Payment Links
+// @Accept json
+// @Produce json
+// @Param id path string true "ID of the payment link"
+// @Success 200 {object} response.PaymentLinkResponse
+// @Failure 400 {object} http_error.HttpError "Error: Invalid payment link ID"
+// @Failure 404 {object} http_error.HttpError "Payment link not found"
+// @Router /payment_link/{id} [get]
+func (ph *paymentLinkHandler) FindPaymentLinkByIDHandler(c *gin.Context) {
+	id, ok := parseIDParam(c, "findPaymentLinkByID")
+	if !ok {
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	result, err := ph.paymentLinkService.FindPaymentLinkByIDService(ctx, id)
+	if err != nil {
+		logger.FromContext(c.Request.Context()).Error("Error finding payment link", err, zap.String("journey", "findPaymentLinkByID"))
+		c.JSON(err.Code, err)
+		return
+	}
+	c.JSON(http.StatusOK, result)
+}
+
+// FindPaymentLinkPaymentsHandler lists the orders paid through a link.
+// @Summary Find payment link payments
+// @Description Lists the orders paid through the payment link, most recent first.
+// @Tags Payment Links
+// @Accept json
+// @Produce json
+// @Param id path string true "ID of the payment link"
+// @Success 200 {array} response.PaymentLinkPaymentResponse
+// @Failure 400 {object} http_error.HttpError "Error: Invalid payment link ID"
+// @Failure 404 {object} http_error.HttpError "Payment link not found"
+// @Router /payment_link/{id}/payments [get]
+func (ph *paymentLinkHandler) FindPaymentLinkPaymentsHandler(c *gin.Context) {
+	id, ok := parseIDParam(c, "findPaymentLinkPayments")
+	if !ok {
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	result, err := ph.paymentLinkService.FindPaymentLinkPaymentsService(ctx, id)
+	if err != nil {
+		logger.FromContext(c.Request.Context()).Error("Error finding payment link payments", err, zap.String("journey", "findPaymentLinkPayments"))
+		c.JSON(err.Code, err)
+		return
+	}
+	c.JSON(http.StatusOK, result)
+}
+
+// ResolvePaymentLinkHandler resolves a public link for display.
+// @Summary Resolve payment link
+// @Description Returns the merchant name, description, amount and status of the link so the payer can review it before paying.
+// @Tags Payment Links
+// @Accept json
+// @Produce json
+// @Param slug path string true "Public slug of the payment link"
+// @Success 200 {object} response.PaymentLinkPublicResponse
+// @Failure 404 {object} http_error.HttpError "Payment link not found"
+// @Router /payment_link/public/{slug} [get]
+func (ph *paymentLinkHandler) ResolvePaymentLinkHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	result, err := ph.paymentLinkService.ResolvePaymentLinkService(ctx, c.Param("slug"))
+	if err != nil {
+		logger.FromContext(c.Request.Context()).Error("Error resolving payment link", err, zap.String("journey", "resolvePaymentLink"))
+		c.JSON(err.Code, err)
+		return
+	}
+	c.JSON(http.StatusOK, result)
+}
+
+// PayPaymentLinkHandler pays a payment link.
+// @Summary Pay payment link
+// @Description Creates an order from the payer to the merchant of the link and records it against the link. The amount is required only for links without a fixed amount.
+// @Tags Payment Links
+// @Accept json
+// @Produce json
+// @Param slug path string true "Public slug of the payment link"
+// @Param paymentLinkOrderRequest body request.PaymentLinkOrderRequest true "Payer and amount"
+// @Success 201 {object} response.OrderResponse
+// @Failure 400 {object} http_error.HttpError
+// @Failure 404 {object} http_error.HttpError
+// @Failure 422 {object} http_error.HttpError
+// @Failure 500 {object} http_error.HttpError
+// @Router /payment_link/public/{slug}/pay [post]
+func (ph *paymentLinkHandler) PayPaymentLinkHandler(c *gin.Context) {
+	var orderRequest request.PaymentLinkOrderRequest
+
+	if err := c.ShouldBindJSON(&orderRequest); err != nil {
+		logger.FromContext(c.Request.Context()).Error("Error trying to validate payment link order info", err,
+			zap.String("journey", "payPaymentLink"))
+		errRest := validation.ValidateError(err)
+		c.JSON(errRest.Code, errRest)
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	result, err := ph.paymentLinkService.PayPaymentLinkService(ctx, c.Param("slug"), uuid.MustParse(orderRequest.Payer), orderRequest.Amount)
+	if err != nil {
+		logger.FromContext(c.Request.Context()).Error(
+			"Error trying to call PayPaymentLink service",
+			err,
+			zap.String("journey", "payPaymentLink"))
+		c.JSON(err.Code, err)
+		return
+	}
+	c.JSON(http.StatusCreated, result)
+}
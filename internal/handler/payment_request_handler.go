@@ -0,0 +1,185 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/felipeversiane/picpay-golang.git/config/http_error"
+	"github.com/felipeversiane/picpay-golang.git/config/logger"
+	"github.com/felipeversiane/picpay-golang.git/config/validation"
+	domain "github.com/felipeversiane/picpay-golang.git/internal"
+	"github.com/felipeversiane/picpay-golang.git/internal/entity/request"
+	"github.com/felipeversiane/picpay-golang.git/internal/entity/response"
+	"github.com/felipeversiane/picpay-golang.git/internal/service"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const defaultPaymentRequestExpiration = 7 * 24 * time.Hour
+
+type paymentRequestHandler struct {
+	paymentRequestService service.PaymentRequestService
+}
+
+func NewPaymentRequestHandler(
+	paymentRequestService service.PaymentRequestService,
+) PaymentRequestHandler {
+	return &paymentRequestHandler{
+		paymentRequestService,
+	}
+}
+
+type PaymentRequestHandler interface {
+	InsertPaymentRequestHandler(c *gin.Context)
+	FindPaymentRequestByIDHandler(c *gin.Context)
+	FindPendingPaymentRequestsHandler(c *gin.Context)
+	AcceptPaymentRequestHandler(c *gin.Context)
+	DeclinePaymentRequestHandler(c *gin.Context)
+}
+
+// InsertPaymentRequestHandler asks another user for money.
+// @Summary Insert a new payment request
+// @Description Creates a pending request from the requester to the payer. It expires after expires_in seconds (7 days by default).
+// @Tags Payment Requests
+// @Accept json
+// @Produce json
+// @Param paymentRequestRequest body request.PaymentRequestRequest true "Payment request information"
+// @Success 201 {object} response.PaymentRequestResponse
+// @Failure 400 {object} http_error.HttpError
+// @Failure 500 {object} http_error.HttpError
+// @Router /payment_request [post]
+func (ph *paymentRequestHandler) InsertPaymentRequestHandler(c *gin.Context) {
+	var paymentRequestRequest request.PaymentRequestRequest
+
+	if err := c.ShouldBindJSON(&paymentRequestRequest); err != nil {
+		logger.FromContext(c.Request.Context()).Error("Error trying to validate payment request info", err,
+			zap.String("journey", "createPaymentRequest"))
+		errRest := validation.ValidateError(err)
+		c.JSON(errRest.Code, errRest)
+		return
+	}
+
+	expiration := defaultPaymentRequestExpiration
+	if paymentRequestRequest.ExpiresIn > 0 {
+		expiration = time.Duration(paymentRequestRequest.ExpiresIn) * time.Second
+	}
+
+	paymentRequest := domain.NewPaymentRequestDomain(
+		uuid.MustParse(paymentRequestRequest.Requester),
+		uuid.MustParse(paymentRequestRequest.Payer),
+		paymentRequestRequest.Amount,
+		paymentRequestRequest.Note,
+		time.Now().Add(expiration),
+	)
+
+	ctx := c.Request.Context()
+
+	result, err := ph.paymentRequestService.InsertPaymentRequestService(ctx, paymentRequest)
+	if err != nil {
+		logger.FromContext(c.Request.Context()).Error(
+			"Error trying to call InsertPaymentRequest service",
+			err,
+			zap.String("journey", "createPaymentRequest"))
+		c.JSON(err.Code, err)
+		return
+	}
+	c.JSON(http.StatusCreated, result)
+}
+
+// FindPaymentRequestByIDHandler retrieves a payment request.
+// @Summary Find payment request by ID
+// @Description Retrieves the payment request with its status and, once accepted, the order that paid it.
+// @Tags Payment Requests
+// @Accept json
+// @Produce json
+// @Param id path string true "ID of the payment request"
+// @Success 200 {object} response.PaymentRequestResponse
+// @Failure 400 {object} http_error.HttpError "Error: Invalid payment request ID"
+// @Failure 404 {object} http_error.HttpError "Payment request not found"
+// @Router /payment_request/{id} [get]
+func (ph *paymentRequestHandler) FindPaymentRequestByIDHandler(c *gin.Context) {
+	ph.handlePaymentRequest(c, "findPaymentRequestByID", ph.paymentRequestService.FindPaymentRequestByIDService)
+}
+
+// FindPendingPaymentRequestsHandler lists the pending requests of a user.
+// @Summary Find pending payment requests
+// @Description Lists the pending payment requests sent or received by the user, most recent first.
+// @Tags Payment Requests
+// @Accept json
+// @Produce json
+// @Param id path string true "ID of the user"
+// @Success 200 {array} response.PaymentRequestResponse
+// @Failure 400 {object} http_error.HttpError "Error: Invalid user ID"
+// @Failure 404 {object} http_error.HttpError "User not found"
+// @Router /user/{id}/payment_requests [get]
+func (ph *paymentRequestHandler) FindPendingPaymentRequestsHandler(c *gin.Context) {
+	id, ok := parseIDParam(c, "findPendingPaymentRequests")
+	if !ok {
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	result, err := ph.paymentRequestService.FindPendingPaymentRequestsService(ctx, id)
+	if err != nil {
+		logger.FromContext(c.Request.Context()).Error("Error finding pending payment requests", err, zap.String("journey", "findPendingPaymentRequests"))
+		c.JSON(err.Code, err)
+		return
+	}
+	c.JSON(http.StatusOK, result)
+}
+
+// AcceptPaymentRequestHandler pays a pending payment request.
+// @Summary Accept payment request
+// @Description Transfers the amount from the payer to the requester through the regular order flow.
+// @Tags Payment Requests
+// @Accept json
+// @Produce json
+// @Param id path string true "ID of the payment request"
+// @Success 200 {object} response.PaymentRequestResponse
+// @Failure 400 {object} http_error.HttpError
+// @Failure 404 {object} http_error.HttpError
+// @Failure 422 {object} http_error.HttpError
+// @Router /payment_request/{id}/accept [put]
+func (ph *paymentRequestHandler) AcceptPaymentRequestHandler(c *gin.Context) {
+	ph.handlePaymentRequest(c, "acceptPaymentRequest", ph.paymentRequestService.AcceptPaymentRequestService)
+}
+
+// DeclinePaymentRequestHandler declines a pending payment request.
+// @Summary Decline payment request
+// @Description Declines the payment request without transferring any money.
+// @Tags Payment Requests
+// @Accept json
+// @Produce json
+// @Param id path string true "ID of the payment request"
+// @Success 200 {object} response.PaymentRequestResponse
+// @Failure 400 {object} http_error.HttpError
+// @Failure 404 {object} http_error.HttpError
+// @Router /payment_request/{id}/decline [put]
+func (ph *paymentRequestHandler) DeclinePaymentRequestHandler(c *gin.Context) {
+	ph.handlePaymentRequest(c, "declinePaymentRequest", ph.paymentRequestService.DeclinePaymentRequestService)
+}
+
+func (ph *paymentRequestHandler) handlePaymentRequest(
+	c *gin.Context,
+	journey string,
+	call func(ctx context.Context, id uuid.UUID) (response.PaymentRequestResponse, *http_error.HttpError),
+) {
+	id, ok := parseIDParam(c, journey)
+	if !ok {
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	result, err := call(ctx, id)
+	if err != nil {
+		logger.FromContext(c.Request.Context()).Error("Error trying to call payment request service", err, zap.String("journey", journey))
+		c.JSON(err.Code, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
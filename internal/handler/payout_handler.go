@@ -0,0 +1,236 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/felipeversiane/picpay-golang.git/config/http_error"
+	"github.com/felipeversiane/picpay-golang.git/config/logger"
+	"github.com/felipeversiane/picpay-golang.git/config/validation"
+	domain "github.com/felipeversiane/picpay-golang.git/internal"
+	"github.com/felipeversiane/picpay-golang.git/internal/entity/request"
+	"github.com/felipeversiane/picpay-golang.git/internal/service"
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const payoutCSVContentType = "text/csv"
+
+var payoutCSVHeader = []string{"payee", "amount"}
+
+type payoutHandler struct {
+	payoutService service.PayoutService
+}
+
+func NewPayoutHandler(
+	payoutService service.PayoutService,
+) PayoutHandler {
+	return &payoutHandler{
+		payoutService,
+	}
+}
+
+type PayoutHandler interface {
+	InsertPayoutBatchHandler(c *gin.Context)
+	FindPayoutBatchByIDHandler(c *gin.Context)
+	FindPayoutBatchResultHandler(c *gin.Context)
+}
+
+// InsertPayoutBatchHandler queues a batch of payouts from a merchant.
+// @Summary Insert a new payout batch
+// @Description Validates every item up front and queues the batch, which is processed in the background. The body is either JSON or a text/csv file with a "payee,amount" header, in which case payer_id and mode are read from the query string. In partial mode each item is paid on its own; in all_or_nothing mode either every item is paid or none is.
+// @Tags Payouts
+// @Accept json
+// @Accept text/csv
+// @Produce json
+// @Param payoutBatchRequest body request.PayoutBatchRequest true "Payout batch information"
+// @Param payer_id query string false "ID of the merchant, for CSV bodies"
+// @Param mode query string false "partial or all_or_nothing, for CSV bodies"
+// @Success 202 {object} response.PayoutBatchResponse
+// @Failure 400 {object} http_error.HttpError
+// @Failure 404 {object} http_error.HttpError
+// @Failure 500 {object} http_error.HttpError
+// @Router /payouts/batch [post]
+func (ph *payoutHandler) InsertPayoutBatchHandler(c *gin.Context) {
+	var payoutRequest request.PayoutBatchRequest
+
+	var err error
+	if c.ContentType() == payoutCSVContentType {
+		payoutRequest, err = parsePayoutCSV(c)
+		if err == nil {
+			err = binding.Validator.ValidateStruct(&payoutRequest)
+		}
+	} else {
+		err = c.ShouldBindJSON(&payoutRequest)
+	}
+	if err != nil {
+		logger.FromContext(c.Request.Context()).Error("Error trying to validate payout batch info", err,
+			zap.String("journey", "createPayoutBatch"))
+		errRest := validation.ValidateError(err)
+		c.JSON(errRest.Code, errRest)
+		return
+	}
+
+	causes := []http_error.Causes{}
+	items := make([]domain.PayoutItem, 0, len(payoutRequest.Items))
+	for i, item := range payoutRequest.Items {
+		payee, parseErr := uuid.Parse(item.Payee)
+		if parseErr != nil {
+			causes = append(causes, http_error.Causes{Field: fmt.Sprintf("items[%d].payee", i), Message: "payee must be a valid UUID"})
+		}
+		if item.Amount <= 0 || math.Abs(math.Round(item.Amount*100)-item.Amount*100) > 1e-6 {
+			causes = append(causes, http_error.Causes{Field: fmt.Sprintf("items[%d].amount", i), Message: "amount must be greater than 0 with at most 2 decimal places"})
+		}
+		items = append(items, domain.PayoutItem{Payee: payee, Amount: item.Amount})
+	}
+	if len(causes) > 0 {
+		errRest := http_error.NewBadRequestValidationError("Some payout items are invalid", causes)
+		c.JSON(errRest.Code, errRest)
+		return
+	}
+
+	mode := payoutRequest.Mode
+	if mode == "" {
+		mode = domain.PayoutModePartial
+	}
+	payer := uuid.MustParse(payoutRequest.PayerID)
+	setLogUser(c, payer)
+	batch := domain.NewPayoutBatchDomain(payer, mode, items)
+
+	ctx := c.Request.Context()
+
+	result, errRest := ph.payoutService.InsertPayoutBatchService(ctx, batch)
+	if errRest != nil {
+		logger.FromContext(c.Request.Context()).Error(
+			"Error trying to call InsertPayoutBatch service",
+			errRest,
+			zap.String("journey", "createPayoutBatch"))
+		c.JSON(errRest.Code, errRest)
+		return
+	}
+	c.JSON(http.StatusAccepted, result)
+}
+
+// FindPayoutBatchByIDHandler retrieves the status of a payout batch.
+// @Summary Find payout batch by ID
+// @Description Retrieves the status of the batch and, once processed, how many items succeeded and failed.
+// @Tags Payouts
+// @Accept json
+// @Produce json
+// @Param id path string true "ID of the payout batch"
+// @Success 200 {object} response.PayoutBatchResponse
+// @Failure 400 {object} http_error.HttpError "Error: Invalid payout batch ID"
+// @Failure 404 {object} http_error.HttpError "Payout batch not found"
+// @Router /payouts/batch/{id} [get]
+func (ph *payoutHandler) FindPayoutBatchByIDHandler(c *gin.Context) {
+	id, ok := parseIDParam(c, "findPayoutBatchByID")
+	if !ok {
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	result, err := ph.payoutService.FindPayoutBatchByIDService(ctx, id)
+	if err != nil {
+		logger.FromContext(c.Request.Context()).Error("Error finding payout batch", err, zap.String("journey", "findPayoutBatchByID"))
+		c.JSON(err.Code, err)
+		return
+	}
+	c.JSON(http.StatusOK, result)
+}
+
+// FindPayoutBatchResultHandler downloads the per-item result of a batch.
+// @Summary Download payout batch result
+// @Description Downloads a CSV file with the status and failure message of every item of a processed batch.
+// @Tags Payouts
+// @Produce text/csv
+// @Param id path string true "ID of the payout batch"
+// @Success 200 {file} file
+// @Failure 400 {object} http_error.HttpError "Error: Invalid payout batch ID or batch still being processed"
+// @Failure 404 {object} http_error.HttpError "Payout batch not found"
+// @Router /payouts/batch/{id}/result [get]
+func (ph *payoutHandler) FindPayoutBatchResultHandler(c *gin.Context) {
+	id, ok := parseIDParam(c, "findPayoutBatchResult")
+	if !ok {
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	items, err := ph.payoutService.FindPayoutBatchResultService(ctx, id)
+	if err != nil {
+		logger.FromContext(c.Request.Context()).Error("Error finding payout batch result", err, zap.String("journey", "findPayoutBatchResult"))
+		c.JSON(err.Code, err)
+		return
+	}
+
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	w.Write([]string{"position", "payee", "amount", "status", "message"})
+	for _, item := range items {
+		w.Write([]string{
+			strconv.Itoa(item.Position),
+			item.Payee.String(),
+			strconv.FormatFloat(item.Amount, 'f', 2, 64),
+			item.Status,
+			item.Message,
+		})
+	}
+	w.Flush()
+
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=\"payout-batch-%s.csv\"", id))
+	c.Data(http.StatusOK, payoutCSVContentType, buf.Bytes())
+}
+
+// parsePayoutCSV reads a "payee,amount" file from the body. An amount that
+// is not a number is read as zero, so the row is reported with the other
+// invalid items instead of failing the whole file.
+func parsePayoutCSV(c *gin.Context) (request.PayoutBatchRequest, error) {
+	payoutRequest := request.PayoutBatchRequest{
+		PayerID: c.Query("payer_id"),
+		Mode:    c.Query("mode"),
+	}
+
+	reader := csv.NewReader(c.Request.Body)
+	reader.FieldsPerRecord = len(payoutCSVHeader)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return payoutRequest, err
+	}
+	for i, column := range header {
+		if !strings.EqualFold(strings.TrimSpace(column), payoutCSVHeader[i]) {
+			return payoutRequest, fmt.Errorf("invalid CSV header %q", strings.Join(header, ","))
+		}
+	}
+
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return payoutRequest, err
+		}
+
+		amount, parseErr := strconv.ParseFloat(strings.TrimSpace(record[1]), 64)
+		if parseErr != nil || math.IsNaN(amount) || math.IsInf(amount, 0) {
+			amount = 0
+		}
+		payoutRequest.Items = append(payoutRequest.Items, request.PayoutItemRequest{
+			Payee:  strings.TrimSpace(record[0]),
+			Amount: amount,
+		})
+	}
+
+	return payoutRequest, nil
+}
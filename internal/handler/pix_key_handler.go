@@ -0,0 +1,300 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/felipeversiane/picpay-golang.git/config/logger"
+	"github.com/felipeversiane/picpay-golang.git/config/validation"
+	domain "github.com/felipeversiane/picpay-golang.git/internal"
+	"github.com/felipeversiane/picpay-golang.git/internal/entity/request"
+	"github.com/felipeversiane/picpay-golang.git/internal/service"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type pixKeyHandler struct {
+	pixKeyService service.PixKeyService
+}
+
+func NewPixKeyHandler(
+	pixKeyService service.PixKeyService,
+) PixKeyHandler {
+	return &pixKeyHandler{
+		pixKeyService,
+	}
+}
+
+type PixKeyHandler interface {
+	InsertPixKeyHandler(c *gin.Context)
+	VerifyPixKeyHandler(c *gin.Context)
+	FindPixKeysByUserHandler(c *gin.Context)
+	LookupPixKeyHandler(c *gin.Context)
+	DeletePixKeyHandler(c *gin.Context)
+	InsertPixKeyClaimHandler(c *gin.Context)
+	ConfirmPixKeyClaimHandler(c *gin.Context)
+	CancelPixKeyClaimHandler(c *gin.Context)
+}
+
+// InsertPixKeyHandler registers a transfer key for a user.
+// @Summary Insert a new key
+// @Description Registers an email, phone, document or random (evp) key. Document keys must match the user document and evp keys are generated, both are active immediately. Email and phone keys stay pending until the code sent to them is verified.
+// @Tags Keys
+// @Accept json
+// @Produce json
+// @Param pixKeyRequest body request.PixKeyRequest true "Key information for registration"
+// @Success 201 {object} response.PixKeyResponse
+// @Failure 400 {object} http_error.HttpError
+// @Failure 404 {object} http_error.HttpError
+// @Failure 500 {object} http_error.HttpError
+// @Router /pix_key [post]
+func (ph *pixKeyHandler) InsertPixKeyHandler(c *gin.Context) {
+	var pixKeyRequest request.PixKeyRequest
+
+	if err := c.ShouldBindJSON(&pixKeyRequest); err != nil {
+		logger.FromContext(c.Request.Context()).Error("Error trying to validate key info", err,
+			zap.String("journey", "createPixKey"))
+		errRest := validation.ValidateError(err)
+		c.JSON(errRest.Code, errRest)
+		return
+	}
+
+	key := domain.NewPixKeyDomain(
+		uuid.MustParse(pixKeyRequest.UserID),
+		pixKeyRequest.KeyType,
+		pixKeyRequest.Key,
+	)
+
+	ctx := c.Request.Context()
+
+	result, err := ph.pixKeyService.InsertPixKeyService(ctx, key)
+	if err != nil {
+		logger.FromContext(c.Request.Context()).Error(
+			"Error trying to call InsertPixKey service",
+			err,
+			zap.String("journey", "createPixKey"))
+		c.JSON(err.Code, err)
+		return
+	}
+	c.JSON(http.StatusCreated, result)
+}
+
+// VerifyPixKeyHandler activates a pending key.
+// @Summary Verify key
+// @Description Activates a pending email or phone key with the code sent to it.
+// @Tags Keys
+// @Accept json
+// @Produce json
+// @Param id path string true "ID of the key"
+// @Param verificationRequest body request.PixKeyVerificationRequest true "Verification code"
+// @Success 200 {object} response.PixKeyResponse
+// @Failure 400 {object} http_error.HttpError
+// @Failure 404 {object} http_error.HttpError
+// @Router /pix_key/{id}/verify [put]
+func (ph *pixKeyHandler) VerifyPixKeyHandler(c *gin.Context) {
+	id, code, ok := bindVerificationCode(c, "verifyPixKey")
+	if !ok {
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	result, err := ph.pixKeyService.VerifyPixKeyService(ctx, id, code)
+	if err != nil {
+		logger.FromContext(c.Request.Context()).Error("Error trying to call VerifyPixKey service", err, zap.String("journey", "verifyPixKey"))
+		c.JSON(err.Code, err)
+		return
+	}
+	c.JSON(http.StatusOK, result)
+}
+
+// FindPixKeysByUserHandler lists the keys of a user.
+// @Summary Find User keys
+// @Description Retrieves every key registered by the user, active or pending.
+// @Tags Users
+// @Accept json
+// @Produce json
+// @Param id path string true "ID of the user"
+// @Success 200 {array} response.PixKeyResponse "Keys retrieved successfully"
+// @Failure 400 {object} http_error.HttpError "Error: Invalid user ID"
+// @Failure 404 {object} http_error.HttpError "User not found"
+// @Router /user/{id}/pix_keys [get]
+func (ph *pixKeyHandler) FindPixKeysByUserHandler(c *gin.Context) {
+	id, ok := parseIDParam(c, "findPixKeysByUser")
+	if !ok {
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	keys, err := ph.pixKeyService.FindPixKeysByUserService(ctx, id)
+	if err != nil {
+		logger.FromContext(c.Request.Context()).Error("Error finding user keys", err, zap.String("journey", "findPixKeysByUser"))
+		c.JSON(err.Code, err)
+		return
+	}
+	c.JSON(http.StatusOK, keys)
+}
+
+// LookupPixKeyHandler finds the owner of an active key.
+// @Summary Lookup key
+// @Description Retrieves the owner of an active key so the payer can confirm it before paying. The owner name and CPF are masked.
+// @Tags Keys
+// @Accept json
+// @Produce json
+// @Param key path string true "Email, phone, CPF/CNPJ or random key"
+// @Success 200 {object} response.PixKeyLookupResponse
+// @Failure 404 {object} http_error.HttpError "Key not found"
+// @Router /pix_key/lookup/{key} [get]
+func (ph *pixKeyHandler) LookupPixKeyHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	result, err := ph.pixKeyService.LookupPixKeyService(ctx, c.Param("key"))
+	if err != nil {
+		logger.FromContext(c.Request.Context()).Error("Error looking up key", err, zap.String("journey", "lookupPixKey"))
+		c.JSON(err.Code, err)
+		return
+	}
+	c.JSON(http.StatusOK, result)
+}
+
+// DeletePixKeyHandler removes a key.
+// @Summary Delete key
+// @Description Removes the key from the directory, making it available for registration again.
+// @Tags Keys
+// @Accept json
+// @Produce json
+// @Param id path string true "ID of the key to be deleted"
+// @Success 204
+// @Failure 400 {object} http_error.HttpError
+// @Failure 404 {object} http_error.HttpError
+// @Router /pix_key/{id} [delete]
+func (ph *pixKeyHandler) DeletePixKeyHandler(c *gin.Context) {
+	id, ok := parseIDParam(c, "deletePixKey")
+	if !ok {
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	if err := ph.pixKeyService.DeletePixKeyService(ctx, id); err != nil {
+		logger.FromContext(c.Request.Context()).Error("Error trying to call DeletePixKey service", err, zap.String("journey", "deletePixKey"))
+		c.JSON(err.Code, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// InsertPixKeyClaimHandler opens a portability claim for a key owned by another user.
+// @Summary Claim key
+// @Description Opens a claim to move an email or phone key to the claimer. A code is sent to the key to prove ownership and the current owner is notified so they can cancel the claim.
+// @Tags Keys
+// @Accept json
+// @Produce json
+// @Param claimRequest body request.PixKeyClaimRequest true "Claim information"
+// @Success 201 {object} response.PixKeyClaimResponse
+// @Failure 400 {object} http_error.HttpError
+// @Failure 404 {object} http_error.HttpError
+// @Router /pix_key/claim [post]
+func (ph *pixKeyHandler) InsertPixKeyClaimHandler(c *gin.Context) {
+	var claimRequest request.PixKeyClaimRequest
+
+	if err := c.ShouldBindJSON(&claimRequest); err != nil {
+		logger.FromContext(c.Request.Context()).Error("Error trying to validate claim info", err,
+			zap.String("journey", "createPixKeyClaim"))
+		errRest := validation.ValidateError(err)
+		c.JSON(errRest.Code, errRest)
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	result, err := ph.pixKeyService.InsertPixKeyClaimService(ctx, uuid.MustParse(claimRequest.ClaimerID), claimRequest.Key)
+	if err != nil {
+		logger.FromContext(c.Request.Context()).Error("Error trying to call InsertPixKeyClaim service", err, zap.String("journey", "createPixKeyClaim"))
+		c.JSON(err.Code, err)
+		return
+	}
+	c.JSON(http.StatusCreated, result)
+}
+
+// ConfirmPixKeyClaimHandler completes a portability claim.
+// @Summary Confirm key claim
+// @Description Moves the key to the claimer with the code sent to the key.
+// @Tags Keys
+// @Accept json
+// @Produce json
+// @Param id path string true "ID of the claim"
+// @Param verificationRequest body request.PixKeyVerificationRequest true "Verification code"
+// @Success 200 {object} response.PixKeyClaimResponse
+// @Failure 400 {object} http_error.HttpError
+// @Failure 404 {object} http_error.HttpError
+// @Router /pix_key/claim/{id}/confirm [put]
+func (ph *pixKeyHandler) ConfirmPixKeyClaimHandler(c *gin.Context) {
+	id, code, ok := bindVerificationCode(c, "confirmPixKeyClaim")
+	if !ok {
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	result, err := ph.pixKeyService.ConfirmPixKeyClaimService(ctx, id, code)
+	if err != nil {
+		logger.FromContext(c.Request.Context()).Error("Error trying to call ConfirmPixKeyClaim service", err, zap.String("journey", "confirmPixKeyClaim"))
+		c.JSON(err.Code, err)
+		return
+	}
+	c.JSON(http.StatusOK, result)
+}
+
+// CancelPixKeyClaimHandler cancels an open portability claim.
+// @Summary Cancel key claim
+// @Description Cancels an open claim, keeping the key with its current owner. Only the current owner of the key and the claimer can cancel it.
+// @Tags Keys
+// @Accept json
+// @Produce json
+// @Param id path string true "ID of the claim"
+// @Param user_id query string true "ID of the owner of the key or of the claimer"
+// @Success 200 {object} response.PixKeyClaimResponse
+// @Failure 400 {object} http_error.HttpError
+// @Failure 403 {object} http_error.HttpError
+// @Failure 404 {object} http_error.HttpError
+// @Router /pix_key/claim/{id}/cancel [put]
+func (ph *pixKeyHandler) CancelPixKeyClaimHandler(c *gin.Context) {
+	id, ok := parseIDParam(c, "cancelPixKeyClaim")
+	if !ok {
+		return
+	}
+	userID, ok := parseUserIDQuery(c, "cancelPixKeyClaim")
+	if !ok {
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	result, err := ph.pixKeyService.CancelPixKeyClaimService(ctx, id, userID)
+	if err != nil {
+		logger.FromContext(c.Request.Context()).Error("Error trying to call CancelPixKeyClaim service", err, zap.String("journey", "cancelPixKeyClaim"))
+		c.JSON(err.Code, err)
+		return
+	}
+	c.JSON(http.StatusOK, result)
+}
+
+func bindVerificationCode(c *gin.Context, journey string) (uuid.UUID, string, bool) {
+	id, ok := parseIDParam(c, journey)
+	if !ok {
+		return uuid.UUID{}, "", false
+	}
+
+	var verificationRequest request.PixKeyVerificationRequest
+	if err := c.ShouldBindJSON(&verificationRequest); err != nil {
+		logger.FromContext(c.Request.Context()).Error("Error trying to validate verification code", err,
+			zap.String("journey", journey))
+		errRest := validation.ValidateError(err)
+		c.JSON(errRest.Code, errRest)
+		return uuid.UUID{}, "", false
+	}
+
+	return id, verificationRequest.Code, true
+}
@@ -0,0 +1,193 @@
+package handler
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/felipeversiane/picpay-golang.git/config/http_error"
+	"github.com/felipeversiane/picpay-golang.git/config/logger"
+	"github.com/felipeversiane/picpay-golang.git/config/validation"
+	domain "github.com/felipeversiane/picpay-golang.git/internal"
+	"github.com/felipeversiane/picpay-golang.git/internal/entity/request"
+	"github.com/felipeversiane/picpay-golang.git/internal/service"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/skip2/go-qrcode"
+	"go.uber.org/zap"
+)
+
+const defaultQRCodeExpiration = time.Hour
+
+type qrCodeHandler struct {
+	qrCodeService service.QRCodeService
+}
+
+func NewQRCodeHandler(
+	qrCodeService service.QRCodeService,
+) QRCodeHandler {
+	return &qrCodeHandler{
+		qrCodeService,
+	}
+}
+
+type QRCodeHandler interface {
+	InsertQRCodeHandler(c *gin.Context)
+	FindQRCodeByIDHandler(c *gin.Context)
+	FindQRCodeImageHandler(c *gin.Context)
+	PayQRCodeHandler(c *gin.Context)
+}
+
+// InsertQRCodeHandler generates a QR code for a merchant.
+// @Summary Insert a new QR code
+// @Description Generates an EMV (BR Code) payload for the merchant. Static codes are reusable and may have an amount; dynamic codes have a fixed amount, can be paid once and expire after expires_in seconds (default 3600).
+// @Tags QR Codes
+// @Accept json
+// @Produce json
+// @Param qrCodeRequest body request.QRCodeRequest true "QR code information"
+// @Success 201 {object} response.QRCodeResponse
+// @Failure 400 {object} http_error.HttpError
+// @Failure 404 {object} http_error.HttpError
+// @Failure 500 {object} http_error.HttpError
+// @Router /qr_code [post]
+func (qh *qrCodeHandler) InsertQRCodeHandler(c *gin.Context) {
+	var qrCodeRequest request.QRCodeRequest
+
+	if err := c.ShouldBindJSON(&qrCodeRequest); err != nil {
+		logger.FromContext(c.Request.Context()).Error("Error trying to validate QR code info", err,
+			zap.String("journey", "createQRCode"))
+		errRest := validation.ValidateError(err)
+		c.JSON(errRest.Code, errRest)
+		return
+	}
+
+	var expiresAt *time.Time
+	if qrCodeRequest.Type == domain.QRCodeTypeDynamic {
+		expiration := defaultQRCodeExpiration
+		if qrCodeRequest.ExpiresIn > 0 {
+			expiration = time.Duration(qrCodeRequest.ExpiresIn) * time.Second
+		}
+		at := time.Now().Add(expiration)
+		expiresAt = &at
+	}
+
+	qrCode := domain.NewQRCodeDomain(
+		uuid.MustParse(qrCodeRequest.MerchantID),
+		qrCodeRequest.Type,
+		qrCodeRequest.Amount,
+		expiresAt,
+	)
+
+	ctx := c.Request.Context()
+
+	result, err := qh.qrCodeService.InsertQRCodeService(ctx, qrCode, qrCodeRequest.City)
+	if err != nil {
+		logger.FromContext(c.Request.Context()).Error(
+			"Error trying to call InsertQRCode service",
+			err,
+			zap.String("journey", "createQRCode"))
+		c.JSON(err.Code, err)
+		return
+	}
+	c.JSON(http.StatusCreated, result)
+}
+
+// FindQRCodeByIDHandler retrieves a QR code.
+// @Summary Find QR code by ID
+// @Description Retrieves the QR code with its payload and status.
+// @Tags QR Codes
+// @Accept json
+// @Produce json
+// @Param id path string true "ID of the QR code"
+// @Success 200 {object} response.QRCodeResponse
+// @Failure 400 {object} http_error.HttpError "Error: Invalid QR code ID"
+// @Failure 404 {object} http_error.HttpError "QR code not found"
+// @Router /qr_code/{id} [get]
+func (qh *qrCodeHandler) FindQRCodeByIDHandler(c *gin.Context) {
+	id, ok := parseIDParam(c, "findQRCodeByID")
+	if !ok {
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	result, err := qh.qrCodeService.FindQRCodeByIDService(ctx, id)
+	if err != nil {
+		logger.FromContext(c.Request.Context()).Error("Error finding QR code", err, zap.String("journey", "findQRCodeByID"))
+		c.JSON(err.Code, err)
+		return
+	}
+	c.JSON(http.StatusOK, result)
+}
+
+// FindQRCodeImageHandler renders a QR code as a PNG image.
+// @Summary Find QR code image
+// @Description Renders the payload of the QR code as a 256x256 PNG image.
+// @Tags QR Codes
+// @Produce png
+// @Param id path string true "ID of the QR code"
+// @Success 200 {file} binary
+// @Failure 400 {object} http_error.HttpError "Error: Invalid QR code ID"
+// @Failure 404 {object} http_error.HttpError "QR code not found"
+// @Router /qr_code/{id}/image [get]
+func (qh *qrCodeHandler) FindQRCodeImageHandler(c *gin.Context) {
+	id, ok := parseIDParam(c, "findQRCodeImage")
+	if !ok {
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	result, err := qh.qrCodeService.FindQRCodeByIDService(ctx, id)
+	if err != nil {
+		logger.FromContext(c.Request.Context()).Error("Error finding QR code", err, zap.String("journey", "findQRCodeImage"))
+		c.JSON(err.Code, err)
+		return
+	}
+
+	png, encodeErr := qrcode.Encode(result.Payload, qrcode.Medium, 256)
+	if encodeErr != nil {
+		logger.FromContext(c.Request.Context()).Error("Error rendering QR code", encodeErr, zap.String("journey", "findQRCodeImage"))
+		errMessage := http_error.NewInternalServerError("Error rendering QR code")
+		c.JSON(errMessage.Code, errMessage)
+		return
+	}
+	c.Data(http.StatusOK, "image/png", png)
+}
+
+// PayQRCodeHandler pays a QR code.
+// @Summary Insert a new order from a QR code
+// @Description Parses and validates the EMV payload (checksum, issuer, status and expiration) and transfers the amount to the merchant. The amount is required only for codes without a fixed amount.
+// @Tags Orders
+// @Accept json
+// @Produce json
+// @Param qrCodeOrderRequest body request.QRCodeOrderRequest true "Payer and scanned payload"
+// @Success 201 {object} response.OrderResponse
+// @Failure 400 {object} http_error.HttpError
+// @Failure 404 {object} http_error.HttpError
+// @Failure 422 {object} http_error.HttpError
+// @Failure 500 {object} http_error.HttpError
+// @Router /order/qr [post]
+func (qh *qrCodeHandler) PayQRCodeHandler(c *gin.Context) {
+	var orderRequest request.QRCodeOrderRequest
+
+	if err := c.ShouldBindJSON(&orderRequest); err != nil {
+		logger.FromContext(c.Request.Context()).Error("Error trying to validate QR code order info", err,
+			zap.String("journey", "createQRCodeOrder"))
+		errRest := validation.ValidateError(err)
+		c.JSON(errRest.Code, errRest)
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	result, err := qh.qrCodeService.PayQRCodeService(ctx, uuid.MustParse(orderRequest.Payer), orderRequest.Payload, orderRequest.Amount)
+	if err != nil {
+		logger.FromContext(c.Request.Context()).Error(
+			"Error trying to call PayQRCode service",
+			err,
+			zap.String("journey", "createQRCodeOrder"))
+		c.JSON(err.Code, err)
+		return
+	}
+	c.JSON(http.StatusCreated, result)
+}
@@ -0,0 +1,117 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/felipeversiane/picpay-golang.git/config/http_error"
+	"github.com/felipeversiane/picpay-golang.git/config/logger"
+	"github.com/felipeversiane/picpay-golang.git/config/validation"
+	"github.com/felipeversiane/picpay-golang.git/internal/entity/request"
+	"github.com/felipeversiane/picpay-golang.git/internal/receipt"
+	"github.com/felipeversiane/picpay-golang.git/internal/service"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type receiptHandler struct {
+	receiptService service.ReceiptService
+}
+
+func NewReceiptHandler(
+	receiptService service.ReceiptService,
+) ReceiptHandler {
+	return &receiptHandler{
+		receiptService,
+	}
+}
+
+type ReceiptHandler interface {
+	FindReceiptHandler(c *gin.Context)
+	VerifyReceiptHandler(c *gin.Context)
+}
+
+// FindReceiptHandler issues the receipt of an order.
+// @Summary Find order receipt
+// @Description Issues the receipt of the order with masked payer and payee data and an authentication code that can be checked at /receipts/verify. Returns JSON by default or a PDF file with format=pdf.
+// @Tags Receipts
+// @Produce json
+// @Produce application/pdf
+// @Param id path string true "ID of the order"
+// @Param format query string false "json (default) or pdf"
+// @Success 200 {object} response.ReceiptResponse
+// @Failure 400 {object} http_error.HttpError "Error: Invalid order ID or format"
+// @Failure 404 {object} http_error.HttpError "Order not found"
+// @Router /order/{id}/receipt [get]
+func (rh *receiptHandler) FindReceiptHandler(c *gin.Context) {
+	id, ok := parseIDParam(c, "findReceipt")
+	if !ok {
+		return
+	}
+
+	format := c.DefaultQuery("format", "json")
+	if format != "json" && format != "pdf" {
+		errMessage := http_error.NewBadRequestError("Format must be json or pdf")
+		c.JSON(errMessage.Code, errMessage)
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	result, err := rh.receiptService.FindReceiptService(ctx, id)
+	if err != nil {
+		logger.FromContext(c.Request.Context()).Error("Error finding receipt", err, zap.String("journey", "findReceipt"))
+		c.JSON(err.Code, err)
+		return
+	}
+
+	if format == "json" {
+		c.JSON(http.StatusOK, result)
+		return
+	}
+
+	pdf, renderErr := receipt.RenderPDF(result)
+	if renderErr != nil {
+		logger.FromContext(c.Request.Context()).Error("Error rendering receipt", renderErr, zap.String("journey", "findReceipt"))
+		errMessage := http_error.NewInternalServerError("Error rendering receipt")
+		c.JSON(errMessage.Code, errMessage)
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=\"receipt-%s.pdf\"", id))
+	c.Data(http.StatusOK, "application/pdf", pdf)
+}
+
+// VerifyReceiptHandler checks the authentication code of a receipt.
+// @Summary Verify receipt
+// @Description Checks that the authentication code was issued for the order. A valid code returns the receipt so it can be compared with the one presented.
+// @Tags Receipts
+// @Accept json
+// @Produce json
+// @Param receiptVerificationRequest body request.ReceiptVerificationRequest true "Order ID and authentication code"
+// @Success 200 {object} response.ReceiptVerificationResponse
+// @Failure 400 {object} http_error.HttpError
+// @Failure 500 {object} http_error.HttpError
+// @Router /receipts/verify [post]
+func (rh *receiptHandler) VerifyReceiptHandler(c *gin.Context) {
+	var verificationRequest request.ReceiptVerificationRequest
+
+	if err := c.ShouldBindJSON(&verificationRequest); err != nil {
+		logger.FromContext(c.Request.Context()).Error("Error trying to validate receipt info", err,
+			zap.String("journey", "verifyReceipt"))
+		errRest := validation.ValidateError(err)
+		c.JSON(errRest.Code, errRest)
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	result, err := rh.receiptService.VerifyReceiptService(ctx, uuid.MustParse(verificationRequest.OrderID), verificationRequest.AuthenticationCode)
+	if err != nil {
+		logger.FromContext(c.Request.Context()).Error("Error verifying receipt", err, zap.String("journey", "verifyReceipt"))
+		c.JSON(err.Code, err)
+		return
+	}
+	c.JSON(http.StatusOK, result)
+}
@@ -0,0 +1,212 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/felipeversiane/picpay-golang.git/config/http_error"
+	"github.com/felipeversiane/picpay-golang.git/config/logger"
+	"github.com/felipeversiane/picpay-golang.git/config/validation"
+	domain "github.com/felipeversiane/picpay-golang.git/internal"
+	"github.com/felipeversiane/picpay-golang.git/internal/entity/request"
+	"github.com/felipeversiane/picpay-golang.git/internal/entity/response"
+	"github.com/felipeversiane/picpay-golang.git/internal/service"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type recurrenceHandler struct {
+	recurrenceService service.RecurrenceService
+}
+
+func NewRecurrenceHandler(
+	recurrenceService service.RecurrenceService,
+) RecurrenceHandler {
+	return &recurrenceHandler{
+		recurrenceService,
+	}
+}
+
+type RecurrenceHandler interface {
+	InsertRecurrenceHandler(c *gin.Context)
+	FindRecurrenceByIDHandler(c *gin.Context)
+	FindRecurrenceExecutionsHandler(c *gin.Context)
+	PauseRecurrenceHandler(c *gin.Context)
+	ResumeRecurrenceHandler(c *gin.Context)
+	CancelRecurrenceHandler(c *gin.Context)
+}
+
+// InsertRecurrenceHandler Creates a new recurring transfer
+// @Summary Insert a new recurrence
+// @Description Creates a standing order that generates a regular order on every occurrence of the cron schedule (evaluated in UTC)
+// @Tags Recurrences
+// @Accept json
+// @Produce json
+// @Param recurrenceRequest body request.RecurrenceRequest true "Recurrence information for registration"
+// @Success 201 {object} response.RecurrenceResponse
+// @Failure 400 {object} http_error.HttpError
+// @Failure 500 {object} http_error.HttpError
+// @Router /recurrence [post]
+func (rh *recurrenceHandler) InsertRecurrenceHandler(c *gin.Context) {
+	var recurrenceRequest request.RecurrenceRequest
+
+	if err := c.ShouldBindJSON(&recurrenceRequest); err != nil {
+		logger.FromContext(c.Request.Context()).Error("Error trying to validate recurrence info", err,
+			zap.String("journey", "createRecurrence"))
+		errRest := validation.ValidateError(err)
+		c.JSON(errRest.Code, errRest)
+		return
+	}
+
+	payee, payeeErr := uuid.Parse(recurrenceRequest.Payee)
+	if payeeErr != nil {
+		logger.FromContext(c.Request.Context()).Error("Error trying to parse Payee UUID", payeeErr,
+			zap.String("journey", "createRecurrence"))
+		errMessage := http_error.NewBadRequestError("Invalid Payee UUID")
+		c.JSON(errMessage.Code, errMessage)
+		return
+	}
+
+	payer, payerErr := uuid.Parse(recurrenceRequest.Payer)
+	if payerErr != nil {
+		logger.FromContext(c.Request.Context()).Error("Error trying to parse Payer UUID", payerErr,
+			zap.String("journey", "createRecurrence"))
+		errMessage := http_error.NewBadRequestError("Invalid Payer UUID")
+		c.JSON(errMessage.Code, errMessage)
+		return
+	}
+
+	recurrence := domain.NewRecurrenceDomain(
+		recurrenceRequest.Amount,
+		payee,
+		payer,
+		recurrenceRequest.Schedule,
+		recurrenceRequest.OnInsufficientFunds,
+		recurrenceRequest.MaxRetries,
+	)
+
+	ctx := c.Request.Context()
+
+	result, err := rh.recurrenceService.InsertRecurrenceService(ctx, recurrence)
+	if err != nil {
+		logger.FromContext(c.Request.Context()).Error(
+			"Error trying to call InsertRecurrence service",
+			err,
+			zap.String("journey", "createRecurrence"))
+		c.JSON(err.Code, err)
+		return
+	}
+	c.JSON(http.StatusCreated, result)
+}
+
+// FindRecurrenceByIDHandler retrieves recurrence information based on the provided ID.
+// @Summary Find Recurrence by ID
+// @Description Retrieves recurrence details based on the recurrence ID provided as a parameter.
+// @Tags Recurrences
+// @Accept json
+// @Produce json
+// @Param id path string true "ID of the recurrence to be retrieved"
+// @Success 200 {object} response.RecurrenceResponse "Recurrence information retrieved successfully"
+// @Failure 400 {object} http_error.HttpError "Error: Invalid recurrence ID"
+// @Failure 404 {object} http_error.HttpError "Recurrence not found"
+// @Router /recurrence/{id} [get]
+func (rh *recurrenceHandler) FindRecurrenceByIDHandler(c *gin.Context) {
+	rh.handleRecurrence(c, "findRecurrenceByID", rh.recurrenceService.FindRecurrenceByIDService)
+}
+
+// FindRecurrenceExecutionsHandler lists the execution history of a recurrence.
+// @Summary Find Recurrence executions
+// @Description Retrieves every execution of the recurrence, most recent first.
+// @Tags Recurrences
+// @Accept json
+// @Produce json
+// @Param id path string true "ID of the recurrence"
+// @Success 200 {array} response.RecurrenceExecutionResponse "Executions retrieved successfully"
+// @Failure 400 {object} http_error.HttpError "Error: Invalid recurrence ID"
+// @Failure 404 {object} http_error.HttpError "Recurrence not found"
+// @Router /recurrence/{id}/executions [get]
+func (rh *recurrenceHandler) FindRecurrenceExecutionsHandler(c *gin.Context) {
+	id, ok := parseIDParam(c, "findRecurrenceExecutions")
+	if !ok {
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	executions, err := rh.recurrenceService.FindRecurrenceExecutionsService(ctx, id)
+	if err != nil {
+		logger.FromContext(c.Request.Context()).Error("Error finding recurrence executions", err, zap.String("journey", "findRecurrenceExecutions"))
+		c.JSON(err.Code, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, executions)
+}
+
+// PauseRecurrenceHandler pauses an active recurrence.
+// @Summary Pause Recurrence
+// @Description Stops generating orders for the recurrence until it is resumed.
+// @Tags Recurrences
+// @Accept json
+// @Produce json
+// @Param id path string true "ID of the recurrence to be paused"
+// @Success 200 {object} response.RecurrenceResponse
+// @Failure 400 {object} http_error.HttpError
+// @Failure 404 {object} http_error.HttpError
+// @Router /recurrence/{id}/pause [put]
+func (rh *recurrenceHandler) PauseRecurrenceHandler(c *gin.Context) {
+	rh.handleRecurrence(c, "pauseRecurrence", rh.recurrenceService.PauseRecurrenceService)
+}
+
+// ResumeRecurrenceHandler resumes a paused recurrence.
+// @Summary Resume Recurrence
+// @Description Schedules the next occurrence of a paused recurrence from now on.
+// @Tags Recurrences
+// @Accept json
+// @Produce json
+// @Param id path string true "ID of the recurrence to be resumed"
+// @Success 200 {object} response.RecurrenceResponse
+// @Failure 400 {object} http_error.HttpError
+// @Failure 404 {object} http_error.HttpError
+// @Router /recurrence/{id}/resume [put]
+func (rh *recurrenceHandler) ResumeRecurrenceHandler(c *gin.Context) {
+	rh.handleRecurrence(c, "resumeRecurrence", rh.recurrenceService.ResumeRecurrenceService)
+}
+
+// CancelRecurrenceHandler cancels a recurrence keeping its execution history.
+// @Summary Cancel Recurrence
+// @Description Cancels the recurrence. Its execution history is kept.
+// @Tags Recurrences
+// @Accept json
+// @Produce json
+// @Param id path string true "ID of the recurrence to be cancelled"
+// @Success 200 {object} response.RecurrenceResponse
+// @Failure 400 {object} http_error.HttpError
+// @Failure 404 {object} http_error.HttpError
+// @Router /recurrence/{id} [delete]
+func (rh *recurrenceHandler) CancelRecurrenceHandler(c *gin.Context) {
+	rh.handleRecurrence(c, "cancelRecurrence", rh.recurrenceService.CancelRecurrenceService)
+}
+
+func (rh *recurrenceHandler) handleRecurrence(
+	c *gin.Context,
+	journey string,
+	call func(ctx context.Context, id uuid.UUID) (response.RecurrenceResponse, *http_error.HttpError),
+) {
+	id, ok := parseIDParam(c, journey)
+	if !ok {
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	result, err := call(ctx, id)
+	if err != nil {
+		logger.FromContext(c.Request.Context()).Error("Error trying to call recurrence service", err, zap.String("journey", journey))
+		c.JSON(err.Code, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
@@ -0,0 +1,85 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/felipeversiane/picpay-golang.git/config/logger"
+	"github.com/felipeversiane/picpay-golang.git/internal/service"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+type settlementHandler struct {
+	settlementService service.SettlementService
+}
+
+func NewSettlementHandler(
+	settlementService service.SettlementService,
+) SettlementHandler {
+	return &settlementHandler{
+		settlementService,
+	}
+}
+
+type SettlementHandler interface {
+	FindPendingSettlementsHandler(c *gin.Context)
+	FindSettlementScheduleHandler(c *gin.Context)
+}
+
+// FindPendingSettlementsHandler lists the pending settlements of a merchant.
+// @Summary Find User pending settlements
+// @Description Retrieves every received amount that is still pending, ordered by the business day it will be released.
+// @Tags Users
+// @Accept json
+// @Produce json
+// @Param id path string true "ID of the merchant"
+// @Success 200 {array} response.SettlementResponse "Pending settlements retrieved successfully"
+// @Failure 400 {object} http_error.HttpError "Error: Invalid user ID"
+// @Failure 404 {object} http_error.HttpError "User not found"
+// @Router /user/{id}/settlements [get]
+func (sh *settlementHandler) FindPendingSettlementsHandler(c *gin.Context) {
+	id, ok := parseIDParam(c, "findPendingSettlements")
+	if !ok {
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	settlements, err := sh.settlementService.FindPendingSettlementsService(ctx, id)
+	if err != nil {
+		logger.FromContext(c.Request.Context()).Error("Error finding pending settlements", err, zap.String("journey", "findPendingSettlements"))
+		c.JSON(err.Code, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, settlements)
+}
+
+// FindSettlementScheduleHandler retrieves the upcoming settlements of a merchant grouped by day.
+// @Summary Find User settlement schedule
+// @Description Retrieves the pending amount that will become available on each upcoming business day.
+// @Tags Users
+// @Accept json
+// @Produce json
+// @Param id path string true "ID of the merchant"
+// @Success 200 {array} response.SettlementScheduleResponse "Settlement schedule retrieved successfully"
+// @Failure 400 {object} http_error.HttpError "Error: Invalid user ID"
+// @Failure 404 {object} http_error.HttpError "User not found"
+// @Router /user/{id}/settlements/schedule [get]
+func (sh *settlementHandler) FindSettlementScheduleHandler(c *gin.Context) {
+	id, ok := parseIDParam(c, "findSettlementSchedule")
+	if !ok {
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	schedule, err := sh.settlementService.FindSettlementScheduleService(ctx, id)
+	if err != nil {
+		logger.FromContext(c.Request.Context()).Error("Error finding settlement schedule", err, zap.String("journey", "findSettlementSchedule"))
+		c.JSON(err.Code, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, schedule)
+}
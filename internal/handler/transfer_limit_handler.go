@@ -0,0 +1,55 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/felipeversiane/picpay-golang.git/config/logger"
+	"github.com/felipeversiane/picpay-golang.git/internal/service"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+type transferLimitHandler struct {
+	transferLimitService service.TransferLimitService
+}
+
+func NewTransferLimitHandler(
+	transferLimitService service.TransferLimitService,
+) TransferLimitHandler {
+	return &transferLimitHandler{
+		transferLimitService,
+	}
+}
+
+type TransferLimitHandler interface {
+	FindTransferLimitsHandler(c *gin.Context)
+}
+
+// FindTransferLimitsHandler retrieves the transfer limits of a user.
+// @Summary Find User transfer limits
+// @Description Retrieves the limits of the user tier together with the current usage and the remaining allowance.
+// @Tags Users
+// @Accept json
+// @Produce json
+// @Param id path string true "ID of the user"
+// @Success 200 {object} response.TransferLimitsResponse "Transfer limits retrieved successfully"
+// @Failure 400 {object} http_error.HttpError "Error: Invalid user ID"
+// @Failure 404 {object} http_error.HttpError "User not found"
+// @Router /user/{id}/limits [get]
+func (th *transferLimitHandler) FindTransferLimitsHandler(c *gin.Context) {
+	id, ok := parseIDParam(c, "findTransferLimits")
+	if !ok {
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	limits, err := th.transferLimitService.FindTransferLimitsService(ctx, id)
+	if err != nil {
+		logger.FromContext(c.Request.Context()).Error("Error finding transfer limits", err, zap.String("journey", "findTransferLimits"))
+		c.JSON(err.Code, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, limits)
+}
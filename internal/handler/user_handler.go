@@ -1,9 +1,7 @@
 package handler
 
 import (
-	"context"
 	"net/http"
-	"time"
 
 	"github.com/felipeversiane/picpay-golang.git/config/http_error"
 	"github.com/felipeversiane/picpay-golang.git/config/logger"
@@ -51,7 +49,7 @@ type UserHandler interface {
 func (uh userHandler) FindUserByIDHandler(c *gin.Context) {
 	id, parseError := uuid.Parse(c.Param("id"))
 	if parseError != nil {
-		logger.Error("Error trying to validate userId",
+		logger.FromContext(c.Request.Context()).Error("Error trying to validate userId",
 			parseError,
 			zap.String("journey", "DeleteUser"),
 		)
@@ -63,12 +61,11 @@ func (uh userHandler) FindUserByIDHandler(c *gin.Context) {
 		return
 	}
 
-	ctxTimeout, cancel := context.WithTimeout(context.Background(), time.Second*10)
-	defer cancel()
+	ctx := c.Request.Context()
 
-	user, err := uh.userService.FindUserByIDService(id, ctxTimeout)
+	user, err := uh.userService.FindUserByIDService(ctx, id)
 	if err != nil {
-		logger.Error("Error finding user by ID", err, zap.String("journey", "findUserByID"))
+		logger.FromContext(c.Request.Context()).Error("Error finding user by ID", err, zap.String("journey", "findUserByID"))
 		c.JSON(err.Code, err)
 		return
 	}
@@ -87,14 +84,13 @@ func (uh userHandler) FindUserByIDHandler(c *gin.Context) {
 // @Failure 404 {object} http_error.HttpError "User not found"
 // @Router /user/find_user_by_document/{document} [get]
 func (uh userHandler) FindUserByDocumentHandler(c *gin.Context) {
-	document := c.Param("document")
+	document := validation.NormalizeDocument(c.Param("document"))
 
-	ctxTimeout, cancel := context.WithTimeout(context.Background(), time.Second*10)
-	defer cancel()
+	ctx := c.Request.Context()
 
-	user, err := uh.userService.FindUserByDocumentService(document, ctxTimeout)
+	user, err := uh.userService.FindUserByDocumentService(ctx, document)
 	if err != nil {
-		logger.Error("Error finding user by Document", err, zap.String("journey", "findUserByDocument"))
+		logger.FromContext(c.Request.Context()).Error("Error finding user by Document", err, zap.String("journey", "findUserByDocument"))
 		c.JSON(err.Code, err)
 		return
 	}
@@ -115,12 +111,11 @@ func (uh userHandler) FindUserByDocumentHandler(c *gin.Context) {
 func (uh userHandler) FindUserByEmailHandler(c *gin.Context) {
 	email := c.Param("email")
 
-	ctxTimeout, cancel := context.WithTimeout(context.Background(), time.Second*10)
-	defer cancel()
+	ctx := c.Request.Context()
 
-	user, err := uh.userService.FindUserByEmailService(email, ctxTimeout)
+	user, err := uh.userService.FindUserByEmailService(ctx, email)
 	if err != nil {
-		logger.Error("Error finding user by Email", err, zap.String("journey", "findUserByEmail"))
+		logger.FromContext(c.Request.Context()).Error("Error finding user by Email", err, zap.String("journey", "findUserByEmail"))
 		c.JSON(err.Code, err)
 		return
 	}
@@ -142,7 +137,7 @@ func (uh userHandler) FindUserByEmailHandler(c *gin.Context) {
 func (uh userHandler) DeleteUserHandler(c *gin.Context) {
 	id, parseError := uuid.Parse(c.Param("id"))
 	if parseError != nil {
-		logger.Error("Error trying to validate userId",
+		logger.FromContext(c.Request.Context()).Error("Error trying to validate userId",
 			parseError,
 			zap.String("journey", "DeleteUser"),
 		)
@@ -154,12 +149,11 @@ func (uh userHandler) DeleteUserHandler(c *gin.Context) {
 		return
 	}
 
-	ctxTimeout, cancel := context.WithTimeout(context.Background(), time.Second*10)
-	defer cancel()
+	ctx := c.Request.Context()
 
-	serviceError := uh.userService.DeleteUserService(id, ctxTimeout)
+	serviceError := uh.userService.DeleteUserService(ctx, id)
 	if serviceError != nil {
-		logger.Error("Error trying to call deleteUser service", serviceError, zap.String("journey", "DeleteUser"))
+		logger.FromContext(c.Request.Context()).Error("Error trying to call deleteUser service", serviceError, zap.String("journey", "DeleteUser"))
 		c.JSON(serviceError.Code, serviceError)
 		return
 	}
@@ -182,29 +176,31 @@ func (uh userHandler) InsertUserHandler(c *gin.Context) {
 	var userRequest request.UserRequest
 
 	if err := c.ShouldBindJSON(&userRequest); err != nil {
-		logger.Error("Error trying to validate user info", err,
+		logger.FromContext(c.Request.Context()).Error("Error trying to validate user info", err,
 			zap.String("journey", "createUser"))
 		errRest := validation.ValidateError(err)
 		c.JSON(errRest.Code, errRest)
 		return
 	}
 
+	document := validation.NormalizeDocument(userRequest.Document)
 	domain := domain.NewUserDomain(
 		userRequest.Email,
 		userRequest.Password,
 		userRequest.FirstName,
 		userRequest.LastName,
-		userRequest.Document,
+		document,
+		validation.DocumentType(document),
 		userRequest.Balance,
 		userRequest.IsMerchant,
+		userRequest.MerchantCategory,
 	)
 
-	ctxTimeout, cancel := context.WithTimeout(context.Background(), time.Second*10)
-	defer cancel()
+	ctx := c.Request.Context()
 
-	result, err := uh.userService.InsertUserService(ctxTimeout, domain)
+	result, err := uh.userService.InsertUserService(ctx, domain)
 	if err != nil {
-		logger.Error(
+		logger.FromContext(c.Request.Context()).Error(
 			"Error trying to call CreateUser service",
 			err,
 			zap.String("journey", "createUser"))
@@ -230,7 +226,7 @@ func (uh userHandler) UpdateUserHandler(c *gin.Context) {
 	var userRequest request.UserUpdateRequest
 
 	if err := c.ShouldBindJSON(&userRequest); err != nil {
-		logger.Error("Error trying to validate user info", err,
+		logger.FromContext(c.Request.Context()).Error("Error trying to validate user info", err,
 			zap.String("journey", "updateUser"))
 		errRest := validation.ValidateError(err)
 
@@ -240,7 +236,7 @@ func (uh userHandler) UpdateUserHandler(c *gin.Context) {
 
 	id, err := uuid.Parse(c.Param("id"))
 	if err != nil {
-		logger.Error("Error trying to validate userId",
+		logger.FromContext(c.Request.Context()).Error("Error trying to validate userId",
 			err,
 			zap.String("journey", "Update"),
 		)
@@ -257,14 +253,15 @@ func (uh userHandler) UpdateUserHandler(c *gin.Context) {
 		userRequest.LastName,
 		userRequest.Balance,
 		userRequest.IsMerchant,
+		userRequest.Tier,
+		userRequest.MerchantCategory,
 	)
 
-	ctxTimeout, cancel := context.WithTimeout(context.Background(), time.Second*10)
-	defer cancel()
+	ctx := c.Request.Context()
 
-	result, serviceErr := uh.userService.UpdateUserService(id, domain, ctxTimeout)
+	result, serviceErr := uh.userService.UpdateUserService(ctx, id, domain)
 	if serviceErr != nil {
-		logger.Error(
+		logger.FromContext(c.Request.Context()).Error(
 			"Error trying to call updateUser service",
 			serviceErr,
 			zap.String("journey", "updateUser"))
@@ -0,0 +1,125 @@
+package domain
+
+import (
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	InvoiceStatusOpen      = "open"
+	InvoiceStatusPaid      = "paid"
+	InvoiceStatusOverdue   = "overdue"
+	InvoiceStatusCancelled = "cancelled"
+)
+
+// InvoiceItem is a line of an invoice.
+type InvoiceItem struct {
+	Description string
+	Quantity    int
+	UnitPrice   float64
+}
+
+// GetAmount returns the quantity times the unit price, rounded to cents.
+func (i InvoiceItem) GetAmount() float64 {
+	return math.Round(float64(i.Quantity)*i.UnitPrice*100) / 100
+}
+
+type invoiceDomain struct {
+	id            uuid.UUID
+	merchantID    uuid.UUID
+	customerID    uuid.UUID
+	items         []InvoiceItem
+	lateFee       float64
+	dailyInterest float64
+	dueDate       time.Time
+	status        string
+	createdAt     time.Time
+	updatedAt     time.Time
+}
+
+type InvoiceDomainInterface interface {
+	GetID() uuid.UUID
+	GetMerchantID() uuid.UUID
+	GetCustomerID() uuid.UUID
+	GetItems() []InvoiceItem
+	GetAmount() float64
+	GetLateFee() float64
+	GetDailyInterest() float64
+	GetDueDate() time.Time
+	GetStatus() string
+	GetCreatedAt() time.Time
+	GetUpdatedAt() time.Time
+}
+
+func NewInvoiceDomain(
+	merchantID uuid.UUID,
+	customerID uuid.UUID,
+	items []InvoiceItem,
+	lateFee float64,
+	dailyInterest float64,
+	dueDate time.Time,
+) *invoiceDomain {
+	return &invoiceDomain{
+		id:            uuid.New(),
+		merchantID:    merchantID,
+		customerID:    customerID,
+		items:         items,
+		lateFee:       lateFee,
+		dailyInterest: dailyInterest,
+		dueDate:       dueDate,
+		status:        InvoiceStatusOpen,
+		createdAt:     time.Now(),
+		updatedAt:     time.Now(),
+	}
+}
+
+func (i *invoiceDomain) GetID() uuid.UUID {
+	return i.id
+}
+
+func (i *invoiceDomain) GetMerchantID() uuid.UUID {
+	return i.merchantID
+}
+
+func (i *invoiceDomain) GetCustomerID() uuid.UUID {
+	return i.customerID
+}
+
+func (i *invoiceDomain) GetItems() []InvoiceItem {
+	return i.items
+}
+
+// GetAmount returns the sum of the items.
+func (i *invoiceDomain) GetAmount() float64 {
+	var cents int64
+	for _, item := range i.items {
+		cents += int64(math.Round(item.GetAmount() * 100))
+	}
+	return float64(cents) / 100
+}
+
+func (i *invoiceDomain) GetLateFee() float64 {
+	return i.lateFee
+}
+
+func (i *invoiceDomain) GetDailyInterest() float64 {
+	return i.dailyInterest
+}
+
+func (i *invoiceDomain) GetDueDate() time.Time {
+	return i.dueDate
+}
+
+func (i *invoiceDomain) GetStatus() string {
+	return i.status
+}
+
+func (i *invoiceDomain) GetCreatedAt() time.Time {
+	return i.createdAt
+}
+
+func (i *invoiceDomain) GetUpdatedAt() time.Time {
+	return i.updatedAt
+}
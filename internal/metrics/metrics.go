@@ -0,0 +1,124 @@
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "picpay"
+
+const (
+	TransferOutcomeCompleted = "completed"
+	TransferOutcomeRejected  = "rejected"
+	TransferOutcomeFailed    = "failed"
+
+	AuthorizerResultAuthorized = "authorized"
+	AuthorizerResultDenied     = "denied"
+	AuthorizerResultError      = "error"
+)
+
+// unmatchedRoute labels requests that hit no route, so unknown paths do not
+// create a new series each.
+const unmatchedRoute = "unmatched"
+
+var registry = prometheus.NewRegistry()
+
+var (
+	httpRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "http_requests_total",
+		Help:      "HTTP requests by method, route and status.",
+	}, []string{"method", "route", "status"})
+
+	httpRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "http_request_duration_seconds",
+		Help:      "HTTP request latency by method, route and status.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "route", "status"})
+
+	transfers = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "transfers_total",
+		Help:      "Transfers by outcome.",
+	}, []string{"outcome"})
+
+	transferAmount = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "transfer_amount_total",
+		Help:      "Transferred volume in BRL by outcome.",
+	}, []string{"outcome"})
+
+	authorizerDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "authorizer_request_duration_seconds",
+		Help:      "Latency of calls to the external authorizer by result.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"result"})
+
+	authorizerErrors = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "authorizer_errors_total",
+		Help:      "Calls to the external authorizer that failed or returned an unreadable answer.",
+	})
+)
+
+func init() {
+	registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		httpRequests,
+		httpRequestDuration,
+		transfers,
+		transferAmount,
+		authorizerDuration,
+		authorizerErrors,
+	)
+}
+
+// Handler serves every metric in the Prometheus text format.
+func Handler() http.Handler {
+	return promhttp.HandlerFor(registry, promhttp.HandlerOpts{})
+}
+
+// Middleware counts and times every request by its route template, such as
+// /api/v1/user/:id, rather than by its path.
+func Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		c.Next()
+
+		route := c.FullPath()
+		if route == "" {
+			route = unmatchedRoute
+		}
+		status := strconv.Itoa(c.Writer.Status())
+
+		httpRequests.WithLabelValues(c.Request.Method, route, status).Inc()
+		httpRequestDuration.WithLabelValues(c.Request.Method, route, status).Observe(time.Since(start).Seconds())
+	}
+}
+
+func ObserveTransfer(outcome string, amount float64) {
+	transfers.WithLabelValues(outcome).Inc()
+	transferAmount.WithLabelValues(outcome).Add(amount)
+}
+
+func ObserveAuthorizer(result string, duration time.Duration) {
+	authorizerDuration.WithLabelValues(result).Observe(duration.Seconds())
+	if result == AuthorizerResultError {
+		authorizerErrors.Inc()
+	}
+}
+
+// RegisterPool exposes the statistics of the database pool.
+func RegisterPool(pool *pgxpool.Pool) {
+	registry.MustRegister(newPoolCollector(pool))
+}
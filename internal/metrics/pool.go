@@ -0,0 +1,79 @@
+package metrics
+
+import (
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// poolCollector reads pgxpool.Stat on every scrape instead of keeping gauges
+// up to date.
+type poolCollector struct {
+	pool *pgxpool.Pool
+
+	acquiredConns        *prometheus.Desc
+	idleConns            *prometheus.Desc
+	constructingConns    *prometheus.Desc
+	totalConns           *prometheus.Desc
+	maxConns             *prometheus.Desc
+	acquires             *prometheus.Desc
+	canceledAcquires     *prometheus.Desc
+	emptyAcquires        *prometheus.Desc
+	acquireDuration      *prometheus.Desc
+	newConns             *prometheus.Desc
+	maxLifetimeDestroyed *prometheus.Desc
+	maxIdleDestroyed     *prometheus.Desc
+}
+
+func newPoolCollector(pool *pgxpool.Pool) prometheus.Collector {
+	desc := func(name string, help string) *prometheus.Desc {
+		return prometheus.NewDesc(prometheus.BuildFQName(namespace, "db_pool", name), help, nil, nil)
+	}
+
+	return &poolCollector{
+		pool:                 pool,
+		acquiredConns:        desc("acquired_connections", "Connections currently in use."),
+		idleConns:            desc("idle_connections", "Connections currently idle."),
+		constructingConns:    desc("constructing_connections", "Connections being established."),
+		totalConns:           desc("total_connections", "Connections currently open."),
+		maxConns:             desc("max_connections", "Maximum size of the pool."),
+		acquires:             desc("acquires_total", "Successful connection acquires."),
+		canceledAcquires:     desc("canceled_acquires_total", "Acquires canceled by their context."),
+		emptyAcquires:        desc("empty_acquires_total", "Acquires that had to wait for a connection."),
+		acquireDuration:      desc("acquire_duration_seconds_total", "Total time spent acquiring connections."),
+		newConns:             desc("new_connections_total", "Connections opened."),
+		maxLifetimeDestroyed: desc("max_lifetime_destroyed_total", "Connections closed for exceeding their maximum lifetime."),
+		maxIdleDestroyed:     desc("max_idle_destroyed_total", "Connections closed for exceeding their maximum idle time."),
+	}
+}
+
+func (pc *poolCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- pc.acquiredConns
+	ch <- pc.idleConns
+	ch <- pc.constructingConns
+	ch <- pc.totalConns
+	ch <- pc.maxConns
+	ch <- pc.acquires
+	ch <- pc.canceledAcquires
+	ch <- pc.emptyAcquires
+	ch <- pc.acquireDuration
+	ch <- pc.newConns
+	ch <- pc.maxLifetimeDestroyed
+	ch <- pc.maxIdleDestroyed
+}
+
+func (pc *poolCollector) Collect(ch chan<- prometheus.Metric) {
+	stat := pc.pool.Stat()
+
+	ch <- prometheus.MustNewConstMetric(pc.acquiredConns, prometheus.GaugeValue, float64(stat.AcquiredConns()))
+	ch <- prometheus.MustNewConstMetric(pc.idleConns, prometheus.GaugeValue, float64(stat.IdleConns()))
+	ch <- prometheus.MustNewConstMetric(pc.constructingConns, prometheus.GaugeValue, float64(stat.ConstructingConns()))
+	ch <- prometheus.MustNewConstMetric(pc.totalConns, prometheus.GaugeValue, float64(stat.TotalConns()))
+	ch <- prometheus.MustNewConstMetric(pc.maxConns, prometheus.GaugeValue, float64(stat.MaxConns()))
+	ch <- prometheus.MustNewConstMetric(pc.acquires, prometheus.CounterValue, float64(stat.AcquireCount()))
+	ch <- prometheus.MustNewConstMetric(pc.canceledAcquires, prometheus.CounterValue, float64(stat.CanceledAcquireCount()))
+	ch <- prometheus.MustNewConstMetric(pc.emptyAcquires, prometheus.CounterValue, float64(stat.EmptyAcquireCount()))
+	ch <- prometheus.MustNewConstMetric(pc.acquireDuration, prometheus.CounterValue, stat.AcquireDuration().Seconds())
+	ch <- prometheus.MustNewConstMetric(pc.newConns, prometheus.CounterValue, float64(stat.NewConnsCount()))
+	ch <- prometheus.MustNewConstMetric(pc.maxLifetimeDestroyed, prometheus.CounterValue, float64(stat.MaxLifetimeDestroyCount()))
+	ch <- prometheus.MustNewConstMetric(pc.maxIdleDestroyed, prometheus.CounterValue, float64(stat.MaxIdleDestroyCount()))
+}
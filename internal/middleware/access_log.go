@@ -0,0 +1,38 @@
+package middleware
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/felipeversiane/picpay-golang.git/config/logger"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// AccessLog writes one JSON line per request once it is answered. Server
+// errors are logged as errors, so they stand out from the regular traffic.
+func AccessLog() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		c.Next()
+
+		status := c.Writer.Status()
+		tags := []zap.Field{
+			zap.String("method", c.Request.Method),
+			zap.String("path", c.Request.URL.Path),
+			zap.Int("status", status),
+			zap.Float64("latency_ms", float64(time.Since(start).Microseconds())/1000),
+			zap.Int("bytes", c.Writer.Size()),
+			zap.String("client_ip", c.ClientIP()),
+			zap.String("user_agent", c.Request.UserAgent()),
+			zap.String("journey", "AccessLog"),
+		}
+
+		log := logger.FromContext(c.Request.Context())
+		if status >= http.StatusInternalServerError {
+			log.Error("Request completed", nil, tags...)
+			return
+		}
+		log.Info("Request completed", tags...)
+	}
+}
@@ -0,0 +1,26 @@
+package middleware
+
+import (
+	"crypto/subtle"
+	"strings"
+
+	"github.com/felipeversiane/picpay-golang.git/config/http_error"
+	"github.com/gin-gonic/gin"
+)
+
+// AdminAuth only lets through requests sent with
+// "Authorization: Bearer <token>". An empty token rejects every request.
+func AdminAuth(token string) gin.HandlerFunc {
+	expected := []byte(strings.TrimSpace(token))
+
+	return func(c *gin.Context) {
+		scheme, credentials, _ := strings.Cut(c.GetHeader("Authorization"), " ")
+		if len(expected) == 0 || !strings.EqualFold(scheme, "Bearer") ||
+			subtle.ConstantTimeCompare([]byte(strings.TrimSpace(credentials)), expected) != 1 {
+			restErr := http_error.NewUnauthorizedRequestError("Invalid or missing admin token")
+			c.AbortWithStatusJSON(restErr.Code, restErr)
+			return
+		}
+		c.Next()
+	}
+}
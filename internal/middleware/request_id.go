@@ -0,0 +1,44 @@
+package middleware
+
+import (
+	"github.com/felipeversiane/picpay-golang.git/config/logger"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+const (
+	RequestIDHeader = "X-Request-ID"
+
+	maxRequestIDLength = 128
+)
+
+// RequestID keeps the X-Request-ID sent by the client, or assigns a new one,
+// echoes it in the response and stores it in the request context together
+// with the route, so every line logged through logger.FromContext carries
+// them.
+func RequestID() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id := c.GetHeader(RequestIDHeader)
+		if !validRequestID(id) {
+			id = uuid.NewString()
+		}
+
+		c.Header(RequestIDHeader, id)
+		c.Request = c.Request.WithContext(logger.WithRequest(c.Request.Context(), id, c.FullPath()))
+		c.Next()
+	}
+}
+
+// validRequestID accepts up to 128 printable ASCII characters, so a client
+// cannot inject line breaks or huge values into the logs.
+func validRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+	for _, r := range id {
+		if r < 0x21 || r > 0x7e {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,30 @@
+package middleware
+
+import (
+	"context"
+
+	"github.com/felipeversiane/picpay-golang.git/config"
+	"github.com/gin-gonic/gin"
+)
+
+// Timeout bounds the request context of every route. cfg.RequestTimeout is
+// the default and cfg.RouteTimeouts overrides it per route template.
+// Services and repositories get the bounded context, so a request that times
+// out or whose client goes away stops its database work. cfg must have
+// passed config.Validate, which rejects malformed route timeouts.
+func Timeout(cfg config.Server) gin.HandlerFunc {
+	routes, _ := cfg.ParseRouteTimeouts()
+
+	return func(c *gin.Context) {
+		timeout, ok := routes[c.Request.Method+" "+c.FullPath()]
+		if !ok {
+			timeout = cfg.RequestTimeout
+		}
+
+		ctx, cancel := context.WithTimeout(c.Request.Context(), timeout)
+		defer cancel()
+
+		c.Request = c.Request.WithContext(ctx)
+		c.Next()
+	}
+}
@@ -0,0 +1,84 @@
+// Package moderation cleans the free text users attach to transfers before it
+// is shown to the other party.
+package moderation
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+var ErrContainsURL = errors.New("message cannot contain links")
+
+// linkTLDs are the top level domains a bare host is recognized by. They are
+// matched in lowercase or uppercase only, so a sentence glued to the next
+// one, as in "obrigado.Me passa", is not taken for a link. "me" is left out
+// as it is too common a word in Portuguese.
+const linkTLDs = "com|net|org|info|biz|io|ly|co|app|xyz|site|online|br"
+
+// urlPattern matches a scheme, "www." or a host ending in one of linkTLDs.
+var urlPattern = regexp.MustCompile(`(?i:\b[a-z][a-z0-9+.-]*://|\bwww\.)|\b[a-zA-Z0-9-]+\.(` + linkTLDs + `|` + strings.ToUpper(linkTLDs) + `)\b`)
+
+// profanity holds lowercase words without accents. Words are matched whole,
+// so "class" is not masked because of "ass".
+var profanity = map[string]bool{
+	"arse": true, "ass": true, "asshole": true, "bastard": true, "bitch": true,
+	"bullshit": true, "cunt": true, "dick": true, "fuck": true, "fucker": true,
+	"fucking": true, "motherfucker": true, "shit": true, "slut": true, "whore": true,
+	"babaca": true, "bosta": true, "buceta": true, "caralho": true, "cacete": true,
+	"corno": true, "cu": true, "cuzao": true, "foda": true, "fodase": true,
+	"merda": true, "otario": true, "porra": true, "puta": true, "viado": true,
+}
+
+// CleanMessage trims the message, collapses whitespace, drops control
+// characters and masks profanity. Messages with links are refused, as they
+// are the usual vector for phishing between users.
+func CleanMessage(message string) (string, error) {
+	message = strings.Join(strings.FieldsFunc(message, func(r rune) bool {
+		return unicode.IsSpace(r) || unicode.IsControl(r)
+	}), " ")
+
+	if urlPattern.MatchString(message) {
+		return "", ErrContainsURL
+	}
+
+	return maskProfanity(message), nil
+}
+
+func maskProfanity(message string) string {
+	runes := []rune(message)
+	for start := 0; start < len(runes); {
+		if !unicode.IsLetter(runes[start]) {
+			start++
+			continue
+		}
+
+		end := start
+		for end < len(runes) && unicode.IsLetter(runes[end]) {
+			end++
+		}
+
+		if profanity[normalize(string(runes[start:end]))] {
+			for i := start; i < end; i++ {
+				runes[i] = '*'
+			}
+		}
+		start = end
+	}
+	return string(runes)
+}
+
+// normalize lowercases the word and removes its accents, so "MÉRDA" and
+// "merda" are the same word.
+func normalize(word string) string {
+	var b strings.Builder
+	for _, r := range norm.NFD.String(strings.ToLower(word)) {
+		if !unicode.Is(unicode.Mn, r) {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,45 @@
+package moderation
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCleanMessage(t *testing.T) {
+	tests := []struct {
+		message  string
+		expected string
+		err      error
+	}{
+		{"https://example.com/pay", "", ErrContainsURL},
+		{"pay at HTTP://example.com", "", ErrContainsURL},
+		{"ftp://files", "", ErrContainsURL},
+		{"see www.example", "", ErrContainsURL},
+		{"WWW.EXAMPLE.ORG", "", ErrContainsURL},
+		{"pague em pizza.com", "", ErrContainsURL},
+		{"acesse loja.com.br/pix", "", ErrContainsURL},
+		{"bit.ly/abc", "", ErrContainsURL},
+		{"PIZZA.COM", "", ErrContainsURL},
+		{"obrigado.ok", "obrigado.ok", nil},
+		{"obrigado.Me passa depois", "obrigado.Me passa depois", nil},
+		{"R$10.50", "R$10.50", nil},
+		{"almoço R$ 10.50, valeu", "almoço R$ 10.50, valeu", nil},
+		{"3.5kg de café", "3.5kg de café", nil},
+		{"  pizza \n\t de  sexta ", "pizza de sexta", nil},
+		{"what the fuck", "what the ****", nil},
+		{"que MÉRDA", "que *****", nil},
+		{"Pórra, atrasou", "*****, atrasou", nil},
+		{"class assignment", "class assignment", nil},
+	}
+
+	for _, tt := range tests {
+		got, err := CleanMessage(tt.message)
+		if !errors.Is(err, tt.err) {
+			t.Errorf("CleanMessage(%q): expected error %v and received %v", tt.message, tt.err, err)
+			continue
+		}
+		if got != tt.expected {
+			t.Errorf("CleanMessage(%q): expected %q and received %q", tt.message, tt.expected, got)
+		}
+	}
+}
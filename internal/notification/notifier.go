@@ -0,0 +1,40 @@
+package notification
+
+import (
+	"context"
+
+	"github.com/felipeversiane/picpay-golang.git/config/logger"
+	"github.com/felipeversiane/picpay-golang.git/config/validation"
+	"go.uber.org/zap"
+)
+
+// Kinds of notification, logged in place of the message.
+const (
+	KindPixKeyVerification = "pix_key_verification"
+	KindPixKeyClaimCode    = "pix_key_claim_code"
+	KindPixKeyClaimNotice  = "pix_key_claim_notice"
+	KindBillSplitReminder  = "bill_split_reminder"
+)
+
+// Notifier delivers a message of the given kind to an email address or
+// phone number.
+type Notifier interface {
+	Send(ctx context.Context, kind string, to string, message string) error
+}
+
+type logNotifier struct{}
+
+// NewLogNotifier returns a Notifier that only logs that a notification was
+// sent. It stands in for an email/SMS provider. The message carries codes,
+// so only its kind and the masked recipient are written.
+func NewLogNotifier() Notifier {
+	return &logNotifier{}
+}
+
+func (n *logNotifier) Send(ctx context.Context, kind string, to string, message string) error {
+	logger.FromContext(ctx).Info("Notification sent",
+		zap.String("kind", kind),
+		zap.String("recipient", validation.MaskContact(to)),
+		zap.String("journey", "notification"))
+	return nil
+}
@@ -1,6 +1,7 @@
 package domain
 
 import (
+	"math"
 	"time"
 
 	"github.com/google/uuid"
@@ -11,7 +12,11 @@ type orderDomain struct {
 	amount     float64
 	payee      uuid.UUID
 	payer      uuid.UUID
+	fee        float64
+	feePlanID  *uuid.UUID
+	settleOn   *time.Time
 	isReversed bool
+	message    string
 	createdAt  time.Time
 }
 
@@ -20,6 +25,14 @@ type OrderDomainInterface interface {
 	GetAmount() float64
 	GetPayee() uuid.UUID
 	GetPayer() uuid.UUID
+	GetFee() float64
+	GetFeePlanID() *uuid.UUID
+	GetNetAmount() float64
+	SetFee(fee float64, feePlanID *uuid.UUID)
+	GetSettlementDate() *time.Time
+	SetSettlementDate(date time.Time)
+	GetMessage() string
+	SetMessage(message string)
 	GetCreatedAt() time.Time
 }
 
@@ -74,6 +87,39 @@ func (o *orderDomain) GetPayer() uuid.UUID {
 	return o.payer
 }
 
+func (o *orderDomain) GetFee() float64 {
+	return o.fee
+}
+
+func (o *orderDomain) GetFeePlanID() *uuid.UUID {
+	return o.feePlanID
+}
+
+func (o *orderDomain) GetNetAmount() float64 {
+	return math.Round((o.amount-o.fee)*100) / 100
+}
+
+func (o *orderDomain) SetFee(fee float64, feePlanID *uuid.UUID) {
+	o.fee = fee
+	o.feePlanID = feePlanID
+}
+
+func (o *orderDomain) GetSettlementDate() *time.Time {
+	return o.settleOn
+}
+
+func (o *orderDomain) SetSettlementDate(date time.Time) {
+	o.settleOn = &date
+}
+
+func (o *orderDomain) GetMessage() string {
+	return o.message
+}
+
+func (o *orderDomain) SetMessage(message string) {
+	o.message = message
+}
+
 func (o *orderDomain) GetCreatedAt() time.Time {
 	return o.createdAt
 }
@@ -0,0 +1,92 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	MaxOrderAttachments    = 5
+	MaxOrderAttachmentSize = 5 << 20
+)
+
+// OrderAttachmentContentTypes lists the files accepted as receipts.
+var OrderAttachmentContentTypes = map[string]bool{
+	"application/pdf": true,
+	"image/jpeg":      true,
+	"image/png":       true,
+}
+
+type orderAttachmentDomain struct {
+	id          uuid.UUID
+	orderID     uuid.UUID
+	uploadedBy  uuid.UUID
+	fileName    string
+	contentType string
+	size        int64
+	createdAt   time.Time
+}
+
+type OrderAttachmentDomainInterface interface {
+	GetID() uuid.UUID
+	GetOrderID() uuid.UUID
+	GetUploadedBy() uuid.UUID
+	GetFileName() string
+	GetContentType() string
+	GetSize() int64
+	GetStorageKey() string
+	GetCreatedAt() time.Time
+}
+
+func NewOrderAttachmentDomain(
+	orderID uuid.UUID,
+	uploadedBy uuid.UUID,
+	fileName string,
+	contentType string,
+	size int64,
+) *orderAttachmentDomain {
+	return &orderAttachmentDomain{
+		id:          uuid.New(),
+		orderID:     orderID,
+		uploadedBy:  uploadedBy,
+		fileName:    fileName,
+		contentType: contentType,
+		size:        size,
+		createdAt:   time.Now(),
+	}
+}
+
+func (a *orderAttachmentDomain) GetID() uuid.UUID {
+	return a.id
+}
+
+func (a *orderAttachmentDomain) GetOrderID() uuid.UUID {
+	return a.orderID
+}
+
+func (a *orderAttachmentDomain) GetUploadedBy() uuid.UUID {
+	return a.uploadedBy
+}
+
+func (a *orderAttachmentDomain) GetFileName() string {
+	return a.fileName
+}
+
+func (a *orderAttachmentDomain) GetContentType() string {
+	return a.contentType
+}
+
+func (a *orderAttachmentDomain) GetSize() int64 {
+	return a.size
+}
+
+// GetStorageKey returns where the file is kept. It does not use the file
+// name sent by the user.
+func (a *orderAttachmentDomain) GetStorageKey() string {
+	return "orders/" + a.orderID.String() + "/" + a.id.String()
+}
+
+func (a *orderAttachmentDomain) GetCreatedAt() time.Time {
+	return a.createdAt
+}
@@ -0,0 +1,97 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	PaymentLinkStatusActive  = "active"
+	PaymentLinkStatusUsed    = "used"
+	PaymentLinkStatusExpired = "expired"
+)
+
+type paymentLinkDomain struct {
+	id          uuid.UUID
+	merchantID  uuid.UUID
+	slug        string
+	description string
+	amount      float64
+	reusable    bool
+	expiresAt   *time.Time
+	createdAt   time.Time
+	updatedAt   time.Time
+}
+
+type PaymentLinkDomainInterface interface {
+	GetID() uuid.UUID
+	GetMerchantID() uuid.UUID
+	GetSlug() string
+	SetSlug(slug string)
+	GetDescription() string
+	GetAmount() float64
+	IsReusable() bool
+	GetExpiresAt() *time.Time
+	GetCreatedAt() time.Time
+	GetUpdatedAt() time.Time
+}
+
+func NewPaymentLinkDomain(
+	merchantID uuid.UUID,
+	description string,
+	amount float64,
+	reusable bool,
+	expiresAt *time.Time,
+) *paymentLinkDomain {
+	return &paymentLinkDomain{
+		id:          uuid.New(),
+		merchantID:  merchantID,
+		description: description,
+		amount:      amount,
+		reusable:    reusable,
+		expiresAt:   expiresAt,
+		createdAt:   time.Now(),
+		updatedAt:   time.Now(),
+	}
+}
+
+func (p *paymentLinkDomain) GetID() uuid.UUID {
+	return p.id
+}
+
+func (p *paymentLinkDomain) GetMerchantID() uuid.UUID {
+	return p.merchantID
+}
+
+func (p *paymentLinkDomain) GetSlug() string {
+	return p.slug
+}
+
+func (p *paymentLinkDomain) SetSlug(slug string) {
+	p.slug = slug
+}
+
+func (p *paymentLinkDomain) GetDescription() string {
+	return p.description
+}
+
+func (p *paymentLinkDomain) GetAmount() float64 {
+	return p.amount
+}
+
+func (p *paymentLinkDomain) IsReusable() bool {
+	return p.reusable
+}
+
+func (p *paymentLinkDomain) GetExpiresAt() *time.Time {
+	return p.expiresAt
+}
+
+func (p *paymentLinkDomain) GetCreatedAt() time.Time {
+	return p.createdAt
+}
+
+func (p *paymentLinkDomain) GetUpdatedAt() time.Time {
+	return p.updatedAt
+}
@@ -0,0 +1,105 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	PaymentRequestStatusPending  = "pending"
+	PaymentRequestStatusAccepted = "accepted"
+	PaymentRequestStatusDeclined = "declined"
+	PaymentRequestStatusExpired  = "expired"
+)
+
+type paymentRequestDomain struct {
+	id        uuid.UUID
+	requester uuid.UUID
+	payer     uuid.UUID
+	amount    float64
+	note      string
+	splitID   *uuid.UUID
+	status    string
+	expiresAt time.Time
+	createdAt time.Time
+	updatedAt time.Time
+}
+
+type PaymentRequestDomainInterface interface {
+	GetID() uuid.UUID
+	GetRequester() uuid.UUID
+	GetPayer() uuid.UUID
+	GetAmount() float64
+	GetNote() string
+	GetSplitID() *uuid.UUID
+	SetSplitID(splitID uuid.UUID)
+	GetStatus() string
+	GetExpiresAt() time.Time
+	GetCreatedAt() time.Time
+	GetUpdatedAt() time.Time
+}
+
+func NewPaymentRequestDomain(
+	requester uuid.UUID,
+	payer uuid.UUID,
+	amount float64,
+	note string,
+	expiresAt time.Time,
+) *paymentRequestDomain {
+	return &paymentRequestDomain{
+		id:        uuid.New(),
+		requester: requester,
+		payer:     payer,
+		amount:    amount,
+		note:      note,
+		status:    PaymentRequestStatusPending,
+		expiresAt: expiresAt,
+		createdAt: time.Now(),
+		updatedAt: time.Now(),
+	}
+}
+
+func (p *paymentRequestDomain) GetID() uuid.UUID {
+	return p.id
+}
+
+func (p *paymentRequestDomain) GetRequester() uuid.UUID {
+	return p.requester
+}
+
+func (p *paymentRequestDomain) GetPayer() uuid.UUID {
+	return p.payer
+}
+
+func (p *paymentRequestDomain) GetAmount() float64 {
+	return p.amount
+}
+
+func (p *paymentRequestDomain) GetNote() string {
+	return p.note
+}
+
+func (p *paymentRequestDomain) GetSplitID() *uuid.UUID {
+	return p.splitID
+}
+
+func (p *paymentRequestDomain) SetSplitID(splitID uuid.UUID) {
+	p.splitID = &splitID
+}
+
+func (p *paymentRequestDomain) GetStatus() string {
+	return p.status
+}
+
+func (p *paymentRequestDomain) GetExpiresAt() time.Time {
+	return p.expiresAt
+}
+
+func (p *paymentRequestDomain) GetCreatedAt() time.Time {
+	return p.createdAt
+}
+
+func (p *paymentRequestDomain) GetUpdatedAt() time.Time {
+	return p.updatedAt
+}
@@ -0,0 +1,102 @@
+package domain
+
+import (
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	PayoutModePartial      = "partial"
+	PayoutModeAllOrNothing = "all_or_nothing"
+
+	PayoutBatchStatusPending    = "pending"
+	PayoutBatchStatusProcessing = "processing"
+	PayoutBatchStatusCompleted  = "completed"
+	PayoutBatchStatusFailed     = "failed"
+
+	PayoutItemStatusPending   = "pending"
+	PayoutItemStatusSucceeded = "succeeded"
+	PayoutItemStatusFailed    = "failed"
+)
+
+// PayoutItem is a single payee of a payout batch.
+type PayoutItem struct {
+	Payee  uuid.UUID
+	Amount float64
+}
+
+type payoutBatchDomain struct {
+	id        uuid.UUID
+	payerID   uuid.UUID
+	mode      string
+	items     []PayoutItem
+	status    string
+	createdAt time.Time
+	updatedAt time.Time
+}
+
+type PayoutBatchDomainInterface interface {
+	GetID() uuid.UUID
+	GetPayerID() uuid.UUID
+	GetMode() string
+	GetItems() []PayoutItem
+	GetTotalAmount() float64
+	GetStatus() string
+	GetCreatedAt() time.Time
+	GetUpdatedAt() time.Time
+}
+
+func NewPayoutBatchDomain(
+	payerID uuid.UUID,
+	mode string,
+	items []PayoutItem,
+) *payoutBatchDomain {
+	return &payoutBatchDomain{
+		id:        uuid.New(),
+		payerID:   payerID,
+		mode:      mode,
+		items:     items,
+		status:    PayoutBatchStatusPending,
+		createdAt: time.Now(),
+		updatedAt: time.Now(),
+	}
+}
+
+func (p *payoutBatchDomain) GetID() uuid.UUID {
+	return p.id
+}
+
+func (p *payoutBatchDomain) GetPayerID() uuid.UUID {
+	return p.payerID
+}
+
+func (p *payoutBatchDomain) GetMode() string {
+	return p.mode
+}
+
+func (p *payoutBatchDomain) GetItems() []PayoutItem {
+	return p.items
+}
+
+// GetTotalAmount returns the sum of the items.
+func (p *payoutBatchDomain) GetTotalAmount() float64 {
+	var cents int64
+	for _, item := range p.items {
+		cents += int64(math.Round(item.Amount * 100))
+	}
+	return float64(cents) / 100
+}
+
+func (p *payoutBatchDomain) GetStatus() string {
+	return p.status
+}
+
+func (p *payoutBatchDomain) GetCreatedAt() time.Time {
+	return p.createdAt
+}
+
+func (p *payoutBatchDomain) GetUpdatedAt() time.Time {
+	return p.updatedAt
+}
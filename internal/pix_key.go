@@ -0,0 +1,113 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	PixKeyTypeEmail    = "email"
+	PixKeyTypePhone    = "phone"
+	PixKeyTypeDocument = "document"
+	PixKeyTypeEVP      = "evp"
+
+	PixKeyStatusPending = "pending"
+	PixKeyStatusActive  = "active"
+
+	PixKeyClaimStatusOpen      = "open"
+	PixKeyClaimStatusCompleted = "completed"
+	PixKeyClaimStatusCancelled = "cancelled"
+)
+
+type pixKeyDomain struct {
+	id                    uuid.UUID
+	userID                uuid.UUID
+	keyType               string
+	key                   string
+	status                string
+	verificationCode      string
+	verificationExpiresAt *time.Time
+	createdAt             time.Time
+	updatedAt             time.Time
+}
+
+type PixKeyDomainInterface interface {
+	GetID() uuid.UUID
+	GetUserID() uuid.UUID
+	GetKeyType() string
+	GetKey() string
+	SetKey(key string)
+	GetStatus() string
+	Activate()
+	GetVerificationCode() string
+	GetVerificationExpiresAt() *time.Time
+	SetVerificationCode(code string, expiresAt time.Time)
+	GetCreatedAt() time.Time
+	GetUpdatedAt() time.Time
+}
+
+func NewPixKeyDomain(
+	userID uuid.UUID,
+	keyType string,
+	key string,
+) *pixKeyDomain {
+	return &pixKeyDomain{
+		id:        uuid.New(),
+		userID:    userID,
+		keyType:   keyType,
+		key:       key,
+		status:    PixKeyStatusPending,
+		createdAt: time.Now(),
+		updatedAt: time.Now(),
+	}
+}
+
+func (p *pixKeyDomain) GetID() uuid.UUID {
+	return p.id
+}
+
+func (p *pixKeyDomain) GetUserID() uuid.UUID {
+	return p.userID
+}
+
+func (p *pixKeyDomain) GetKeyType() string {
+	return p.keyType
+}
+
+func (p *pixKeyDomain) GetKey() string {
+	return p.key
+}
+
+func (p *pixKeyDomain) SetKey(key string) {
+	p.key = key
+}
+
+func (p *pixKeyDomain) GetStatus() string {
+	return p.status
+}
+
+func (p *pixKeyDomain) Activate() {
+	p.status = PixKeyStatusActive
+}
+
+func (p *pixKeyDomain) GetVerificationCode() string {
+	return p.verificationCode
+}
+
+func (p *pixKeyDomain) GetVerificationExpiresAt() *time.Time {
+	return p.verificationExpiresAt
+}
+
+func (p *pixKeyDomain) SetVerificationCode(code string, expiresAt time.Time) {
+	p.verificationCode = code
+	p.verificationExpiresAt = &expiresAt
+}
+
+func (p *pixKeyDomain) GetCreatedAt() time.Time {
+	return p.createdAt
+}
+
+func (p *pixKeyDomain) GetUpdatedAt() time.Time {
+	return p.updatedAt
+}
@@ -0,0 +1,105 @@
+package domain
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	QRCodeTypeStatic  = "static"
+	QRCodeTypeDynamic = "dynamic"
+
+	QRCodeStatusActive  = "active"
+	QRCodeStatusPaid    = "paid"
+	QRCodeStatusExpired = "expired"
+)
+
+type qrCodeDomain struct {
+	id         uuid.UUID
+	merchantID uuid.UUID
+	codeType   string
+	amount     float64
+	payload    string
+	expiresAt  *time.Time
+	createdAt  time.Time
+	updatedAt  time.Time
+}
+
+type QRCodeDomainInterface interface {
+	GetID() uuid.UUID
+	GetReference() string
+	GetMerchantID() uuid.UUID
+	GetCodeType() string
+	IsDynamic() bool
+	GetAmount() float64
+	GetPayload() string
+	SetPayload(payload string)
+	GetExpiresAt() *time.Time
+	GetCreatedAt() time.Time
+	GetUpdatedAt() time.Time
+}
+
+func NewQRCodeDomain(
+	merchantID uuid.UUID,
+	codeType string,
+	amount float64,
+	expiresAt *time.Time,
+) *qrCodeDomain {
+	return &qrCodeDomain{
+		id:         uuid.New(),
+		merchantID: merchantID,
+		codeType:   codeType,
+		amount:     amount,
+		expiresAt:  expiresAt,
+		createdAt:  time.Now(),
+		updatedAt:  time.Now(),
+	}
+}
+
+func (q *qrCodeDomain) GetID() uuid.UUID {
+	return q.id
+}
+
+// GetReference returns the ID without dashes, the form written to the
+// reference label of the EMV payload.
+func (q *qrCodeDomain) GetReference() string {
+	return strings.ReplaceAll(q.id.String(), "-", "")
+}
+
+func (q *qrCodeDomain) GetMerchantID() uuid.UUID {
+	return q.merchantID
+}
+
+func (q *qrCodeDomain) GetCodeType() string {
+	return q.codeType
+}
+
+func (q *qrCodeDomain) IsDynamic() bool {
+	return q.codeType == QRCodeTypeDynamic
+}
+
+func (q *qrCodeDomain) GetAmount() float64 {
+	return q.amount
+}
+
+func (q *qrCodeDomain) GetPayload() string {
+	return q.payload
+}
+
+func (q *qrCodeDomain) SetPayload(payload string) {
+	q.payload = payload
+}
+
+func (q *qrCodeDomain) GetExpiresAt() *time.Time {
+	return q.expiresAt
+}
+
+func (q *qrCodeDomain) GetCreatedAt() time.Time {
+	return q.createdAt
+}
+
+func (q *qrCodeDomain) GetUpdatedAt() time.Time {
+	return q.updatedAt
+}
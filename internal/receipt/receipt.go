@@ -0,0 +1,139 @@
+// Package receipt signs transfer receipts and renders them as PDF. The
+// authentication code printed on a receipt is an HMAC of the order fields,
+// so only this service can issue it and anyone can have it checked.
+package receipt
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/felipeversiane/picpay-golang.git/internal/entity/response"
+	"github.com/google/uuid"
+	"github.com/jung-kurt/gofpdf"
+)
+
+// codeVersion prefixes the signed content so the layout of the fields can
+// change without old codes being read with the new layout.
+const codeVersion = "v1"
+
+// Fields are the order fields covered by the authentication code.
+type Fields struct {
+	OrderID   uuid.UUID
+	Amount    float64
+	Payer     uuid.UUID
+	Payee     uuid.UUID
+	CreatedAt time.Time
+}
+
+type Signer interface {
+	Sign(fields Fields) string
+	Verify(fields Fields, code string) bool
+}
+
+type hmacSigner struct {
+	key []byte
+}
+
+// NewSigner returns a Signer keyed by key, RECEIPT_SECRET_KEY. The key must
+// stay the same across restarts and instances for issued receipts to keep
+// verifying. config.Validate only lets it be empty in development.
+func NewSigner(key []byte) Signer {
+	return &hmacSigner{key}
+}
+
+// Sign returns the first 128 bits of the HMAC-SHA256 of the fields as
+// uppercase hex in groups of four, such as "1A2B-3C4D-...".
+func (s *hmacSigner) Sign(fields Fields) string {
+	mac := hmac.New(sha256.New, s.key)
+	mac.Write([]byte(strings.Join([]string{
+		codeVersion,
+		fields.OrderID.String(),
+		strconv.FormatFloat(fields.Amount, 'f', 2, 64),
+		fields.Payer.String(),
+		fields.Payee.String(),
+		fields.CreatedAt.UTC().Format(time.RFC3339Nano),
+	}, "|")))
+	sum := strings.ToUpper(hex.EncodeToString(mac.Sum(nil)[:16]))
+
+	groups := make([]string, 0, len(sum)/4)
+	for i := 0; i < len(sum); i += 4 {
+		groups = append(groups, sum[i:i+4])
+	}
+	return strings.Join(groups, "-")
+}
+
+// Verify compares the code in constant time. Case, spaces and dashes in the
+// code are ignored, as it is often typed from a printed receipt.
+func (s *hmacSigner) Verify(fields Fields, code string) bool {
+	return hmac.Equal([]byte(normalizeCode(s.Sign(fields))), []byte(normalizeCode(code)))
+}
+
+func normalizeCode(code string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '-' || r == ' ' {
+			return -1
+		}
+		return r
+	}, strings.ToUpper(strings.TrimSpace(code)))
+}
+
+// RenderPDF renders the receipt as a single A4 page.
+func RenderPDF(r response.ReceiptResponse) ([]byte, error) {
+	pdf := gofpdf.New("P", "mm", "A4", "")
+	pdf.SetTitle("Transfer receipt "+r.OrderID.String(), true)
+	pdf.SetCreationDate(r.CreatedAt)
+	pdf.SetMargins(20, 20, 20)
+	pdf.AddPage()
+
+	tr := pdf.UnicodeTranslatorFromDescriptor("")
+
+	pdf.SetFont("Helvetica", "B", 18)
+	pdf.CellFormat(0, 10, "Transfer receipt", "", 1, "L", false, 0, "")
+	pdf.SetFont("Helvetica", "", 10)
+	pdf.SetTextColor(100, 100, 100)
+	pdf.CellFormat(0, 6, tr(r.CreatedAt.UTC().Format("2006-01-02 15:04:05 MST")), "", 1, "L", false, 0, "")
+	pdf.Ln(6)
+
+	pdf.SetTextColor(0, 0, 0)
+	pdf.SetFont("Helvetica", "B", 24)
+	pdf.CellFormat(0, 14, "R$ "+strconv.FormatFloat(r.Amount, 'f', 2, 64), "", 1, "L", false, 0, "")
+	pdf.Ln(4)
+
+	line := func(label string, value string) {
+		pdf.SetFont("Helvetica", "B", 10)
+		pdf.CellFormat(40, 7, tr(label), "", 0, "L", false, 0, "")
+		pdf.SetFont("Helvetica", "", 10)
+		pdf.MultiCell(0, 7, tr(value), "", "L", false)
+	}
+
+	line("From", r.Payer.Name)
+	line("Document", r.Payer.Document)
+	pdf.Ln(2)
+	line("To", r.Payee.Name)
+	line("Document", r.Payee.Document)
+	pdf.Ln(2)
+	if r.Message != "" {
+		line("Message", r.Message)
+	}
+	line("Transaction ID", r.OrderID.String())
+	pdf.Ln(6)
+
+	pdf.SetDrawColor(200, 200, 200)
+	pdf.Line(20, pdf.GetY(), 190, pdf.GetY())
+	pdf.Ln(4)
+	line("Authentication", r.AuthenticationCode)
+	pdf.SetFont("Helvetica", "", 8)
+	pdf.SetTextColor(100, 100, 100)
+	pdf.MultiCell(0, 5, "Check this receipt by sending the transaction ID and the authentication code to POST /api/v1/receipts/verify.", "", "L", false)
+
+	var buf bytes.Buffer
+	if err := pdf.Output(&buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
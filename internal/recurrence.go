@@ -0,0 +1,123 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	RecurrenceStatusActive    = "active"
+	RecurrenceStatusPaused    = "paused"
+	RecurrenceStatusCancelled = "cancelled"
+	RecurrenceStatusFinished  = "finished"
+
+	InsufficientFundsSkip  = "skip"
+	InsufficientFundsRetry = "retry"
+	InsufficientFundsPause = "pause"
+
+	ExecutionStatusSucceeded = "succeeded"
+	ExecutionStatusSkipped   = "skipped"
+	ExecutionStatusRetrying  = "retrying"
+	ExecutionStatusPaused    = "paused"
+	ExecutionStatusFailed    = "failed"
+)
+
+type recurrenceDomain struct {
+	id                  uuid.UUID
+	amount              float64
+	payee               uuid.UUID
+	payer               uuid.UUID
+	schedule            string
+	onInsufficientFunds string
+	maxRetries          int
+	status              string
+	nextRunAt           time.Time
+	createdAt           time.Time
+	updatedAt           time.Time
+}
+
+type RecurrenceDomainInterface interface {
+	GetID() uuid.UUID
+	GetAmount() float64
+	GetPayee() uuid.UUID
+	GetPayer() uuid.UUID
+	GetSchedule() string
+	GetOnInsufficientFunds() string
+	GetMaxRetries() int
+	GetStatus() string
+	GetNextRunAt() time.Time
+	SetNextRunAt(nextRunAt time.Time)
+	GetCreatedAt() time.Time
+	GetUpdatedAt() time.Time
+}
+
+func NewRecurrenceDomain(
+	amount float64,
+	payee uuid.UUID,
+	payer uuid.UUID,
+	schedule string,
+	onInsufficientFunds string,
+	maxRetries int,
+) *recurrenceDomain {
+	return &recurrenceDomain{
+		id:                  uuid.New(),
+		amount:              amount,
+		payee:               payee,
+		payer:               payer,
+		schedule:            schedule,
+		onInsufficientFunds: onInsufficientFunds,
+		maxRetries:          maxRetries,
+		status:              RecurrenceStatusActive,
+		createdAt:           time.Now(),
+		updatedAt:           time.Now(),
+	}
+}
+
+func (r *recurrenceDomain) GetID() uuid.UUID {
+	return r.id
+}
+
+func (r *recurrenceDomain) GetAmount() float64 {
+	return r.amount
+}
+
+func (r *recurrenceDomain) GetPayee() uuid.UUID {
+	return r.payee
+}
+
+func (r *recurrenceDomain) GetPayer() uuid.UUID {
+	return r.payer
+}
+
+func (r *recurrenceDomain) GetSchedule() string {
+	return r.schedule
+}
+
+func (r *recurrenceDomain) GetOnInsufficientFunds() string {
+	return r.onInsufficientFunds
+}
+
+func (r *recurrenceDomain) GetMaxRetries() int {
+	return r.maxRetries
+}
+
+func (r *recurrenceDomain) GetStatus() string {
+	return r.status
+}
+
+func (r *recurrenceDomain) GetNextRunAt() time.Time {
+	return r.nextRunAt
+}
+
+func (r *recurrenceDomain) SetNextRunAt(nextRunAt time.Time) {
+	r.nextRunAt = nextRunAt
+}
+
+func (r *recurrenceDomain) GetCreatedAt() time.Time {
+	return r.createdAt
+}
+
+func (r *recurrenceDomain) GetUpdatedAt() time.Time {
+	return r.updatedAt
+}
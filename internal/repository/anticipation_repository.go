@@ -0,0 +1,143 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/felipeversiane/picpay-golang.git/config/http_error"
+	"github.com/felipeversiane/picpay-golang.git/internal/entity/response"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type AnticipationQuote func(settlements []response.SettlementResponse) (response.AnticipationQuoteResponse, *http_error.HttpError)
+
+type rowsQuerier interface {
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+}
+
+type anticipationRepository struct {
+	conn *pgxpool.Pool
+}
+
+func NewAnticipationRepository(
+	conn *pgxpool.Pool,
+) AnticipationRepository {
+	return &anticipationRepository{
+		conn,
+	}
+}
+
+type AnticipationRepository interface {
+	FindAnticipableSettlementsRepository(ctx context.Context, merchantID uuid.UUID, settlementIDs []uuid.UUID) ([]response.SettlementResponse, *http_error.HttpError)
+	AcceptAnticipationRepository(ctx context.Context, merchantID uuid.UUID, settlementIDs []uuid.UUID, quote AnticipationQuote) (response.AnticipationResponse, *http_error.HttpError)
+}
+
+func (r *anticipationRepository) FindAnticipableSettlementsRepository(ctx context.Context, merchantID uuid.UUID, settlementIDs []uuid.UUID) ([]response.SettlementResponse, *http_error.HttpError) {
+	settlements, err := findPendingSettlements(ctx, r.conn, merchantID, settlementIDs, false)
+	if err != nil {
+		return nil, http_error.NewInternalServerError(err.Error())
+	}
+	return settlements, nil
+}
+
+func (r *anticipationRepository) AcceptAnticipationRepository(ctx context.Context, merchantID uuid.UUID, settlementIDs []uuid.UUID, quote AnticipationQuote) (response.AnticipationResponse, *http_error.HttpError) {
+	tx, err := r.conn.Begin(ctx)
+	if err != nil {
+		return response.AnticipationResponse{}, http_error.NewInternalServerError(err.Error())
+	}
+	defer tx.Rollback(ctx)
+
+	settlements, err := findPendingSettlements(ctx, tx, merchantID, settlementIDs, true)
+	if err != nil {
+		return response.AnticipationResponse{}, http_error.NewInternalServerError(err.Error())
+	}
+
+	result, quoteErr := quote(settlements)
+	if quoteErr != nil {
+		return response.AnticipationResponse{}, quoteErr
+	}
+
+	query := `
+		INSERT INTO anticipations (id, merchant_id, monthly_rate, gross_amount, fee, net_amount, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id, created_at
+	`
+
+	anticipation := response.AnticipationResponse{
+		MerchantID:  result.MerchantID,
+		MonthlyRate: result.MonthlyRate,
+		GrossAmount: result.GrossAmount,
+		Fee:         result.Fee,
+		NetAmount:   result.NetAmount,
+		Settlements: result.Settlements,
+	}
+	err = tx.QueryRow(ctx, query,
+		uuid.New(), merchantID, result.MonthlyRate,
+		result.GrossAmount, result.Fee, result.NetAmount, time.Now(),
+	).Scan(&anticipation.ID, &anticipation.CreatedAt)
+	if err != nil {
+		return response.AnticipationResponse{}, http_error.NewInternalServerError(err.Error())
+	}
+
+	query = `
+		UPDATE settlements
+		SET status = 'anticipated', anticipation_id = $1, settled_at = now()
+		WHERE id = ANY($2)
+	`
+	if _, err := tx.Exec(ctx, query, anticipation.ID, settlementIDs); err != nil {
+		return response.AnticipationResponse{}, http_error.NewInternalServerError("Error updating anticipated settlements")
+	}
+
+	query = `
+		UPDATE users
+		SET balance = balance + $1, pending_balance = pending_balance - $2, updated_at = now()
+		WHERE id = $3
+	`
+	if _, err := tx.Exec(ctx, query, result.NetAmount, result.GrossAmount, merchantID); err != nil {
+		return response.AnticipationResponse{}, http_error.NewInternalServerError("Error updating merchant balance")
+	}
+
+	if result.Fee > 0 {
+		_, err := tx.Exec(ctx, "UPDATE platform_accounts SET balance = balance + $1, updated_at = now() WHERE id = 'revenue'", result.Fee)
+		if err != nil {
+			return response.AnticipationResponse{}, http_error.NewInternalServerError("Error recording anticipation fee")
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return response.AnticipationResponse{}, http_error.NewInternalServerError(err.Error())
+	}
+
+	return anticipation, nil
+}
+
+func findPendingSettlements(ctx context.Context, q rowsQuerier, merchantID uuid.UUID, ids []uuid.UUID, forUpdate bool) ([]response.SettlementResponse, error) {
+	query := `
+		SELECT id, merchant_id, order_id, amount, scheduled_for, status, settled_at, created_at
+		FROM settlements
+		WHERE merchant_id = $1 AND id = ANY($2) AND status = 'pending'
+		ORDER BY scheduled_for, id
+	`
+	if forUpdate {
+		query += " FOR UPDATE"
+	}
+
+	rows, err := q.Query(ctx, query, merchantID, ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	settlements := []response.SettlementResponse{}
+	for rows.Next() {
+		settlement, err := scanSettlement(rows)
+		if err != nil {
+			return nil, err
+		}
+		settlements = append(settlements, settlement)
+	}
+
+	return settlements, rows.Err()
+}
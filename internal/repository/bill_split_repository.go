@@ -0,0 +1,142 @@
+package repository
+
+import (
+	"context"
+	"math"
+
+	"github.com/felipeversiane/picpay-golang.git/config/http_error"
+	domain "github.com/felipeversiane/picpay-golang.git/internal"
+	"github.com/felipeversiane/picpay-golang.git/internal/entity/response"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const billSplitColumns = "id, owner_id, description, total_amount, split_mode, status, settled_at, created_at, updated_at"
+
+type billSplitRepository struct {
+	conn *pgxpool.Pool
+}
+
+func NewBillSplitRepository(
+	conn *pgxpool.Pool,
+) BillSplitRepository {
+	return &billSplitRepository{
+		conn,
+	}
+}
+
+type BillSplitRepository interface {
+	InsertBillSplitRepository(ctx context.Context, billSplit domain.BillSplitDomainInterface, shares []domain.PaymentRequestDomainInterface) *http_error.HttpError
+	FindBillSplitByIDRepository(ctx context.Context, id uuid.UUID) (response.BillSplitResponse, *http_error.HttpError)
+	SettleBillSplitRepository(id uuid.UUID) OrderHook
+}
+
+// InsertBillSplitRepository stores the split and the payment request of every
+// share in a single transaction.
+func (r *billSplitRepository) InsertBillSplitRepository(ctx context.Context, billSplit domain.BillSplitDomainInterface, shares []domain.PaymentRequestDomainInterface) *http_error.HttpError {
+	tx, err := r.conn.Begin(ctx)
+	if err != nil {
+		return http_error.NewInternalServerError(err.Error())
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+		INSERT INTO bill_splits (id, owner_id, description, total_amount, split_mode, status, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`
+	_, err = tx.Exec(ctx, query,
+		billSplit.GetID(), billSplit.GetOwner(),
+		billSplit.GetDescription(), billSplit.GetTotalAmount(),
+		billSplit.GetMode(), billSplit.GetStatus(),
+		billSplit.GetCreatedAt(), billSplit.GetUpdatedAt(),
+	)
+	if err != nil {
+		return http_error.NewInternalServerError(err.Error())
+	}
+
+	for _, share := range shares {
+		if _, err := insertPaymentRequest(ctx, tx, share); err != nil {
+			return http_error.NewInternalServerError(err.Error())
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return http_error.NewInternalServerError(err.Error())
+	}
+
+	return nil
+}
+
+func (r *billSplitRepository) FindBillSplitByIDRepository(ctx context.Context, id uuid.UUID) (response.BillSplitResponse, *http_error.HttpError) {
+	query := "SELECT " + billSplitColumns + " FROM bill_splits WHERE id = $1"
+
+	var billSplit response.BillSplitResponse
+	err := r.conn.QueryRow(ctx, query, id).Scan(
+		&billSplit.ID,
+		&billSplit.Owner,
+		&billSplit.Description,
+		&billSplit.TotalAmount,
+		&billSplit.Mode,
+		&billSplit.Status,
+		&billSplit.SettledAt,
+		&billSplit.CreatedAt,
+		&billSplit.UpdatedAt,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return response.BillSplitResponse{}, http_error.NewNotFoundError("Bill split not found")
+		}
+		return response.BillSplitResponse{}, http_error.NewInternalServerError(err.Error())
+	}
+
+	rows, err := r.conn.Query(ctx, "SELECT "+paymentRequestColumns+" FROM payment_requests WHERE split_id = $1 ORDER BY amount DESC, id", id)
+	if err != nil {
+		return response.BillSplitResponse{}, http_error.NewInternalServerError(err.Error())
+	}
+	defer rows.Close()
+
+	billSplit.Shares = []response.PaymentRequestResponse{}
+	for rows.Next() {
+		share, err := scanPaymentRequest(rows)
+		if err != nil {
+			return response.BillSplitResponse{}, http_error.NewInternalServerError(err.Error())
+		}
+		if share.Status == domain.PaymentRequestStatusAccepted {
+			billSplit.PaidAmount += share.Amount
+		}
+		billSplit.Shares = append(billSplit.Shares, share)
+	}
+	if err := rows.Err(); err != nil {
+		return response.BillSplitResponse{}, http_error.NewInternalServerError(err.Error())
+	}
+	billSplit.PaidAmount = math.Round(billSplit.PaidAmount*100) / 100
+
+	return billSplit, nil
+}
+
+// SettleBillSplitRepository settles the split once every share is accepted.
+// The split row is locked first, so when the last two shares are paid at the
+// same time the second one sees the first and settles the split.
+func (r *billSplitRepository) SettleBillSplitRepository(id uuid.UUID) OrderHook {
+	return func(ctx context.Context, tx pgx.Tx, order response.OrderResponse) *http_error.HttpError {
+		if _, err := tx.Exec(ctx, "SELECT 1 FROM bill_splits WHERE id = $1 FOR UPDATE", id); err != nil {
+			return http_error.NewInternalServerError(err.Error())
+		}
+
+		query := `
+			UPDATE bill_splits
+			SET status = 'settled', settled_at = now(), updated_at = now()
+			WHERE id = $1 AND status = 'open'
+				AND NOT EXISTS (
+					SELECT 1 FROM payment_requests
+					WHERE split_id = $1 AND status <> 'accepted'
+				)
+		`
+
+		if _, err := tx.Exec(ctx, query, id); err != nil {
+			return http_error.NewInternalServerError(err.Error())
+		}
+		return nil
+	}
+}
@@ -0,0 +1,147 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/felipeversiane/picpay-golang.git/config/http_error"
+	domain "github.com/felipeversiane/picpay-golang.git/internal"
+	"github.com/felipeversiane/picpay-golang.git/internal/entity/response"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const feePlanColumns = "id, name, merchant_id, category, percentage, fixed_fee, settlement_days, created_at, updated_at"
+
+type feePlanRepository struct {
+	conn *pgxpool.Pool
+}
+
+func NewFeePlanRepository(
+	conn *pgxpool.Pool,
+) FeePlanRepository {
+	return &feePlanRepository{
+		conn,
+	}
+}
+
+type FeePlanRepository interface {
+	InsertFeePlanRepository(ctx context.Context, feePlan domain.FeePlanDomainInterface) (response.FeePlanResponse, *http_error.HttpError)
+	FindFeePlanByIDRepository(ctx context.Context, id uuid.UUID) (response.FeePlanResponse, *http_error.HttpError)
+	FindFeePlansRepository(ctx context.Context) ([]response.FeePlanResponse, *http_error.HttpError)
+	FindApplicableFeePlanRepository(ctx context.Context, merchantID uuid.UUID, category string) (response.FeePlanResponse, *http_error.HttpError)
+	DeleteFeePlanRepository(ctx context.Context, id uuid.UUID) *http_error.HttpError
+}
+
+func (r *feePlanRepository) InsertFeePlanRepository(ctx context.Context, feePlan domain.FeePlanDomainInterface) (response.FeePlanResponse, *http_error.HttpError) {
+	query := `
+		INSERT INTO fee_plans (id, name, merchant_id, category, percentage, fixed_fee, settlement_days, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		RETURNING ` + feePlanColumns
+
+	result, err := scanFeePlan(r.conn.QueryRow(ctx, query,
+		feePlan.GetID(), feePlan.GetName(),
+		feePlan.GetMerchantID(), feePlan.GetCategory(),
+		feePlan.GetPercentage(), feePlan.GetFixedFee(),
+		feePlan.GetSettlementDays(),
+		feePlan.GetCreatedAt(), feePlan.GetUpdatedAt(),
+	))
+	if err != nil {
+		if isUniqueViolation(err) {
+			return response.FeePlanResponse{}, http_error.NewBadRequestError("A fee plan already exists for this merchant or category")
+		}
+		return response.FeePlanResponse{}, http_error.NewInternalServerError(err.Error())
+	}
+
+	return result, nil
+}
+
+func (r *feePlanRepository) FindFeePlanByIDRepository(ctx context.Context, id uuid.UUID) (response.FeePlanResponse, *http_error.HttpError) {
+	query := "SELECT " + feePlanColumns + " FROM fee_plans WHERE id = $1"
+
+	result, err := scanFeePlan(r.conn.QueryRow(ctx, query, id))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return response.FeePlanResponse{}, http_error.NewNotFoundError("Fee plan not found")
+		}
+		return response.FeePlanResponse{}, http_error.NewInternalServerError(err.Error())
+	}
+
+	return result, nil
+}
+
+func (r *feePlanRepository) FindFeePlansRepository(ctx context.Context) ([]response.FeePlanResponse, *http_error.HttpError) {
+	query := "SELECT " + feePlanColumns + " FROM fee_plans ORDER BY created_at"
+
+	rows, err := r.conn.Query(ctx, query)
+	if err != nil {
+		return nil, http_error.NewInternalServerError(err.Error())
+	}
+	defer rows.Close()
+
+	feePlans := []response.FeePlanResponse{}
+	for rows.Next() {
+		feePlan, err := scanFeePlan(rows)
+		if err != nil {
+			return nil, http_error.NewInternalServerError(err.Error())
+		}
+		feePlans = append(feePlans, feePlan)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, http_error.NewInternalServerError(err.Error())
+	}
+
+	return feePlans, nil
+}
+
+func (r *feePlanRepository) FindApplicableFeePlanRepository(ctx context.Context, merchantID uuid.UUID, category string) (response.FeePlanResponse, *http_error.HttpError) {
+	query := `
+		SELECT ` + feePlanColumns + `
+		FROM fee_plans
+		WHERE merchant_id = $1
+			OR (category = $2 AND $2 <> '')
+			OR (merchant_id IS NULL AND category IS NULL)
+		ORDER BY
+			CASE
+				WHEN merchant_id IS NOT NULL THEN 0
+				WHEN category IS NOT NULL THEN 1
+				ELSE 2
+			END
+		LIMIT 1;
+	`
+
+	result, err := scanFeePlan(r.conn.QueryRow(ctx, query, merchantID, category))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return response.FeePlanResponse{}, http_error.NewNotFoundError("Fee plan not found")
+		}
+		return response.FeePlanResponse{}, http_error.NewInternalServerError(err.Error())
+	}
+
+	return result, nil
+}
+
+func (r *feePlanRepository) DeleteFeePlanRepository(ctx context.Context, id uuid.UUID) *http_error.HttpError {
+	query := "DELETE FROM fee_plans WHERE id = $1"
+	_, err := r.conn.Exec(ctx, query, id)
+	if err != nil {
+		return http_error.NewInternalServerError(err.Error())
+	}
+	return nil
+}
+
+func scanFeePlan(row pgx.Row) (response.FeePlanResponse, error) {
+	var feePlan response.FeePlanResponse
+	err := row.Scan(
+		&feePlan.ID,
+		&feePlan.Name,
+		&feePlan.MerchantID,
+		&feePlan.Category,
+		&feePlan.Percentage,
+		&feePlan.FixedFee,
+		&feePlan.SettlementDays,
+		&feePlan.CreatedAt,
+		&feePlan.UpdatedAt,
+	)
+	return feePlan, err
+}
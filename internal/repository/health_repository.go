@@ -0,0 +1,50 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/felipeversiane/picpay-golang.git/config/http_error"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type healthRepository struct {
+	conn *pgxpool.Pool
+}
+
+func NewHealthRepository(
+	conn *pgxpool.Pool,
+) HealthRepository {
+	return &healthRepository{
+		conn,
+	}
+}
+
+type HealthRepository interface {
+	PingRepository(ctx context.Context) *http_error.HttpError
+	FindMigrationVersionRepository(ctx context.Context) (uint, bool, *http_error.HttpError)
+}
+
+func (r *healthRepository) PingRepository(ctx context.Context) *http_error.HttpError {
+	if err := r.conn.Ping(ctx); err != nil {
+		return http_error.NewInternalServerError(err.Error())
+	}
+	return nil
+}
+
+// FindMigrationVersionRepository reads the version and dirty flag that
+// golang-migrate keeps in schema_migrations.
+func (r *healthRepository) FindMigrationVersionRepository(ctx context.Context) (uint, bool, *http_error.HttpError) {
+	var version int64
+	var dirty bool
+
+	err := r.conn.QueryRow(ctx, `SELECT version, dirty FROM schema_migrations LIMIT 1`).Scan(&version, &dirty)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, false, http_error.NewNotFoundError("No migration has been applied")
+		}
+		return 0, false, http_error.NewInternalServerError(err.Error())
+	}
+	return uint(version), dirty, nil
+}
@@ -0,0 +1,231 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/felipeversiane/picpay-golang.git/config/http_error"
+	domain "github.com/felipeversiane/picpay-golang.git/internal"
+	"github.com/felipeversiane/picpay-golang.git/internal/entity/response"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const invoiceColumns = "id, merchant_id, customer_id, amount, late_fee, daily_interest, due_date, status, paid_amount, order_id, paid_at, created_at, updated_at"
+
+type invoiceRepository struct {
+	conn *pgxpool.Pool
+}
+
+func NewInvoiceRepository(
+	conn *pgxpool.Pool,
+) InvoiceRepository {
+	return &invoiceRepository{
+		conn,
+	}
+}
+
+type InvoiceRepository interface {
+	InsertInvoiceRepository(ctx context.Context, invoice domain.InvoiceDomainInterface) *http_error.HttpError
+	FindInvoiceByIDRepository(ctx context.Context, id uuid.UUID) (response.InvoiceResponse, *http_error.HttpError)
+	FindInvoicesByUserRepository(ctx context.Context, userID uuid.UUID) ([]response.InvoiceResponse, *http_error.HttpError)
+	UpdateInvoiceStatusRepository(ctx context.Context, id uuid.UUID, from string, to string) (bool, *http_error.HttpError)
+	PayInvoiceRepository(id uuid.UUID) OrderHook
+	MarkOverdueInvoicesRepository(ctx context.Context, today time.Time) (int64, *http_error.HttpError)
+}
+
+// InsertInvoiceRepository stores the invoice and its items in a single
+// transaction.
+func (r *invoiceRepository) InsertInvoiceRepository(ctx context.Context, invoice domain.InvoiceDomainInterface) *http_error.HttpError {
+	tx, err := r.conn.Begin(ctx)
+	if err != nil {
+		return http_error.NewInternalServerError(err.Error())
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+		INSERT INTO invoices (id, merchant_id, customer_id, amount, late_fee, daily_interest, due_date, status, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+	`
+	_, err = tx.Exec(ctx, query,
+		invoice.GetID(), invoice.GetMerchantID(),
+		invoice.GetCustomerID(), invoice.GetAmount(),
+		invoice.GetLateFee(), invoice.GetDailyInterest(),
+		invoice.GetDueDate(), invoice.GetStatus(),
+		invoice.GetCreatedAt(), invoice.GetUpdatedAt(),
+	)
+	if err != nil {
+		return http_error.NewInternalServerError(err.Error())
+	}
+
+	itemQuery := `
+		INSERT INTO invoice_items (id, invoice_id, description, quantity, unit_price, amount, position)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`
+	for position, item := range invoice.GetItems() {
+		_, err := tx.Exec(ctx, itemQuery, uuid.New(), invoice.GetID(), item.Description, item.Quantity, item.UnitPrice, item.GetAmount(), position)
+		if err != nil {
+			return http_error.NewInternalServerError(err.Error())
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return http_error.NewInternalServerError(err.Error())
+	}
+
+	return nil
+}
+
+func (r *invoiceRepository) FindInvoiceByIDRepository(ctx context.Context, id uuid.UUID) (response.InvoiceResponse, *http_error.HttpError) {
+	query := "SELECT " + invoiceColumns + " FROM invoices WHERE id = $1"
+
+	invoice, err := scanInvoice(r.conn.QueryRow(ctx, query, id))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return response.InvoiceResponse{}, http_error.NewNotFoundError("Invoice not found")
+		}
+		return response.InvoiceResponse{}, http_error.NewInternalServerError(err.Error())
+	}
+
+	items, err := findInvoiceItems(ctx, r.conn, []uuid.UUID{id})
+	if err != nil {
+		return response.InvoiceResponse{}, http_error.NewInternalServerError(err.Error())
+	}
+	invoice.Items = items[id]
+
+	return invoice, nil
+}
+
+func (r *invoiceRepository) FindInvoicesByUserRepository(ctx context.Context, userID uuid.UUID) ([]response.InvoiceResponse, *http_error.HttpError) {
+	query := `
+		SELECT ` + invoiceColumns + `
+		FROM invoices
+		WHERE merchant_id = $1 OR customer_id = $1
+		ORDER BY due_date DESC, created_at DESC;
+	`
+
+	rows, err := r.conn.Query(ctx, query, userID)
+	if err != nil {
+		return nil, http_error.NewInternalServerError(err.Error())
+	}
+	defer rows.Close()
+
+	invoices := []response.InvoiceResponse{}
+	ids := []uuid.UUID{}
+	for rows.Next() {
+		invoice, err := scanInvoice(rows)
+		if err != nil {
+			return nil, http_error.NewInternalServerError(err.Error())
+		}
+		invoices = append(invoices, invoice)
+		ids = append(ids, invoice.ID)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, http_error.NewInternalServerError(err.Error())
+	}
+
+	items, err := findInvoiceItems(ctx, r.conn, ids)
+	if err != nil {
+		return nil, http_error.NewInternalServerError(err.Error())
+	}
+	for i := range invoices {
+		invoices[i].Items = items[invoices[i].ID]
+	}
+
+	return invoices, nil
+}
+
+// UpdateInvoiceStatusRepository moves the invoice to a new status only if it
+// is still in the expected one, so an invoice cannot be paid twice or paid
+// and cancelled at the same time.
+func (r *invoiceRepository) UpdateInvoiceStatusRepository(ctx context.Context, id uuid.UUID, from string, to string) (bool, *http_error.HttpError) {
+	query := "UPDATE invoices SET status = $1, updated_at = now() WHERE id = $2 AND status = $3"
+
+	tag, err := r.conn.Exec(ctx, query, to, id, from)
+	if err != nil {
+		return false, http_error.NewInternalServerError(err.Error())
+	}
+
+	return tag.RowsAffected() == 1, nil
+}
+
+// PayInvoiceRepository marks the invoice as paid by the order with the amount
+// charged. It fails when the invoice is no longer open or overdue, so it
+// cannot be paid twice or paid and cancelled at the same time.
+func (r *invoiceRepository) PayInvoiceRepository(id uuid.UUID) OrderHook {
+	return func(ctx context.Context, tx pgx.Tx, order response.OrderResponse) *http_error.HttpError {
+		query := `
+			UPDATE invoices
+			SET status = 'paid', order_id = $1, paid_amount = $2, paid_at = now(), updated_at = now()
+			WHERE id = $3 AND status IN ('open', 'overdue')
+		`
+
+		tag, err := tx.Exec(ctx, query, order.ID, order.Amount, id)
+		if err != nil {
+			return http_error.NewInternalServerError(err.Error())
+		}
+		if tag.RowsAffected() != 1 {
+			return http_error.NewBadRequestError("Invoice was changed by another request")
+		}
+		return nil
+	}
+}
+
+func (r *invoiceRepository) MarkOverdueInvoicesRepository(ctx context.Context, today time.Time) (int64, *http_error.HttpError) {
+	query := "UPDATE invoices SET status = 'overdue', updated_at = now() WHERE status = 'open' AND due_date < $1"
+
+	tag, err := r.conn.Exec(ctx, query, today)
+	if err != nil {
+		return 0, http_error.NewInternalServerError(err.Error())
+	}
+
+	return tag.RowsAffected(), nil
+}
+
+func findInvoiceItems(ctx context.Context, q rowsQuerier, ids []uuid.UUID) (map[uuid.UUID][]response.InvoiceItemResponse, error) {
+	query := `
+		SELECT invoice_id, description, quantity, unit_price, amount
+		FROM invoice_items
+		WHERE invoice_id = ANY($1)
+		ORDER BY invoice_id, position;
+	`
+
+	rows, err := q.Query(ctx, query, ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	items := map[uuid.UUID][]response.InvoiceItemResponse{}
+	for rows.Next() {
+		var invoiceID uuid.UUID
+		var item response.InvoiceItemResponse
+		if err := rows.Scan(&invoiceID, &item.Description, &item.Quantity, &item.UnitPrice, &item.Amount); err != nil {
+			return nil, err
+		}
+		items[invoiceID] = append(items[invoiceID], item)
+	}
+
+	return items, rows.Err()
+}
+
+func scanInvoice(row pgx.Row) (response.InvoiceResponse, error) {
+	var invoice response.InvoiceResponse
+	err := row.Scan(
+		&invoice.ID,
+		&invoice.MerchantID,
+		&invoice.CustomerID,
+		&invoice.Amount,
+		&invoice.LateFee,
+		&invoice.DailyInterest,
+		&invoice.DueDate,
+		&invoice.Status,
+		&invoice.PaidAmount,
+		&invoice.OrderID,
+		&invoice.PaidAt,
+		&invoice.CreatedAt,
+		&invoice.UpdatedAt,
+	)
+	return invoice, err
+}
@@ -0,0 +1,134 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/felipeversiane/picpay-golang.git/config/http_error"
+	domain "github.com/felipeversiane/picpay-golang.git/internal"
+	"github.com/felipeversiane/picpay-golang.git/internal/entity/response"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const orderAttachmentColumns = "id, order_id, uploaded_by, file_name, content_type, size, storage_key, created_at"
+
+type orderAttachmentRepository struct {
+	conn *pgxpool.Pool
+}
+
+func NewOrderAttachmentRepository(
+	conn *pgxpool.Pool,
+) OrderAttachmentRepository {
+	return &orderAttachmentRepository{
+		conn,
+	}
+}
+
+type OrderAttachmentRepository interface {
+	InsertOrderAttachmentRepository(ctx context.Context, attachment domain.OrderAttachmentDomainInterface) (response.OrderAttachmentResponse, *http_error.HttpError)
+	FindOrderAttachmentByIDRepository(ctx context.Context, orderID uuid.UUID, id uuid.UUID) (response.OrderAttachmentResponse, *http_error.HttpError)
+	FindOrderAttachmentsRepository(ctx context.Context, orderID uuid.UUID) ([]response.OrderAttachmentResponse, *http_error.HttpError)
+}
+
+// InsertOrderAttachmentRepository stores the attachment unless the order
+// already has the maximum number of attachments. The order row is locked
+// first, so concurrent uploads to the same order are counted one at a time.
+func (r *orderAttachmentRepository) InsertOrderAttachmentRepository(ctx context.Context, attachment domain.OrderAttachmentDomainInterface) (response.OrderAttachmentResponse, *http_error.HttpError) {
+	tx, err := r.conn.Begin(ctx)
+	if err != nil {
+		return response.OrderAttachmentResponse{}, http_error.NewInternalServerError(err.Error())
+	}
+	defer tx.Rollback(ctx)
+
+	var count int
+	query := `
+		SELECT (SELECT count(*) FROM order_attachments WHERE order_id = o.id)
+		FROM orders o
+		WHERE o.id = $1
+		FOR UPDATE
+	`
+	if err := tx.QueryRow(ctx, query, attachment.GetOrderID()).Scan(&count); err != nil {
+		if err == pgx.ErrNoRows {
+			return response.OrderAttachmentResponse{}, http_error.NewNotFoundError("Order not found")
+		}
+		return response.OrderAttachmentResponse{}, http_error.NewInternalServerError(err.Error())
+	}
+	if count >= domain.MaxOrderAttachments {
+		return response.OrderAttachmentResponse{}, http_error.NewBadRequestError("Order already has the maximum number of attachments")
+	}
+
+	query = `
+		INSERT INTO order_attachments (id, order_id, uploaded_by, file_name, content_type, size, storage_key, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING ` + orderAttachmentColumns
+
+	result, err := scanOrderAttachment(tx.QueryRow(ctx, query,
+		attachment.GetID(), attachment.GetOrderID(),
+		attachment.GetUploadedBy(), attachment.GetFileName(),
+		attachment.GetContentType(), attachment.GetSize(),
+		attachment.GetStorageKey(), attachment.GetCreatedAt(),
+	))
+	if err != nil {
+		return response.OrderAttachmentResponse{}, http_error.NewInternalServerError(err.Error())
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return response.OrderAttachmentResponse{}, http_error.NewInternalServerError(err.Error())
+	}
+
+	return result, nil
+}
+
+func (r *orderAttachmentRepository) FindOrderAttachmentByIDRepository(ctx context.Context, orderID uuid.UUID, id uuid.UUID) (response.OrderAttachmentResponse, *http_error.HttpError) {
+	query := "SELECT " + orderAttachmentColumns + " FROM order_attachments WHERE id = $1 AND order_id = $2"
+
+	result, err := scanOrderAttachment(r.conn.QueryRow(ctx, query, id, orderID))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return response.OrderAttachmentResponse{}, http_error.NewNotFoundError("Attachment not found")
+		}
+		return response.OrderAttachmentResponse{}, http_error.NewInternalServerError(err.Error())
+	}
+
+	return result, nil
+}
+
+func (r *orderAttachmentRepository) FindOrderAttachmentsRepository(ctx context.Context, orderID uuid.UUID) ([]response.OrderAttachmentResponse, *http_error.HttpError) {
+	query := "SELECT " + orderAttachmentColumns + " FROM order_attachments WHERE order_id = $1 ORDER BY created_at"
+
+	rows, err := r.conn.Query(ctx, query, orderID)
+	if err != nil {
+		return nil, http_error.NewInternalServerError(err.Error())
+	}
+	defer rows.Close()
+
+	attachments := []response.OrderAttachmentResponse{}
+	for rows.Next() {
+		attachment, err := scanOrderAttachment(rows)
+		if err != nil {
+			return nil, http_error.NewInternalServerError(err.Error())
+		}
+		attachments = append(attachments, attachment)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, http_error.NewInternalServerError(err.Error())
+	}
+
+	return attachments, nil
+}
+
+func scanOrderAttachment(row pgx.Row) (response.OrderAttachmentResponse, error) {
+	var attachment response.OrderAttachmentResponse
+	err := row.Scan(
+		&attachment.ID,
+		&attachment.OrderID,
+		&attachment.UploadedBy,
+		&attachment.FileName,
+		&attachment.ContentType,
+		&attachment.Size,
+		&attachment.StorageKey,
+		&attachment.CreatedAt,
+	)
+	return attachment, err
+}
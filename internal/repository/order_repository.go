@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/felipeversiane/picpay-golang.git/config/http_error"
+	"github.com/felipeversiane/picpay-golang.git/config/tracing"
 	domain "github.com/felipeversiane/picpay-golang.git/internal"
 	"github.com/felipeversiane/picpay-golang.git/internal/entity/response"
 	"github.com/google/uuid"
@@ -12,6 +13,19 @@ import (
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
+type TransferCheck func(payerBalance float64, limits response.TransferLimitsResponse) *http_error.HttpError
+
+// OrderHook runs inside the transaction of the order after the balances are
+// moved, so whatever it claims or records is committed or rolled back
+// together with the transfer.
+type OrderHook func(ctx context.Context, tx pgx.Tx, order response.OrderResponse) *http_error.HttpError
+
+// BatchOrder is one order of a batch with the hooks that run for it.
+type BatchOrder struct {
+	Order domain.OrderDomainInterface
+	Hooks []OrderHook
+}
+
 type orderRepository struct {
 	conn *pgxpool.Pool
 }
@@ -25,25 +39,107 @@ func NewOrderRepository(
 }
 
 type OrderRepository interface {
-	InsertOrderRepository(ctx context.Context, order domain.OrderDomainInterface) (response.OrderResponse, *http_error.HttpError)
+	InsertOrderRepository(ctx context.Context, order domain.OrderDomainInterface, windows TransferWindows, check TransferCheck, hooks ...OrderHook) (response.OrderResponse, *http_error.HttpError)
+	InsertOrderBatchRepository(ctx context.Context, payer uuid.UUID, orders []BatchOrder, windows TransferWindows, check TransferCheck) ([]response.OrderResponse, *http_error.HttpError)
 	FindOrderByIDRepository(ctx context.Context, orderID uuid.UUID) (response.OrderResponse, *http_error.HttpError)
 }
 
-func (r *orderRepository) InsertOrderRepository(ctx context.Context, order domain.OrderDomainInterface) (response.OrderResponse, *http_error.HttpError) {
+func (r *orderRepository) InsertOrderRepository(ctx context.Context, order domain.OrderDomainInterface, windows TransferWindows, check TransferCheck, hooks ...OrderHook) (response.OrderResponse, *http_error.HttpError) {
+	ctx, span := tracing.Start(ctx, "OrderRepository.InsertOrder")
+	defer span.End()
+
+	result, err := r.insertOrders(ctx, order.GetPayer(), []BatchOrder{{Order: order, Hooks: hooks}}, windows, check)
+	if err != nil {
+		return response.OrderResponse{}, err
+	}
+	return result[0], nil
+}
+
+// InsertOrderBatchRepository makes every order of the batch, all from the
+// same payer, in a single transaction. check is called once with the balance
+// of the payer, so it has to cover the whole batch.
+func (r *orderRepository) InsertOrderBatchRepository(ctx context.Context, payer uuid.UUID, orders []BatchOrder, windows TransferWindows, check TransferCheck) ([]response.OrderResponse, *http_error.HttpError) {
+	ctx, span := tracing.Start(ctx, "OrderRepository.InsertOrderBatch")
+	defer span.End()
+
+	return r.insertOrders(ctx, payer, orders, windows, check)
+}
+
+func (r *orderRepository) insertOrders(ctx context.Context, payer uuid.UUID, orders []BatchOrder, windows TransferWindows, check TransferCheck) ([]response.OrderResponse, *http_error.HttpError) {
+	tx, err := r.conn.Begin(ctx)
+	if err != nil {
+		return nil, http_error.NewInternalServerError(err.Error())
+	}
+	defer tx.Rollback(ctx)
+
+	ids := []uuid.UUID{payer}
+	for _, batchOrder := range orders {
+		ids = append(ids, batchOrder.Order.GetPayee())
+	}
+	balances, err := lockUserBalances(ctx, tx, ids...)
+	if err != nil {
+		return nil, http_error.NewInternalServerError(err.Error())
+	}
+	payerBalance, ok := balances[payer]
+	if !ok {
+		return nil, http_error.NewBadRequestError("Payer not found")
+	}
+	for _, batchOrder := range orders {
+		if _, ok := balances[batchOrder.Order.GetPayee()]; !ok {
+			return nil, http_error.NewBadRequestError("Payee not found")
+		}
+	}
+
+	limits, err := findTransferLimits(ctx, tx, payer, windows)
+	if err != nil {
+		return nil, http_error.NewInternalServerError(err.Error())
+	}
+
+	if checkErr := check(payerBalance, limits); checkErr != nil {
+		return nil, checkErr
+	}
+
+	results := make([]response.OrderResponse, 0, len(orders))
+	for _, batchOrder := range orders {
+		result, insertErr := insertOrder(ctx, tx, batchOrder.Order)
+		if insertErr != nil {
+			return nil, insertErr
+		}
+		for _, hook := range batchOrder.Hooks {
+			if hookErr := hook(ctx, tx, result); hookErr != nil {
+				return nil, hookErr
+			}
+		}
+		results = append(results, result)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, http_error.NewInternalServerError(err.Error())
+	}
+
+	return results, nil
+}
+
+// insertOrder stores the order and moves its amount, crediting the payee now
+// or scheduling the settlement, and records the fee.
+func insertOrder(ctx context.Context, tx pgx.Tx, order domain.OrderDomainInterface) (response.OrderResponse, *http_error.HttpError) {
 	query := `
-		INSERT INTO orders (id, amount, payee, payer, created_at)
-		VALUES ($1, $2, $3, $4, $5)
-		RETURNING id, amount, payee, payer, created_at;
+		INSERT INTO orders (id, amount, fee, net_amount, payee, payer, message, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING id, amount, fee, net_amount, payee, payer, message, created_at;
 	`
 
-	row := r.conn.QueryRow(ctx, query, order.GetID(), order.GetAmount(), order.GetPayee(), order.GetPayer(), time.Now())
+	row := tx.QueryRow(ctx, query, order.GetID(), order.GetAmount(), order.GetFee(), order.GetNetAmount(), order.GetPayee(), order.GetPayer(), order.GetMessage(), time.Now())
 
 	var orderResponse response.OrderResponse
 	err := row.Scan(
 		&orderResponse.ID,
 		&orderResponse.Amount,
+		&orderResponse.Fee,
+		&orderResponse.NetAmount,
 		&orderResponse.Payee,
 		&orderResponse.Payer,
+		&orderResponse.Message,
 		&orderResponse.CreatedAt,
 	)
 
@@ -51,12 +147,30 @@ func (r *orderRepository) InsertOrderRepository(ctx context.Context, order domai
 		return response.OrderResponse{}, http_error.NewInternalServerError(err.Error())
 	}
 
+	if err := addUserBalance(ctx, tx, order.GetPayer(), -order.GetAmount()); err != nil {
+		return response.OrderResponse{}, http_error.NewInternalServerError("Error updating payer balance")
+	}
+
+	if order.GetSettlementDate() != nil {
+		if err := scheduleSettlement(ctx, tx, order); err != nil {
+			return response.OrderResponse{}, http_error.NewInternalServerError("Error scheduling payee settlement")
+		}
+	} else if err := addUserBalance(ctx, tx, order.GetPayee(), order.GetNetAmount()); err != nil {
+		return response.OrderResponse{}, http_error.NewInternalServerError("Error updating payee balance")
+	}
+
+	if order.GetFee() > 0 {
+		if err := recordFee(ctx, tx, order); err != nil {
+			return response.OrderResponse{}, http_error.NewInternalServerError("Error recording order fee")
+		}
+	}
+
 	return orderResponse, nil
 }
 
 func (r *orderRepository) FindOrderByIDRepository(ctx context.Context, orderID uuid.UUID) (response.OrderResponse, *http_error.HttpError) {
 	query := `
-		SELECT id, amount, payee, payer, created_at
+		SELECT id, amount, fee, net_amount, payee, payer, message, created_at
 		FROM orders
 		WHERE id = $1;
 	`
@@ -67,8 +181,11 @@ func (r *orderRepository) FindOrderByIDRepository(ctx context.Context, orderID u
 	err := row.Scan(
 		&order.ID,
 		&order.Amount,
+		&order.Fee,
+		&order.NetAmount,
 		&order.Payee,
 		&order.Payer,
+		&order.Message,
 		&order.CreatedAt,
 	)
 
@@ -81,3 +198,59 @@ func (r *orderRepository) FindOrderByIDRepository(ctx context.Context, orderID u
 
 	return order, nil
 }
+
+func lockUserBalances(ctx context.Context, tx pgx.Tx, ids ...uuid.UUID) (map[uuid.UUID]float64, error) {
+	query := "SELECT id, balance FROM users WHERE id = ANY($1) ORDER BY id FOR UPDATE"
+
+	rows, err := tx.Query(ctx, query, ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	balances := map[uuid.UUID]float64{}
+	for rows.Next() {
+		var id uuid.UUID
+		var balance float64
+		if err := rows.Scan(&id, &balance); err != nil {
+			return nil, err
+		}
+		balances[id] = balance
+	}
+
+	return balances, rows.Err()
+}
+
+func addUserBalance(ctx context.Context, tx pgx.Tx, id uuid.UUID, amount float64) error {
+	query := "UPDATE users SET balance = balance + $1, updated_at = now() WHERE id = $2"
+	_, err := tx.Exec(ctx, query, amount, id)
+	return err
+}
+
+func recordFee(ctx context.Context, tx pgx.Tx, order domain.OrderDomainInterface) error {
+	query := `
+		INSERT INTO fee_ledger (id, order_id, merchant_id, fee_plan_id, account_id, gross_amount, fee_amount, net_amount, created_at)
+		VALUES ($1, $2, $3, $4, 'revenue', $5, $6, $7, $8)
+	`
+	_, err := tx.Exec(ctx, query, uuid.New(), order.GetID(), order.GetPayee(), order.GetFeePlanID(), order.GetAmount(), order.GetFee(), order.GetNetAmount(), time.Now())
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, "UPDATE platform_accounts SET balance = balance + $1, updated_at = now() WHERE id = 'revenue'", order.GetFee())
+	return err
+}
+
+func scheduleSettlement(ctx context.Context, tx pgx.Tx, order domain.OrderDomainInterface) error {
+	query := `
+		INSERT INTO settlements (id, merchant_id, order_id, amount, scheduled_for, status, created_at)
+		VALUES ($1, $2, $3, $4, $5, 'pending', $6)
+	`
+	_, err := tx.Exec(ctx, query, uuid.New(), order.GetPayee(), order.GetID(), order.GetNetAmount(), *order.GetSettlementDate(), time.Now())
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, "UPDATE users SET pending_balance = pending_balance + $1, updated_at = now() WHERE id = $2", order.GetNetAmount(), order.GetPayee())
+	return err
+}
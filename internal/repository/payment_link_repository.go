@@ -0,0 +1,179 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/felipeversiane/picpay-golang.git/config/http_error"
+	domain "github.com/felipeversiane/picpay-golang.git/internal"
+	"github.com/felipeversiane/picpay-golang.git/internal/entity/response"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const paymentLinkColumns = "id, merchant_id, slug, description, amount, reusable, status, expires_at, created_at, updated_at"
+
+type paymentLinkRepository struct {
+	conn *pgxpool.Pool
+}
+
+func NewPaymentLinkRepository(
+	conn *pgxpool.Pool,
+) PaymentLinkRepository {
+	return &paymentLinkRepository{
+		conn,
+	}
+}
+
+type PaymentLinkRepository interface {
+	InsertPaymentLinkRepository(ctx context.Context, paymentLink domain.PaymentLinkDomainInterface) (response.PaymentLinkResponse, *http_error.HttpError)
+	FindPaymentLinkByIDRepository(ctx context.Context, id uuid.UUID) (response.PaymentLinkResponse, *http_error.HttpError)
+	FindPaymentLinkBySlugRepository(ctx context.Context, slug string) (response.PaymentLinkResponse, *http_error.HttpError)
+	UpdatePaymentLinkStatusRepository(ctx context.Context, id uuid.UUID, from string, to string) (bool, *http_error.HttpError)
+	PayPaymentLinkRepository(id uuid.UUID) OrderHook
+	FindPaymentLinkPaymentsRepository(ctx context.Context, paymentLinkID uuid.UUID) ([]response.PaymentLinkPaymentResponse, *http_error.HttpError)
+}
+
+func (r *paymentLinkRepository) InsertPaymentLinkRepository(ctx context.Context, paymentLink domain.PaymentLinkDomainInterface) (response.PaymentLinkResponse, *http_error.HttpError) {
+	query := `
+		INSERT INTO payment_links (id, merchant_id, slug, description, amount, reusable, status, expires_at, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, NULLIF($5::numeric, 0), $6, 'active', $7, $8, $9)
+		RETURNING ` + paymentLinkColumns
+
+	result, err := scanPaymentLink(r.conn.QueryRow(ctx, query,
+		paymentLink.GetID(), paymentLink.GetMerchantID(),
+		paymentLink.GetSlug(), paymentLink.GetDescription(),
+		paymentLink.GetAmount(), paymentLink.IsReusable(),
+		paymentLink.GetExpiresAt(), paymentLink.GetCreatedAt(),
+		paymentLink.GetUpdatedAt(),
+	))
+	if err != nil {
+		return response.PaymentLinkResponse{}, http_error.NewInternalServerError(err.Error())
+	}
+
+	return result, nil
+}
+
+func (r *paymentLinkRepository) FindPaymentLinkByIDRepository(ctx context.Context, id uuid.UUID) (response.PaymentLinkResponse, *http_error.HttpError) {
+	query := "SELECT " + paymentLinkColumns + " FROM payment_links WHERE id = $1"
+	return findPaymentLink(ctx, r.conn, query, id)
+}
+
+func (r *paymentLinkRepository) FindPaymentLinkBySlugRepository(ctx context.Context, slug string) (response.PaymentLinkResponse, *http_error.HttpError) {
+	query := "SELECT " + paymentLinkColumns + " FROM payment_links WHERE slug = $1"
+	return findPaymentLink(ctx, r.conn, query, slug)
+}
+
+// UpdatePaymentLinkStatusRepository moves the link to a new status only if it
+// is still in the expected one, so a link used in the meantime is not marked
+// as expired. Payments go through PayPaymentLinkRepository.
+func (r *paymentLinkRepository) UpdatePaymentLinkStatusRepository(ctx context.Context, id uuid.UUID, from string, to string) (bool, *http_error.HttpError) {
+	query := "UPDATE payment_links SET status = $1, updated_at = now() WHERE id = $2 AND status = $3"
+
+	tag, err := r.conn.Exec(ctx, query, to, id, from)
+	if err != nil {
+		return false, http_error.NewInternalServerError(err.Error())
+	}
+
+	return tag.RowsAffected() == 1, nil
+}
+
+// PayPaymentLinkRepository records the order against the link and uses up a
+// single-use link. It fails when the link is no longer active or expired in
+// the meantime, so a single-use link cannot be paid twice and no link can be
+// paid after its expiration.
+func (r *paymentLinkRepository) PayPaymentLinkRepository(id uuid.UUID) OrderHook {
+	return func(ctx context.Context, tx pgx.Tx, order response.OrderResponse) *http_error.HttpError {
+		query := `
+			UPDATE payment_links
+			SET status = CASE WHEN reusable THEN status ELSE 'used' END, updated_at = now()
+			WHERE id = $1 AND status = 'active' AND (expires_at IS NULL OR expires_at > now())
+		`
+
+		tag, err := tx.Exec(ctx, query, id)
+		if err != nil {
+			return http_error.NewInternalServerError(err.Error())
+		}
+		if tag.RowsAffected() != 1 {
+			return http_error.NewBadRequestError("Payment link is no longer active or is expired")
+		}
+
+		query = `
+			INSERT INTO payment_link_payments (id, payment_link_id, order_id, payer_id, amount, created_at)
+			VALUES ($1, $2, $3, $4, $5, $6)
+		`
+
+		_, err = tx.Exec(ctx, query, uuid.New(), id, order.ID, order.Payer, order.Amount, time.Now())
+		if err != nil {
+			return http_error.NewInternalServerError(err.Error())
+		}
+		return nil
+	}
+}
+
+func (r *paymentLinkRepository) FindPaymentLinkPaymentsRepository(ctx context.Context, paymentLinkID uuid.UUID) ([]response.PaymentLinkPaymentResponse, *http_error.HttpError) {
+	query := `
+		SELECT id, payment_link_id, order_id, payer_id, amount, created_at
+		FROM payment_link_payments
+		WHERE payment_link_id = $1
+		ORDER BY created_at DESC;
+	`
+
+	rows, err := r.conn.Query(ctx, query, paymentLinkID)
+	if err != nil {
+		return nil, http_error.NewInternalServerError(err.Error())
+	}
+	defer rows.Close()
+
+	payments := []response.PaymentLinkPaymentResponse{}
+	for rows.Next() {
+		var payment response.PaymentLinkPaymentResponse
+		err := rows.Scan(
+			&payment.ID,
+			&payment.PaymentLinkID,
+			&payment.OrderID,
+			&payment.Payer,
+			&payment.Amount,
+			&payment.CreatedAt,
+		)
+		if err != nil {
+			return nil, http_error.NewInternalServerError(err.Error())
+		}
+		payments = append(payments, payment)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, http_error.NewInternalServerError(err.Error())
+	}
+
+	return payments, nil
+}
+
+func findPaymentLink(ctx context.Context, q querier, query string, arg any) (response.PaymentLinkResponse, *http_error.HttpError) {
+	result, err := scanPaymentLink(q.QueryRow(ctx, query, arg))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return response.PaymentLinkResponse{}, http_error.NewNotFoundError("Payment link not found")
+		}
+		return response.PaymentLinkResponse{}, http_error.NewInternalServerError(err.Error())
+	}
+
+	return result, nil
+}
+
+func scanPaymentLink(row pgx.Row) (response.PaymentLinkResponse, error) {
+	var paymentLink response.PaymentLinkResponse
+	err := row.Scan(
+		&paymentLink.ID,
+		&paymentLink.MerchantID,
+		&paymentLink.Slug,
+		&paymentLink.Description,
+		&paymentLink.Amount,
+		&paymentLink.Reusable,
+		&paymentLink.Status,
+		&paymentLink.ExpiresAt,
+		&paymentLink.CreatedAt,
+		&paymentLink.UpdatedAt,
+	)
+	return paymentLink, err
+}
@@ -0,0 +1,171 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/felipeversiane/picpay-golang.git/config/http_error"
+	domain "github.com/felipeversiane/picpay-golang.git/internal"
+	"github.com/felipeversiane/picpay-golang.git/internal/entity/response"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const paymentRequestColumns = "id, requester_id, payer_id, amount, note, split_id, status, expires_at, order_id, created_at, updated_at"
+
+type paymentRequestRepository struct {
+	conn *pgxpool.Pool
+}
+
+func NewPaymentRequestRepository(
+	conn *pgxpool.Pool,
+) PaymentRequestRepository {
+	return &paymentRequestRepository{
+		conn,
+	}
+}
+
+type PaymentRequestRepository interface {
+	InsertPaymentRequestRepository(ctx context.Context, paymentRequest domain.PaymentRequestDomainInterface) (response.PaymentRequestResponse, *http_error.HttpError)
+	FindPaymentRequestByIDRepository(ctx context.Context, id uuid.UUID) (response.PaymentRequestResponse, *http_error.HttpError)
+	FindPendingPaymentRequestsRepository(ctx context.Context, userID uuid.UUID, now time.Time) ([]response.PaymentRequestResponse, *http_error.HttpError)
+	UpdatePaymentRequestStatusRepository(ctx context.Context, id uuid.UUID, from string, to string) (bool, *http_error.HttpError)
+	AcceptPaymentRequestRepository(id uuid.UUID) OrderHook
+	ExpirePaymentRequestsRepository(ctx context.Context, now time.Time) (int64, *http_error.HttpError)
+}
+
+func (r *paymentRequestRepository) InsertPaymentRequestRepository(ctx context.Context, paymentRequest domain.PaymentRequestDomainInterface) (response.PaymentRequestResponse, *http_error.HttpError) {
+	result, err := insertPaymentRequest(ctx, r.conn, paymentRequest)
+	if err != nil {
+		return response.PaymentRequestResponse{}, http_error.NewInternalServerError(err.Error())
+	}
+
+	return result, nil
+}
+
+func (r *paymentRequestRepository) FindPaymentRequestByIDRepository(ctx context.Context, id uuid.UUID) (response.PaymentRequestResponse, *http_error.HttpError) {
+	query := "SELECT " + paymentRequestColumns + " FROM payment_requests WHERE id = $1"
+
+	result, err := scanPaymentRequest(r.conn.QueryRow(ctx, query, id))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return response.PaymentRequestResponse{}, http_error.NewNotFoundError("Payment request not found")
+		}
+		return response.PaymentRequestResponse{}, http_error.NewInternalServerError(err.Error())
+	}
+
+	return result, nil
+}
+
+// FindPendingPaymentRequestsRepository lists the pending requests sent or
+// received by the user. Requests past their expiration are left out even
+// before the worker marks them as expired.
+func (r *paymentRequestRepository) FindPendingPaymentRequestsRepository(ctx context.Context, userID uuid.UUID, now time.Time) ([]response.PaymentRequestResponse, *http_error.HttpError) {
+	query := `
+		SELECT ` + paymentRequestColumns + `
+		FROM payment_requests
+		WHERE (requester_id = $1 OR payer_id = $1) AND status = 'pending' AND expires_at > $2
+		ORDER BY created_at DESC;
+	`
+
+	rows, err := r.conn.Query(ctx, query, userID, now)
+	if err != nil {
+		return nil, http_error.NewInternalServerError(err.Error())
+	}
+	defer rows.Close()
+
+	paymentRequests := []response.PaymentRequestResponse{}
+	for rows.Next() {
+		paymentRequest, err := scanPaymentRequest(rows)
+		if err != nil {
+			return nil, http_error.NewInternalServerError(err.Error())
+		}
+		paymentRequests = append(paymentRequests, paymentRequest)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, http_error.NewInternalServerError(err.Error())
+	}
+
+	return paymentRequests, nil
+}
+
+// UpdatePaymentRequestStatusRepository moves the request to a new status only
+// if it is still in the expected one, so a request cannot be both accepted
+// and declined.
+func (r *paymentRequestRepository) UpdatePaymentRequestStatusRepository(ctx context.Context, id uuid.UUID, from string, to string) (bool, *http_error.HttpError) {
+	query := "UPDATE payment_requests SET status = $1, updated_at = now() WHERE id = $2 AND status = $3"
+
+	tag, err := r.conn.Exec(ctx, query, to, id, from)
+	if err != nil {
+		return false, http_error.NewInternalServerError(err.Error())
+	}
+
+	return tag.RowsAffected() == 1, nil
+}
+
+// AcceptPaymentRequestRepository marks the request as accepted and paid by
+// the order. It fails when the request is no longer pending or has expired,
+// so it cannot be paid twice.
+func (r *paymentRequestRepository) AcceptPaymentRequestRepository(id uuid.UUID) OrderHook {
+	return func(ctx context.Context, tx pgx.Tx, order response.OrderResponse) *http_error.HttpError {
+		query := `
+			UPDATE payment_requests
+			SET status = 'accepted', order_id = $1, updated_at = now()
+			WHERE id = $2 AND status = 'pending' AND expires_at > now()
+		`
+
+		tag, err := tx.Exec(ctx, query, order.ID, id)
+		if err != nil {
+			return http_error.NewInternalServerError(err.Error())
+		}
+		if tag.RowsAffected() != 1 {
+			return http_error.NewBadRequestError("Payment request was already answered")
+		}
+		return nil
+	}
+}
+
+func (r *paymentRequestRepository) ExpirePaymentRequestsRepository(ctx context.Context, now time.Time) (int64, *http_error.HttpError) {
+	query := "UPDATE payment_requests SET status = 'expired', updated_at = now() WHERE status = 'pending' AND expires_at <= $1"
+
+	tag, err := r.conn.Exec(ctx, query, now)
+	if err != nil {
+		return 0, http_error.NewInternalServerError(err.Error())
+	}
+
+	return tag.RowsAffected(), nil
+}
+
+func insertPaymentRequest(ctx context.Context, q querier, paymentRequest domain.PaymentRequestDomainInterface) (response.PaymentRequestResponse, error) {
+	query := `
+		INSERT INTO payment_requests (id, requester_id, payer_id, amount, note, split_id, status, expires_at, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		RETURNING ` + paymentRequestColumns
+
+	return scanPaymentRequest(q.QueryRow(ctx, query,
+		paymentRequest.GetID(), paymentRequest.GetRequester(),
+		paymentRequest.GetPayer(), paymentRequest.GetAmount(),
+		paymentRequest.GetNote(), paymentRequest.GetSplitID(),
+		paymentRequest.GetStatus(), paymentRequest.GetExpiresAt(),
+		paymentRequest.GetCreatedAt(), paymentRequest.GetUpdatedAt(),
+	))
+}
+
+func scanPaymentRequest(row pgx.Row) (response.PaymentRequestResponse, error) {
+	var paymentRequest response.PaymentRequestResponse
+	err := row.Scan(
+		&paymentRequest.ID,
+		&paymentRequest.Requester,
+		&paymentRequest.Payer,
+		&paymentRequest.Amount,
+		&paymentRequest.Note,
+		&paymentRequest.SplitID,
+		&paymentRequest.Status,
+		&paymentRequest.ExpiresAt,
+		&paymentRequest.OrderID,
+		&paymentRequest.CreatedAt,
+		&paymentRequest.UpdatedAt,
+	)
+	return paymentRequest, err
+}
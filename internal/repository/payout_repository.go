@@ -0,0 +1,294 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/felipeversiane/picpay-golang.git/config/http_error"
+	domain "github.com/felipeversiane/picpay-golang.git/internal"
+	"github.com/felipeversiane/picpay-golang.git/internal/entity/response"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const (
+	payoutBatchColumns = "id, payer_id, mode, status, total_items, total_amount, succeeded_items, failed_items, completed_at, created_at, updated_at"
+	payoutItemColumns  = "id, batch_id, position, payee_id, amount, status, message, order_id, processed_at"
+)
+
+type payoutRepository struct {
+	conn *pgxpool.Pool
+}
+
+func NewPayoutRepository(
+	conn *pgxpool.Pool,
+) PayoutRepository {
+	return &payoutRepository{
+		conn,
+	}
+}
+
+type PayoutRepository interface {
+	InsertPayoutBatchRepository(ctx context.Context, batch domain.PayoutBatchDomainInterface) *http_error.HttpError
+	FindPayoutBatchByIDRepository(ctx context.Context, id uuid.UUID) (response.PayoutBatchResponse, *http_error.HttpError)
+	FindPayoutItemsRepository(ctx context.Context, batchID uuid.UUID) ([]response.PayoutItemResponse, *http_error.HttpError)
+	FindMissingUsersRepository(ctx context.Context, ids []uuid.UUID) ([]uuid.UUID, *http_error.HttpError)
+	ClaimPayoutBatchRepository(ctx context.Context, staleBefore time.Time) (response.PayoutBatchResponse, bool, *http_error.HttpError)
+	PayPayoutItemRepository(id uuid.UUID) OrderHook
+	FailPayoutItemRepository(ctx context.Context, id uuid.UUID, message string) *http_error.HttpError
+	FailPayoutItemsRepository(ctx context.Context, batchID uuid.UUID, message string) *http_error.HttpError
+	CompletePayoutBatchRepository(ctx context.Context, id uuid.UUID) *http_error.HttpError
+}
+
+// InsertPayoutBatchRepository stores the batch and its items in a single
+// transaction, so a batch is never picked up by the worker half written.
+func (r *payoutRepository) InsertPayoutBatchRepository(ctx context.Context, batch domain.PayoutBatchDomainInterface) *http_error.HttpError {
+	tx, err := r.conn.Begin(ctx)
+	if err != nil {
+		return http_error.NewInternalServerError(err.Error())
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+		INSERT INTO payout_batches (id, payer_id, mode, status, total_items, total_amount, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`
+	_, err = tx.Exec(ctx, query,
+		batch.GetID(), batch.GetPayerID(),
+		batch.GetMode(), batch.GetStatus(),
+		len(batch.GetItems()), batch.GetTotalAmount(),
+		batch.GetCreatedAt(), batch.GetUpdatedAt(),
+	)
+	if err != nil {
+		return http_error.NewInternalServerError(err.Error())
+	}
+
+	rows := make([][]any, 0, len(batch.GetItems()))
+	for position, item := range batch.GetItems() {
+		rows = append(rows, []any{uuid.New(), batch.GetID(), position, item.Payee, item.Amount, domain.PayoutItemStatusPending})
+	}
+	_, err = tx.CopyFrom(ctx,
+		pgx.Identifier{"payout_items"},
+		[]string{"id", "batch_id", "position", "payee_id", "amount", "status"},
+		pgx.CopyFromRows(rows),
+	)
+	if err != nil {
+		return http_error.NewInternalServerError(err.Error())
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return http_error.NewInternalServerError(err.Error())
+	}
+
+	return nil
+}
+
+func (r *payoutRepository) FindPayoutBatchByIDRepository(ctx context.Context, id uuid.UUID) (response.PayoutBatchResponse, *http_error.HttpError) {
+	query := "SELECT " + payoutBatchColumns + " FROM payout_batches WHERE id = $1"
+
+	result, err := scanPayoutBatch(r.conn.QueryRow(ctx, query, id))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return response.PayoutBatchResponse{}, http_error.NewNotFoundError("Payout batch not found")
+		}
+		return response.PayoutBatchResponse{}, http_error.NewInternalServerError(err.Error())
+	}
+
+	return result, nil
+}
+
+func (r *payoutRepository) FindPayoutItemsRepository(ctx context.Context, batchID uuid.UUID) ([]response.PayoutItemResponse, *http_error.HttpError) {
+	query := "SELECT " + payoutItemColumns + " FROM payout_items WHERE batch_id = $1 ORDER BY position"
+
+	rows, err := r.conn.Query(ctx, query, batchID)
+	if err != nil {
+		return nil, http_error.NewInternalServerError(err.Error())
+	}
+	defer rows.Close()
+
+	items := []response.PayoutItemResponse{}
+	for rows.Next() {
+		item, err := scanPayoutItem(rows)
+		if err != nil {
+			return nil, http_error.NewInternalServerError(err.Error())
+		}
+		items = append(items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, http_error.NewInternalServerError(err.Error())
+	}
+
+	return items, nil
+}
+
+// FindMissingUsersRepository returns the ids that do not belong to any user.
+func (r *payoutRepository) FindMissingUsersRepository(ctx context.Context, ids []uuid.UUID) ([]uuid.UUID, *http_error.HttpError) {
+	query := `
+		SELECT id FROM unnest($1::uuid[]) AS id
+		EXCEPT
+		SELECT id FROM users
+	`
+
+	rows, err := r.conn.Query(ctx, query, ids)
+	if err != nil {
+		return nil, http_error.NewInternalServerError(err.Error())
+	}
+	defer rows.Close()
+
+	missing := []uuid.UUID{}
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, http_error.NewInternalServerError(err.Error())
+		}
+		missing = append(missing, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, http_error.NewInternalServerError(err.Error())
+	}
+
+	return missing, nil
+}
+
+// ClaimPayoutBatchRepository moves the oldest pending batch to processing.
+// A batch left processing since before staleBefore, by a worker that crashed
+// or gave up on an error, is claimed again; its paid items are skipped.
+// Concurrent workers skip the batches already being claimed.
+func (r *payoutRepository) ClaimPayoutBatchRepository(ctx context.Context, staleBefore time.Time) (response.PayoutBatchResponse, bool, *http_error.HttpError) {
+	query := `
+		UPDATE payout_batches SET status = $1, updated_at = now()
+		WHERE id = (
+			SELECT id FROM payout_batches
+			WHERE status = $2 OR (status = $1 AND updated_at < $3)
+			ORDER BY created_at
+			LIMIT 1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING ` + payoutBatchColumns
+
+	result, err := scanPayoutBatch(r.conn.QueryRow(ctx, query, domain.PayoutBatchStatusProcessing, domain.PayoutBatchStatusPending, staleBefore))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return response.PayoutBatchResponse{}, false, nil
+		}
+		return response.PayoutBatchResponse{}, false, http_error.NewInternalServerError(err.Error())
+	}
+
+	return result, true, nil
+}
+
+// PayPayoutItemRepository marks the item as paid by the order. It fails when
+// the item is no longer pending, so a batch claimed again never pays an item
+// twice.
+func (r *payoutRepository) PayPayoutItemRepository(id uuid.UUID) OrderHook {
+	return func(ctx context.Context, tx pgx.Tx, order response.OrderResponse) *http_error.HttpError {
+		query := `
+			UPDATE payout_items SET status = $1, message = '', order_id = $2, processed_at = $3
+			WHERE id = $4 AND status = $5
+		`
+
+		tag, err := tx.Exec(ctx, query, domain.PayoutItemStatusSucceeded, order.ID, time.Now(), id, domain.PayoutItemStatusPending)
+		if err != nil {
+			return http_error.NewInternalServerError(err.Error())
+		}
+		if tag.RowsAffected() != 1 {
+			return http_error.NewBadRequestError("Payout item was already processed")
+		}
+		return nil
+	}
+}
+
+func (r *payoutRepository) FailPayoutItemRepository(ctx context.Context, id uuid.UUID, message string) *http_error.HttpError {
+	query := `
+		UPDATE payout_items SET status = $1, message = $2, processed_at = $3
+		WHERE id = $4 AND status = $5
+	`
+
+	_, err := r.conn.Exec(ctx, query, domain.PayoutItemStatusFailed, message, time.Now(), id, domain.PayoutItemStatusPending)
+	if err != nil {
+		return http_error.NewInternalServerError(err.Error())
+	}
+
+	return nil
+}
+
+func (r *payoutRepository) FailPayoutItemsRepository(ctx context.Context, batchID uuid.UUID, message string) *http_error.HttpError {
+	query := `
+		UPDATE payout_items SET status = $1, message = $2, processed_at = $3
+		WHERE batch_id = $4 AND status = $5
+	`
+
+	_, err := r.conn.Exec(ctx, query, domain.PayoutItemStatusFailed, message, time.Now(), batchID, domain.PayoutItemStatusPending)
+	if err != nil {
+		return http_error.NewInternalServerError(err.Error())
+	}
+
+	return nil
+}
+
+// CompletePayoutBatchRepository stores the item counts of the batch. A batch
+// without a single paid item ends as failed.
+func (r *payoutRepository) CompletePayoutBatchRepository(ctx context.Context, id uuid.UUID) *http_error.HttpError {
+	query := `
+		UPDATE payout_batches b SET
+			succeeded_items = s.succeeded,
+			failed_items = s.failed,
+			status = CASE WHEN s.succeeded = 0 THEN $1 ELSE $2 END,
+			completed_at = now(),
+			updated_at = now()
+		FROM (
+			SELECT
+				count(*) FILTER (WHERE status = $3) AS succeeded,
+				count(*) FILTER (WHERE status = $4) AS failed
+			FROM payout_items
+			WHERE batch_id = $5
+		) s
+		WHERE b.id = $5
+	`
+
+	_, err := r.conn.Exec(ctx, query,
+		domain.PayoutBatchStatusFailed, domain.PayoutBatchStatusCompleted,
+		domain.PayoutItemStatusSucceeded, domain.PayoutItemStatusFailed,
+		id,
+	)
+	if err != nil {
+		return http_error.NewInternalServerError(err.Error())
+	}
+
+	return nil
+}
+
+func scanPayoutBatch(row pgx.Row) (response.PayoutBatchResponse, error) {
+	var batch response.PayoutBatchResponse
+	err := row.Scan(
+		&batch.ID,
+		&batch.PayerID,
+		&batch.Mode,
+		&batch.Status,
+		&batch.TotalItems,
+		&batch.TotalAmount,
+		&batch.SucceededItems,
+		&batch.FailedItems,
+		&batch.CompletedAt,
+		&batch.CreatedAt,
+		&batch.UpdatedAt,
+	)
+	return batch, err
+}
+
+func scanPayoutItem(row pgx.Row) (response.PayoutItemResponse, error) {
+	var item response.PayoutItemResponse
+	err := row.Scan(
+		&item.ID,
+		&item.BatchID,
+		&item.Position,
+		&item.Payee,
+		&item.Amount,
+		&item.Status,
+		&item.Message,
+		&item.OrderID,
+		&item.ProcessedAt,
+	)
+	return item, err
+}
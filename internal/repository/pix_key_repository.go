@@ -0,0 +1,448 @@
+package repository
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/felipeversiane/picpay-golang.git/config/http_error"
+	domain "github.com/felipeversiane/picpay-golang.git/internal"
+	"github.com/felipeversiane/picpay-golang.git/internal/entity/response"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const pixKeyColumns = "id, user_id, key_type, key_value, status, created_at, updated_at"
+
+const pixKeyClaimQuery = `
+	SELECT c.id, c.key_id, k.key_value, c.claimer_id, c.donor_id, c.status, c.claimable_at, c.created_at, c.resolved_at
+	FROM pix_key_claims c
+	JOIN pix_keys k ON k.id = c.key_id
+	WHERE c.id = $1
+`
+
+type PixKeyOwner struct {
+	KeyID        uuid.UUID
+	UserID       uuid.UUID
+	KeyType      string
+	Key          string
+	FirstName    string
+	LastName     string
+	Document     string
+	DocumentType string
+	IsMerchant   bool
+}
+
+type pixKeyRepository struct {
+	conn *pgxpool.Pool
+}
+
+func NewPixKeyRepository(
+	conn *pgxpool.Pool,
+) PixKeyRepository {
+	return &pixKeyRepository{
+		conn,
+	}
+}
+
+type PixKeyRepository interface {
+	InsertPixKeyRepository(ctx context.Context, key domain.PixKeyDomainInterface) (response.PixKeyResponse, *http_error.HttpError)
+	FindPixKeyByIDRepository(ctx context.Context, id uuid.UUID) (response.PixKeyResponse, *http_error.HttpError)
+	FindPixKeysByUserRepository(ctx context.Context, userID uuid.UUID) ([]response.PixKeyResponse, *http_error.HttpError)
+	CountPixKeysByUserRepository(ctx context.Context, userID uuid.UUID) (int, *http_error.HttpError)
+	FindPixKeyOwnerRepository(ctx context.Context, key string) (PixKeyOwner, *http_error.HttpError)
+	VerifyPixKeyRepository(ctx context.Context, id uuid.UUID, code string, now time.Time, maxAttempts int) (response.PixKeyResponse, *http_error.HttpError)
+	DeletePixKeyRepository(ctx context.Context, id uuid.UUID) *http_error.HttpError
+	InsertPixKeyClaimRepository(ctx context.Context, keyID uuid.UUID, claimerID uuid.UUID, donorID uuid.UUID, code string, claimableAt time.Time, expiresAt time.Time) (response.PixKeyClaimResponse, *http_error.HttpError)
+	FindPixKeyClaimByIDRepository(ctx context.Context, id uuid.UUID) (response.PixKeyClaimResponse, *http_error.HttpError)
+	CompletePixKeyClaimRepository(ctx context.Context, id uuid.UUID, code string, now time.Time, maxAttempts int) (response.PixKeyClaimResponse, *http_error.HttpError)
+	CancelPixKeyClaimRepository(ctx context.Context, id uuid.UUID, userID uuid.UUID) (response.PixKeyClaimResponse, *http_error.HttpError)
+}
+
+func (r *pixKeyRepository) InsertPixKeyRepository(ctx context.Context, key domain.PixKeyDomainInterface) (response.PixKeyResponse, *http_error.HttpError) {
+	query := `
+		INSERT INTO pix_keys (id, user_id, key_type, key_value, status, verification_code, verification_expires_at, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, NULLIF($6, ''), $7, $8, $9)
+		RETURNING ` + pixKeyColumns
+
+	result, err := scanPixKey(r.conn.QueryRow(ctx, query,
+		key.GetID(), key.GetUserID(),
+		key.GetKeyType(), key.GetKey(), key.GetStatus(),
+		key.GetVerificationCode(), key.GetVerificationExpiresAt(),
+		key.GetCreatedAt(), key.GetUpdatedAt(),
+	))
+	if err != nil {
+		if isUniqueViolation(err) {
+			return response.PixKeyResponse{}, http_error.NewBadRequestError("Key is already registered")
+		}
+		return response.PixKeyResponse{}, http_error.NewInternalServerError(err.Error())
+	}
+
+	return result, nil
+}
+
+func (r *pixKeyRepository) FindPixKeyByIDRepository(ctx context.Context, id uuid.UUID) (response.PixKeyResponse, *http_error.HttpError) {
+	query := "SELECT " + pixKeyColumns + " FROM pix_keys WHERE id = $1"
+
+	result, err := scanPixKey(r.conn.QueryRow(ctx, query, id))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return response.PixKeyResponse{}, http_error.NewNotFoundError("Key not found")
+		}
+		return response.PixKeyResponse{}, http_error.NewInternalServerError(err.Error())
+	}
+
+	return result, nil
+}
+
+func (r *pixKeyRepository) FindPixKeysByUserRepository(ctx context.Context, userID uuid.UUID) ([]response.PixKeyResponse, *http_error.HttpError) {
+	query := "SELECT " + pixKeyColumns + " FROM pix_keys WHERE user_id = $1 ORDER BY created_at"
+
+	rows, err := r.conn.Query(ctx, query, userID)
+	if err != nil {
+		return nil, http_error.NewInternalServerError(err.Error())
+	}
+	defer rows.Close()
+
+	keys := []response.PixKeyResponse{}
+	for rows.Next() {
+		key, err := scanPixKey(rows)
+		if err != nil {
+			return nil, http_error.NewInternalServerError(err.Error())
+		}
+		keys = append(keys, key)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, http_error.NewInternalServerError(err.Error())
+	}
+
+	return keys, nil
+}
+
+func (r *pixKeyRepository) CountPixKeysByUserRepository(ctx context.Context, userID uuid.UUID) (int, *http_error.HttpError) {
+	var count int
+	err := r.conn.QueryRow(ctx, "SELECT COUNT(*) FROM pix_keys WHERE user_id = $1", userID).Scan(&count)
+	if err != nil {
+		return 0, http_error.NewInternalServerError(err.Error())
+	}
+	return count, nil
+}
+
+func (r *pixKeyRepository) FindPixKeyOwnerRepository(ctx context.Context, key string) (PixKeyOwner, *http_error.HttpError) {
+	query := `
+		SELECT k.id, u.id, k.key_type, k.key_value, u.first_name, u.last_name, u.document, u.document_type, u.is_merchant
+		FROM pix_keys k
+		JOIN users u ON u.id = k.user_id
+		WHERE k.key_value = $1 AND k.status = 'active'
+	`
+
+	var owner PixKeyOwner
+	err := r.conn.QueryRow(ctx, query, key).Scan(
+		&owner.KeyID,
+		&owner.UserID,
+		&owner.KeyType,
+		&owner.Key,
+		&owner.FirstName,
+		&owner.LastName,
+		&owner.Document,
+		&owner.DocumentType,
+		&owner.IsMerchant,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return PixKeyOwner{}, http_error.NewNotFoundError("Key not found")
+		}
+		return PixKeyOwner{}, http_error.NewInternalServerError(err.Error())
+	}
+
+	return owner, nil
+}
+
+// VerifyPixKeyRepository activates a pending key when code matches. Every
+// wrong code is counted, and the code is discarded once maxAttempts is
+// reached, so it cannot be guessed within its validity.
+func (r *pixKeyRepository) VerifyPixKeyRepository(ctx context.Context, id uuid.UUID, code string, now time.Time, maxAttempts int) (response.PixKeyResponse, *http_error.HttpError) {
+	tx, err := r.conn.Begin(ctx)
+	if err != nil {
+		return response.PixKeyResponse{}, http_error.NewInternalServerError(err.Error())
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+		SELECT COALESCE(verification_code, ''), verification_expires_at, verification_attempts
+		FROM pix_keys
+		WHERE id = $1 AND status = 'pending'
+		FOR UPDATE
+	`
+
+	var stored string
+	var expiresAt *time.Time
+	var attempts int
+	if err := tx.QueryRow(ctx, query, id).Scan(&stored, &expiresAt, &attempts); err != nil {
+		if err == pgx.ErrNoRows {
+			return response.PixKeyResponse{}, http_error.NewBadRequestError("Invalid or expired verification code")
+		}
+		return response.PixKeyResponse{}, http_error.NewInternalServerError(err.Error())
+	}
+
+	if stored == "" || expiresAt == nil || !expiresAt.After(now) {
+		return response.PixKeyResponse{}, http_error.NewBadRequestError("Invalid or expired verification code")
+	}
+
+	if !codeMatches(stored, code) {
+		attempts++
+		query = "UPDATE pix_keys SET verification_attempts = $1, updated_at = now() WHERE id = $2"
+		message := "Invalid or expired verification code"
+		if attempts >= maxAttempts {
+			query = "UPDATE pix_keys SET verification_attempts = $1, verification_code = NULL, verification_expires_at = NULL, updated_at = now() WHERE id = $2"
+			message = "Too many invalid attempts. Delete the key and register it again"
+		}
+		if _, err := tx.Exec(ctx, query, attempts, id); err != nil {
+			return response.PixKeyResponse{}, http_error.NewInternalServerError(err.Error())
+		}
+		if err := tx.Commit(ctx); err != nil {
+			return response.PixKeyResponse{}, http_error.NewInternalServerError(err.Error())
+		}
+		return response.PixKeyResponse{}, http_error.NewBadRequestError(message)
+	}
+
+	query = `
+		UPDATE pix_keys
+		SET status = 'active', verification_code = NULL, verification_expires_at = NULL, verification_attempts = 0, updated_at = now()
+		WHERE id = $1
+		RETURNING ` + pixKeyColumns
+
+	result, err := scanPixKey(tx.QueryRow(ctx, query, id))
+	if err != nil {
+		if isUniqueViolation(err) {
+			return response.PixKeyResponse{}, http_error.NewBadRequestError("Key is already registered")
+		}
+		return response.PixKeyResponse{}, http_error.NewInternalServerError(err.Error())
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return response.PixKeyResponse{}, http_error.NewInternalServerError(err.Error())
+	}
+
+	return result, nil
+}
+
+func (r *pixKeyRepository) DeletePixKeyRepository(ctx context.Context, id uuid.UUID) *http_error.HttpError {
+	_, err := r.conn.Exec(ctx, "DELETE FROM pix_keys WHERE id = $1", id)
+	if err != nil {
+		return http_error.NewInternalServerError(err.Error())
+	}
+	return nil
+}
+
+// InsertPixKeyClaimRepository opens a claim that can only be completed from
+// claimableAt on, leaving the donor time to cancel it.
+func (r *pixKeyRepository) InsertPixKeyClaimRepository(ctx context.Context, keyID uuid.UUID, claimerID uuid.UUID, donorID uuid.UUID, code string, claimableAt time.Time, expiresAt time.Time) (response.PixKeyClaimResponse, *http_error.HttpError) {
+	query := `
+		INSERT INTO pix_key_claims (id, key_id, claimer_id, donor_id, status, verification_code, verification_expires_at, claimable_at, created_at)
+		VALUES ($1, $2, $3, $4, 'open', $5, $6, $7, $8)
+	`
+
+	id := uuid.New()
+	_, err := r.conn.Exec(ctx, query, id, keyID, claimerID, donorID, code, expiresAt, claimableAt, time.Now())
+	if err != nil {
+		if isUniqueViolation(err) {
+			return response.PixKeyClaimResponse{}, http_error.NewBadRequestError("There is already an open claim for this key")
+		}
+		return response.PixKeyClaimResponse{}, http_error.NewInternalServerError(err.Error())
+	}
+
+	return r.FindPixKeyClaimByIDRepository(ctx, id)
+}
+
+func (r *pixKeyRepository) FindPixKeyClaimByIDRepository(ctx context.Context, id uuid.UUID) (response.PixKeyClaimResponse, *http_error.HttpError) {
+	result, err := scanPixKeyClaim(r.conn.QueryRow(ctx, pixKeyClaimQuery, id))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return response.PixKeyClaimResponse{}, http_error.NewNotFoundError("Claim not found")
+		}
+		return response.PixKeyClaimResponse{}, http_error.NewInternalServerError(err.Error())
+	}
+
+	return result, nil
+}
+
+// CompletePixKeyClaimRepository moves the key to the claimer when code
+// matches, once the donor window is over. Every wrong code is counted, and
+// the claim is cancelled once maxAttempts is reached, so a new claim has to
+// be opened.
+func (r *pixKeyRepository) CompletePixKeyClaimRepository(ctx context.Context, id uuid.UUID, code string, now time.Time, maxAttempts int) (response.PixKeyClaimResponse, *http_error.HttpError) {
+	tx, err := r.conn.Begin(ctx)
+	if err != nil {
+		return response.PixKeyClaimResponse{}, http_error.NewInternalServerError(err.Error())
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+		SELECT key_id, claimer_id, verification_code, verification_expires_at, claimable_at, verification_attempts
+		FROM pix_key_claims
+		WHERE id = $1 AND status = 'open'
+		FOR UPDATE
+	`
+
+	var keyID, claimerID uuid.UUID
+	var attempt claimAttempt
+	if err := tx.QueryRow(ctx, query, id).Scan(&keyID, &claimerID, &attempt.stored, &attempt.expiresAt, &attempt.claimableAt, &attempt.attempts); err != nil {
+		if err == pgx.ErrNoRows {
+			return response.PixKeyClaimResponse{}, http_error.NewBadRequestError("Invalid or expired verification code")
+		}
+		return response.PixKeyClaimResponse{}, http_error.NewInternalServerError(err.Error())
+	}
+
+	switch outcome, message := attempt.resolve(code, now, maxAttempts); outcome {
+	case claimRefused:
+		return response.PixKeyClaimResponse{}, http_error.NewBadRequestError(message)
+	case claimWrongCode, claimExhausted:
+		query = "UPDATE pix_key_claims SET verification_attempts = verification_attempts + 1 WHERE id = $1"
+		if outcome == claimExhausted {
+			query = "UPDATE pix_key_claims SET verification_attempts = verification_attempts + 1, status = 'cancelled', resolved_at = now() WHERE id = $1"
+		}
+		if _, err := tx.Exec(ctx, query, id); err != nil {
+			return response.PixKeyClaimResponse{}, http_error.NewInternalServerError(err.Error())
+		}
+		if err := tx.Commit(ctx); err != nil {
+			return response.PixKeyClaimResponse{}, http_error.NewInternalServerError(err.Error())
+		}
+		return response.PixKeyClaimResponse{}, http_error.NewBadRequestError(message)
+	}
+
+	query = `
+		DELETE FROM pix_keys
+		WHERE user_id = $1 AND id <> $2
+			AND key_value = (SELECT key_value FROM pix_keys WHERE id = $2)
+	`
+	if _, err := tx.Exec(ctx, query, claimerID, keyID); err != nil {
+		return response.PixKeyClaimResponse{}, http_error.NewInternalServerError(err.Error())
+	}
+
+	query = "UPDATE pix_keys SET user_id = $1, updated_at = now() WHERE id = $2"
+	if _, err := tx.Exec(ctx, query, claimerID, keyID); err != nil {
+		return response.PixKeyClaimResponse{}, http_error.NewInternalServerError("Error transferring key")
+	}
+
+	query = "UPDATE pix_key_claims SET status = 'completed', resolved_at = now() WHERE id = $1"
+	if _, err := tx.Exec(ctx, query, id); err != nil {
+		return response.PixKeyClaimResponse{}, http_error.NewInternalServerError(err.Error())
+	}
+
+	result, err := scanPixKeyClaim(tx.QueryRow(ctx, pixKeyClaimQuery, id))
+	if err != nil {
+		return response.PixKeyClaimResponse{}, http_error.NewInternalServerError(err.Error())
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return response.PixKeyClaimResponse{}, http_error.NewInternalServerError(err.Error())
+	}
+
+	return result, nil
+}
+
+// CancelPixKeyClaimRepository cancels an open claim on behalf of userID,
+// who must be its donor or its claimer.
+func (r *pixKeyRepository) CancelPixKeyClaimRepository(ctx context.Context, id uuid.UUID, userID uuid.UUID) (response.PixKeyClaimResponse, *http_error.HttpError) {
+	query := `
+		UPDATE pix_key_claims SET status = 'cancelled', resolved_at = now()
+		WHERE id = $1 AND status = 'open' AND $2 IN (donor_id, claimer_id)
+	`
+
+	tag, err := r.conn.Exec(ctx, query, id, userID)
+	if err != nil {
+		return response.PixKeyClaimResponse{}, http_error.NewInternalServerError(err.Error())
+	}
+	if tag.RowsAffected() == 0 {
+		claim, findErr := r.FindPixKeyClaimByIDRepository(ctx, id)
+		if findErr != nil {
+			return response.PixKeyClaimResponse{}, findErr
+		}
+		if userID != claim.DonorID && userID != claim.ClaimerID {
+			return response.PixKeyClaimResponse{}, http_error.NewForbiddenError("Only the owner of the key or the claimer can cancel the claim")
+		}
+		return response.PixKeyClaimResponse{}, http_error.NewBadRequestError("Claim is not open")
+	}
+
+	return r.FindPixKeyClaimByIDRepository(ctx, id)
+}
+
+func scanPixKey(row pgx.Row) (response.PixKeyResponse, error) {
+	var key response.PixKeyResponse
+	err := row.Scan(
+		&key.ID,
+		&key.UserID,
+		&key.KeyType,
+		&key.Key,
+		&key.Status,
+		&key.CreatedAt,
+		&key.UpdatedAt,
+	)
+	return key, err
+}
+
+func scanPixKeyClaim(row pgx.Row) (response.PixKeyClaimResponse, error) {
+	var claim response.PixKeyClaimResponse
+	err := row.Scan(
+		&claim.ID,
+		&claim.KeyID,
+		&claim.Key,
+		&claim.ClaimerID,
+		&claim.DonorID,
+		&claim.Status,
+		&claim.ClaimableAt,
+		&claim.CreatedAt,
+		&claim.ResolvedAt,
+	)
+	return claim, err
+}
+
+type claimOutcome int
+
+const (
+	claimAccepted claimOutcome = iota
+	claimRefused
+	claimWrongCode
+	claimExhausted
+)
+
+// claimAttempt is the state of an open claim when its code is sent.
+type claimAttempt struct {
+	stored      string
+	expiresAt   time.Time
+	claimableAt time.Time
+	attempts    int
+}
+
+// resolve decides what a confirmation at now does. Before claimableAt the
+// claim is refused without looking at the code, so the donor window cannot
+// be skipped and no attempt is spent.
+func (a claimAttempt) resolve(code string, now time.Time, maxAttempts int) (claimOutcome, string) {
+	switch {
+	case now.Before(a.claimableAt):
+		return claimRefused, fmt.Sprintf("The claim can only be confirmed from %s, after the owner of the key had the chance to cancel it", a.claimableAt.UTC().Format(time.RFC3339))
+	case !a.expiresAt.After(now):
+		return claimRefused, "Invalid or expired verification code"
+	case codeMatches(a.stored, code):
+		return claimAccepted, ""
+	case a.attempts+1 >= maxAttempts:
+		return claimExhausted, "Too many invalid attempts. The claim was cancelled"
+	default:
+		return claimWrongCode, "Invalid or expired verification code"
+	}
+}
+
+// codeMatches compares verification codes in constant time.
+func codeMatches(stored string, code string) bool {
+	return subtle.ConstantTimeCompare([]byte(stored), []byte(code)) == 1
+}
+
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == "23505"
+}
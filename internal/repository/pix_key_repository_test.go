@@ -0,0 +1,37 @@
+package repository
+
+import (
+	"testing"
+	"time"
+)
+
+func TestClaimAttempt_Resolve(t *testing.T) {
+	opened := time.Date(2024, time.March, 10, 12, 0, 0, 0, time.UTC)
+	claimableAt := opened.Add(7 * 24 * time.Hour)
+	attempt := func(attempts int) claimAttempt {
+		return claimAttempt{stored: "123456", expiresAt: claimableAt.Add(7 * 24 * time.Hour), claimableAt: claimableAt, attempts: attempts}
+	}
+
+	tests := []struct {
+		name    string
+		attempt claimAttempt
+		code    string
+		now     time.Time
+		outcome claimOutcome
+	}{
+		{"right code right after opening", attempt(0), "123456", opened, claimRefused},
+		{"right code a minute before the window ends", attempt(0), "123456", claimableAt.Add(-time.Minute), claimRefused},
+		{"wrong code before the window ends", attempt(4), "000000", opened, claimRefused},
+		{"right code when the window ends", attempt(0), "123456", claimableAt, claimAccepted},
+		{"right code after the window", attempt(2), "123456", claimableAt.Add(time.Hour), claimAccepted},
+		{"wrong code after the window", attempt(0), "000000", claimableAt.Add(time.Hour), claimWrongCode},
+		{"last wrong code after the window", attempt(4), "000000", claimableAt.Add(time.Hour), claimExhausted},
+		{"right code after it expired", attempt(0), "123456", claimableAt.Add(8 * 24 * time.Hour), claimRefused},
+	}
+
+	for _, tt := range tests {
+		if outcome, _ := tt.attempt.resolve(tt.code, tt.now, 5); outcome != tt.outcome {
+			t.Errorf("%s: expected outcome %d and received %d", tt.name, tt.outcome, outcome)
+		}
+	}
+}
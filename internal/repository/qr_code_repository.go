@@ -0,0 +1,115 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/felipeversiane/picpay-golang.git/config/http_error"
+	domain "github.com/felipeversiane/picpay-golang.git/internal"
+	"github.com/felipeversiane/picpay-golang.git/internal/entity/response"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const qrCodeColumns = "id, merchant_id, code_type, amount, payload, status, expires_at, order_id, created_at, updated_at"
+
+type qrCodeRepository struct {
+	conn *pgxpool.Pool
+}
+
+func NewQRCodeRepository(
+	conn *pgxpool.Pool,
+) QRCodeRepository {
+	return &qrCodeRepository{
+		conn,
+	}
+}
+
+type QRCodeRepository interface {
+	InsertQRCodeRepository(ctx context.Context, qrCode domain.QRCodeDomainInterface) (response.QRCodeResponse, *http_error.HttpError)
+	FindQRCodeByIDRepository(ctx context.Context, id uuid.UUID) (response.QRCodeResponse, *http_error.HttpError)
+	UpdateQRCodeStatusRepository(ctx context.Context, id uuid.UUID, from string, to string) (bool, *http_error.HttpError)
+	PayQRCodeRepository(id uuid.UUID) OrderHook
+}
+
+func (r *qrCodeRepository) InsertQRCodeRepository(ctx context.Context, qrCode domain.QRCodeDomainInterface) (response.QRCodeResponse, *http_error.HttpError) {
+	query := `
+		INSERT INTO qr_codes (id, merchant_id, code_type, amount, payload, status, expires_at, created_at, updated_at)
+		VALUES ($1, $2, $3, NULLIF($4::numeric, 0), $5, 'active', $6, $7, $8)
+		RETURNING ` + qrCodeColumns
+
+	result, err := scanQRCode(r.conn.QueryRow(ctx, query,
+		qrCode.GetID(), qrCode.GetMerchantID(),
+		qrCode.GetCodeType(), qrCode.GetAmount(),
+		qrCode.GetPayload(), qrCode.GetExpiresAt(),
+		qrCode.GetCreatedAt(), qrCode.GetUpdatedAt(),
+	))
+	if err != nil {
+		return response.QRCodeResponse{}, http_error.NewInternalServerError(err.Error())
+	}
+
+	return result, nil
+}
+
+func (r *qrCodeRepository) FindQRCodeByIDRepository(ctx context.Context, id uuid.UUID) (response.QRCodeResponse, *http_error.HttpError) {
+	query := "SELECT " + qrCodeColumns + " FROM qr_codes WHERE id = $1"
+
+	result, err := scanQRCode(r.conn.QueryRow(ctx, query, id))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return response.QRCodeResponse{}, http_error.NewNotFoundError("QR code not found")
+		}
+		return response.QRCodeResponse{}, http_error.NewInternalServerError(err.Error())
+	}
+
+	return result, nil
+}
+
+// UpdateQRCodeStatusRepository moves the QR code to a new status only if it
+// is still in the expected one, so a code paid in the meantime is not marked
+// as expired. Payments go through PayQRCodeRepository.
+func (r *qrCodeRepository) UpdateQRCodeStatusRepository(ctx context.Context, id uuid.UUID, from string, to string) (bool, *http_error.HttpError) {
+	query := "UPDATE qr_codes SET status = $1, updated_at = now() WHERE id = $2 AND status = $3"
+
+	tag, err := r.conn.Exec(ctx, query, to, id, from)
+	if err != nil {
+		return false, http_error.NewInternalServerError(err.Error())
+	}
+
+	return tag.RowsAffected() == 1, nil
+}
+
+// PayQRCodeRepository marks a dynamic QR code as paid by the order. It fails
+// when the code is no longer active or expired in the meantime, so it cannot
+// be paid twice or after its expiration.
+func (r *qrCodeRepository) PayQRCodeRepository(id uuid.UUID) OrderHook {
+	return func(ctx context.Context, tx pgx.Tx, order response.OrderResponse) *http_error.HttpError {
+		query := "UPDATE qr_codes SET status = 'paid', order_id = $1, updated_at = now() WHERE id = $2 AND status = 'active' AND (expires_at IS NULL OR expires_at > now())"
+
+		tag, err := tx.Exec(ctx, query, order.ID, id)
+		if err != nil {
+			return http_error.NewInternalServerError(err.Error())
+		}
+		if tag.RowsAffected() != 1 {
+			return http_error.NewBadRequestError("QR code was already paid or is expired")
+		}
+		return nil
+	}
+}
+
+func scanQRCode(row pgx.Row) (response.QRCodeResponse, error) {
+	var qrCode response.QRCodeResponse
+	err := row.Scan(
+		&qrCode.ID,
+		&qrCode.MerchantID,
+		&qrCode.Type,
+		&qrCode.Amount,
+		&qrCode.Payload,
+		&qrCode.Status,
+		&qrCode.ExpiresAt,
+		&qrCode.OrderID,
+		&qrCode.CreatedAt,
+		&qrCode.UpdatedAt,
+	)
+	return qrCode, err
+}
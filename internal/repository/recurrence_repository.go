@@ -0,0 +1,207 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/felipeversiane/picpay-golang.git/config/http_error"
+	domain "github.com/felipeversiane/picpay-golang.git/internal"
+	"github.com/felipeversiane/picpay-golang.git/internal/entity/response"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const recurrenceColumns = "id, amount, payee, payer, schedule, on_insufficient_funds, max_retries, retry_count, status, next_run_at, created_at, updated_at"
+
+type recurrenceRepository struct {
+	conn *pgxpool.Pool
+}
+
+func NewRecurrenceRepository(
+	conn *pgxpool.Pool,
+) RecurrenceRepository {
+	return &recurrenceRepository{
+		conn,
+	}
+}
+
+type RecurrenceRepository interface {
+	InsertRecurrenceRepository(ctx context.Context, recurrence domain.RecurrenceDomainInterface) (response.RecurrenceResponse, *http_error.HttpError)
+	FindRecurrenceByIDRepository(ctx context.Context, id uuid.UUID) (response.RecurrenceResponse, *http_error.HttpError)
+	FindDueRecurrencesRepository(ctx context.Context, now time.Time, limit int) ([]response.RecurrenceResponse, *http_error.HttpError)
+	ClaimRecurrenceRepository(ctx context.Context, id uuid.UUID, previousRunAt time.Time, nextRunAt time.Time, status string) (bool, *http_error.HttpError)
+	UpdateRecurrenceStateRepository(ctx context.Context, id uuid.UUID, status string, nextRunAt time.Time, retryCount int) (response.RecurrenceResponse, *http_error.HttpError)
+	InsertRecurrenceExecutionRepository(ctx context.Context, recurrenceID uuid.UUID, orderID *uuid.UUID, status string, message string) *http_error.HttpError
+	FindRecurrenceExecutionsRepository(ctx context.Context, recurrenceID uuid.UUID) ([]response.RecurrenceExecutionResponse, *http_error.HttpError)
+}
+
+func (r *recurrenceRepository) InsertRecurrenceRepository(ctx context.Context, recurrence domain.RecurrenceDomainInterface) (response.RecurrenceResponse, *http_error.HttpError) {
+	query := `
+		INSERT INTO recurrences (id, amount, payee, payer, schedule, on_insufficient_funds, max_retries, status, next_run_at, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		RETURNING ` + recurrenceColumns
+
+	row := r.conn.QueryRow(ctx, query,
+		recurrence.GetID(), recurrence.GetAmount(),
+		recurrence.GetPayee(), recurrence.GetPayer(),
+		recurrence.GetSchedule(), recurrence.GetOnInsufficientFunds(),
+		recurrence.GetMaxRetries(), recurrence.GetStatus(),
+		recurrence.GetNextRunAt(), recurrence.GetCreatedAt(),
+		recurrence.GetUpdatedAt(),
+	)
+
+	result, err := scanRecurrence(row)
+	if err != nil {
+		return response.RecurrenceResponse{}, http_error.NewInternalServerError(err.Error())
+	}
+
+	return result, nil
+}
+
+func (r *recurrenceRepository) FindRecurrenceByIDRepository(ctx context.Context, id uuid.UUID) (response.RecurrenceResponse, *http_error.HttpError) {
+	query := "SELECT " + recurrenceColumns + " FROM recurrences WHERE id = $1"
+
+	result, err := scanRecurrence(r.conn.QueryRow(ctx, query, id))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return response.RecurrenceResponse{}, http_error.NewNotFoundError("Recurrence not found")
+		}
+		return response.RecurrenceResponse{}, http_error.NewInternalServerError(err.Error())
+	}
+
+	return result, nil
+}
+
+func (r *recurrenceRepository) FindDueRecurrencesRepository(ctx context.Context, now time.Time, limit int) ([]response.RecurrenceResponse, *http_error.HttpError) {
+	query := `
+		SELECT ` + recurrenceColumns + `
+		FROM recurrences
+		WHERE status = 'active' AND next_run_at <= $1
+		ORDER BY next_run_at
+		LIMIT $2;
+	`
+
+	rows, err := r.conn.Query(ctx, query, now, limit)
+	if err != nil {
+		return nil, http_error.NewInternalServerError(err.Error())
+	}
+	defer rows.Close()
+
+	recurrences := []response.RecurrenceResponse{}
+	for rows.Next() {
+		recurrence, err := scanRecurrence(rows)
+		if err != nil {
+			return nil, http_error.NewInternalServerError(err.Error())
+		}
+		recurrences = append(recurrences, recurrence)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, http_error.NewInternalServerError(err.Error())
+	}
+
+	return recurrences, nil
+}
+
+func (r *recurrenceRepository) ClaimRecurrenceRepository(ctx context.Context, id uuid.UUID, previousRunAt time.Time, nextRunAt time.Time, status string) (bool, *http_error.HttpError) {
+	query := `
+		UPDATE recurrences
+		SET next_run_at = $1, status = $2, updated_at = now()
+		WHERE id = $3 AND status = 'active' AND next_run_at = $4
+	`
+
+	tag, err := r.conn.Exec(ctx, query, nextRunAt, status, id, previousRunAt)
+	if err != nil {
+		return false, http_error.NewInternalServerError(err.Error())
+	}
+
+	return tag.RowsAffected() == 1, nil
+}
+
+func (r *recurrenceRepository) UpdateRecurrenceStateRepository(ctx context.Context, id uuid.UUID, status string, nextRunAt time.Time, retryCount int) (response.RecurrenceResponse, *http_error.HttpError) {
+	query := `
+		UPDATE recurrences
+		SET status = $1, next_run_at = $2, retry_count = $3, updated_at = now()
+		WHERE id = $4
+		RETURNING ` + recurrenceColumns
+
+	result, err := scanRecurrence(r.conn.QueryRow(ctx, query, status, nextRunAt, retryCount, id))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return response.RecurrenceResponse{}, http_error.NewNotFoundError("Recurrence not found")
+		}
+		return response.RecurrenceResponse{}, http_error.NewInternalServerError(err.Error())
+	}
+
+	return result, nil
+}
+
+func (r *recurrenceRepository) InsertRecurrenceExecutionRepository(ctx context.Context, recurrenceID uuid.UUID, orderID *uuid.UUID, status string, message string) *http_error.HttpError {
+	query := `
+		INSERT INTO recurrence_executions (id, recurrence_id, order_id, status, message, executed_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`
+
+	_, err := r.conn.Exec(ctx, query, uuid.New(), recurrenceID, orderID, status, message, time.Now())
+	if err != nil {
+		return http_error.NewInternalServerError(err.Error())
+	}
+
+	return nil
+}
+
+func (r *recurrenceRepository) FindRecurrenceExecutionsRepository(ctx context.Context, recurrenceID uuid.UUID) ([]response.RecurrenceExecutionResponse, *http_error.HttpError) {
+	query := `
+		SELECT id, recurrence_id, order_id, status, message, executed_at
+		FROM recurrence_executions
+		WHERE recurrence_id = $1
+		ORDER BY executed_at DESC;
+	`
+
+	rows, err := r.conn.Query(ctx, query, recurrenceID)
+	if err != nil {
+		return nil, http_error.NewInternalServerError(err.Error())
+	}
+	defer rows.Close()
+
+	executions := []response.RecurrenceExecutionResponse{}
+	for rows.Next() {
+		var execution response.RecurrenceExecutionResponse
+		err := rows.Scan(
+			&execution.ID,
+			&execution.RecurrenceID,
+			&execution.OrderID,
+			&execution.Status,
+			&execution.Message,
+			&execution.ExecutedAt,
+		)
+		if err != nil {
+			return nil, http_error.NewInternalServerError(err.Error())
+		}
+		executions = append(executions, execution)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, http_error.NewInternalServerError(err.Error())
+	}
+
+	return executions, nil
+}
+
+func scanRecurrence(row pgx.Row) (response.RecurrenceResponse, error) {
+	var recurrence response.RecurrenceResponse
+	err := row.Scan(
+		&recurrence.ID,
+		&recurrence.Amount,
+		&recurrence.Payee,
+		&recurrence.Payer,
+		&recurrence.Schedule,
+		&recurrence.OnInsufficientFunds,
+		&recurrence.MaxRetries,
+		&recurrence.RetryCount,
+		&recurrence.Status,
+		&recurrence.NextRunAt,
+		&recurrence.CreatedAt,
+		&recurrence.UpdatedAt,
+	)
+	return recurrence, err
+}
@@ -0,0 +1,132 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/felipeversiane/picpay-golang.git/config/http_error"
+	"github.com/felipeversiane/picpay-golang.git/internal/entity/response"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type settlementRepository struct {
+	conn *pgxpool.Pool
+}
+
+func NewSettlementRepository(
+	conn *pgxpool.Pool,
+) SettlementRepository {
+	return &settlementRepository{
+		conn,
+	}
+}
+
+type SettlementRepository interface {
+	FindPendingSettlementsRepository(ctx context.Context, merchantID uuid.UUID) ([]response.SettlementResponse, *http_error.HttpError)
+	FindSettlementScheduleRepository(ctx context.Context, merchantID uuid.UUID) ([]response.SettlementScheduleResponse, *http_error.HttpError)
+	ReleaseDueSettlementsRepository(ctx context.Context, today time.Time) (int64, *http_error.HttpError)
+}
+
+func (r *settlementRepository) FindPendingSettlementsRepository(ctx context.Context, merchantID uuid.UUID) ([]response.SettlementResponse, *http_error.HttpError) {
+	query := `
+		SELECT id, merchant_id, order_id, amount, scheduled_for, status, settled_at, created_at
+		FROM settlements
+		WHERE merchant_id = $1 AND status = 'pending'
+		ORDER BY scheduled_for, created_at
+	`
+
+	rows, err := r.conn.Query(ctx, query, merchantID)
+	if err != nil {
+		return nil, http_error.NewInternalServerError(err.Error())
+	}
+	defer rows.Close()
+
+	settlements := []response.SettlementResponse{}
+	for rows.Next() {
+		settlement, err := scanSettlement(rows)
+		if err != nil {
+			return nil, http_error.NewInternalServerError(err.Error())
+		}
+		settlements = append(settlements, settlement)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, http_error.NewInternalServerError(err.Error())
+	}
+
+	return settlements, nil
+}
+
+func (r *settlementRepository) FindSettlementScheduleRepository(ctx context.Context, merchantID uuid.UUID) ([]response.SettlementScheduleResponse, *http_error.HttpError) {
+	query := `
+		SELECT scheduled_for, SUM(amount), COUNT(*)
+		FROM settlements
+		WHERE merchant_id = $1 AND status = 'pending'
+		GROUP BY scheduled_for
+		ORDER BY scheduled_for
+	`
+
+	rows, err := r.conn.Query(ctx, query, merchantID)
+	if err != nil {
+		return nil, http_error.NewInternalServerError(err.Error())
+	}
+	defer rows.Close()
+
+	schedule := []response.SettlementScheduleResponse{}
+	for rows.Next() {
+		var day response.SettlementScheduleResponse
+		if err := rows.Scan(&day.Date, &day.Amount, &day.Count); err != nil {
+			return nil, http_error.NewInternalServerError(err.Error())
+		}
+		schedule = append(schedule, day)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, http_error.NewInternalServerError(err.Error())
+	}
+
+	return schedule, nil
+}
+
+func (r *settlementRepository) ReleaseDueSettlementsRepository(ctx context.Context, today time.Time) (int64, *http_error.HttpError) {
+	query := `
+		WITH released AS (
+			UPDATE settlements
+			SET status = 'settled', settled_at = now()
+			WHERE status = 'pending' AND scheduled_for <= $1
+			RETURNING merchant_id, amount
+		), totals AS (
+			SELECT merchant_id, SUM(amount) AS amount
+			FROM released
+			GROUP BY merchant_id
+		)
+		UPDATE users u
+		SET balance = u.balance + t.amount,
+			pending_balance = u.pending_balance - t.amount,
+			updated_at = now()
+		FROM totals t
+		WHERE u.id = t.merchant_id
+	`
+
+	tag, err := r.conn.Exec(ctx, query, today)
+	if err != nil {
+		return 0, http_error.NewInternalServerError(err.Error())
+	}
+
+	return tag.RowsAffected(), nil
+}
+
+func scanSettlement(row pgx.Row) (response.SettlementResponse, error) {
+	var settlement response.SettlementResponse
+	err := row.Scan(
+		&settlement.ID,
+		&settlement.MerchantID,
+		&settlement.OrderID,
+		&settlement.Amount,
+		&settlement.ScheduledFor,
+		&settlement.Status,
+		&settlement.SettledAt,
+		&settlement.CreatedAt,
+	)
+	return settlement, err
+}
@@ -0,0 +1,96 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/felipeversiane/picpay-golang.git/config/http_error"
+	"github.com/felipeversiane/picpay-golang.git/internal/entity/response"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type TransferWindows struct {
+	DayStart   time.Time
+	MonthStart time.Time
+	HourStart  time.Time
+	NightStart time.Time
+	IsNight    bool
+}
+
+type querier interface {
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+type transferLimitRepository struct {
+	conn *pgxpool.Pool
+}
+
+func NewTransferLimitRepository(
+	conn *pgxpool.Pool,
+) TransferLimitRepository {
+	return &transferLimitRepository{
+		conn,
+	}
+}
+
+type TransferLimitRepository interface {
+	FindTransferLimitsRepository(ctx context.Context, userID uuid.UUID, windows TransferWindows) (response.TransferLimitsResponse, *http_error.HttpError)
+}
+
+func (r *transferLimitRepository) FindTransferLimitsRepository(ctx context.Context, userID uuid.UUID, windows TransferWindows) (response.TransferLimitsResponse, *http_error.HttpError) {
+	limits, err := findTransferLimits(ctx, r.conn, userID, windows)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return response.TransferLimitsResponse{}, http_error.NewNotFoundError("User not found")
+		}
+		return response.TransferLimitsResponse{}, http_error.NewInternalServerError(err.Error())
+	}
+
+	return limits, nil
+}
+
+func findTransferLimits(ctx context.Context, q querier, userID uuid.UUID, windows TransferWindows) (response.TransferLimitsResponse, error) {
+	query := `
+		SELECT
+			u.id,
+			l.tier,
+			l.max_per_transaction,
+			l.daily_limit,
+			l.monthly_limit,
+			l.nightly_limit,
+			l.max_transfers_per_hour,
+			COALESCE((SELECT SUM(amount) FROM orders WHERE payer = u.id AND created_at >= $2), 0),
+			COALESCE((SELECT SUM(amount) FROM orders WHERE payer = u.id AND created_at >= $3), 0),
+			COALESCE((SELECT SUM(amount) FROM orders WHERE payer = u.id AND created_at >= $4), 0),
+			(SELECT COUNT(*) FROM orders WHERE payer = u.id AND created_at >= $5)
+		FROM users u
+		JOIN transfer_limits l ON l.tier = u.tier
+		WHERE u.id = $1;
+	`
+
+	var limits response.TransferLimitsResponse
+	err := q.QueryRow(ctx, query,
+		userID,
+		windows.DayStart.UTC(),
+		windows.MonthStart.UTC(),
+		windows.NightStart.UTC(),
+		windows.HourStart.UTC(),
+	).Scan(
+		&limits.UserID,
+		&limits.Tier,
+		&limits.MaxPerTransaction,
+		&limits.DailyLimit,
+		&limits.MonthlyLimit,
+		&limits.NightlyLimit,
+		&limits.MaxTransfersPerHour,
+		&limits.DailyUsed,
+		&limits.MonthlyUsed,
+		&limits.NightlyUsed,
+		&limits.TransfersLastHour,
+	)
+	limits.IsNight = windows.IsNight
+
+	return limits, err
+}
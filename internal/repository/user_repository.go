@@ -33,18 +33,23 @@ type UserRepository interface {
 }
 
 func (ur *userRepository) InsertUserRepository(ctx context.Context, user domain.UserDomainInterface) (response.UserResponse, *http_error.HttpError) {
-	query := "INSERT INTO users (id, email, password, first_name, last_name, document, balance, is_merchant, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10) RETURNING id, email, password, first_name, last_name, document, balance, is_merchant, created_at, updated_at"
+	query := "INSERT INTO users (id, email, password, first_name, last_name, document, document_type, balance, is_merchant, merchant_category, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12) RETURNING id, email, password, first_name, last_name, document, document_type, balance, pending_balance, is_merchant, merchant_category, tier, created_at, updated_at"
 	var insertedUser response.UserResponse
 	err := ur.conn.QueryRow(ctx, query,
 		user.GetID(), user.GetEmail(),
 		user.GetPassword(), user.GetFirstName(),
 		user.GetLastName(), user.GetDocument(),
+		user.GetDocumentType(),
 		user.GetBalance(), user.GetIsMerchant(),
+		user.GetMerchantCategory(),
 		user.GetCreatedAt(), user.GetUpdatedAt()).Scan(
 		&insertedUser.ID, &insertedUser.Email,
 		&insertedUser.Password, &insertedUser.FirstName,
 		&insertedUser.LastName, &insertedUser.Document,
-		&insertedUser.Balance, &insertedUser.IsMerchant,
+		&insertedUser.DocumentType,
+		&insertedUser.Balance, &insertedUser.PendingBalance,
+		&insertedUser.IsMerchant,
+		&insertedUser.MerchantCategory, &insertedUser.Tier,
 		&insertedUser.CreatedAt, &insertedUser.UpdatedAt,
 	)
 
@@ -56,13 +61,16 @@ func (ur *userRepository) InsertUserRepository(ctx context.Context, user domain.
 }
 
 func (ur *userRepository) FindUserByDocumentRepository(ctx context.Context, document string) (response.UserResponse, *http_error.HttpError) {
-	query := "SELECT id, email, password, first_name, last_name, document, balance, is_merchant, created_at, updated_at FROM users WHERE document = $1"
+	query := "SELECT id, email, password, first_name, last_name, document, document_type, balance, pending_balance, is_merchant, merchant_category, tier, created_at, updated_at FROM users WHERE document = $1"
 	var foundUser response.UserResponse
 	err := ur.conn.QueryRow(ctx, query, document).Scan(
 		&foundUser.ID, &foundUser.Email,
 		&foundUser.Password, &foundUser.FirstName,
 		&foundUser.LastName, &foundUser.Document,
-		&foundUser.Balance, &foundUser.IsMerchant,
+		&foundUser.DocumentType,
+		&foundUser.Balance, &foundUser.PendingBalance,
+		&foundUser.IsMerchant,
+		&foundUser.MerchantCategory, &foundUser.Tier,
 		&foundUser.CreatedAt, &foundUser.UpdatedAt,
 	)
 
@@ -77,13 +85,16 @@ func (ur *userRepository) FindUserByDocumentRepository(ctx context.Context, docu
 }
 
 func (ur *userRepository) FindUserByIDRepository(ctx context.Context, id uuid.UUID) (response.UserResponse, *http_error.HttpError) {
-	query := "SELECT id, email, password, first_name, last_name, document, balance, is_merchant, created_at, updated_at FROM users WHERE id = $1"
+	query := "SELECT id, email, password, first_name, last_name, document, document_type, balance, pending_balance, is_merchant, merchant_category, tier, created_at, updated_at FROM users WHERE id = $1"
 	var foundUser response.UserResponse
 	err := ur.conn.QueryRow(ctx, query, id).Scan(
 		&foundUser.ID, &foundUser.Email,
 		&foundUser.Password, &foundUser.FirstName,
 		&foundUser.LastName, &foundUser.Document,
-		&foundUser.Balance, &foundUser.IsMerchant,
+		&foundUser.DocumentType,
+		&foundUser.Balance, &foundUser.PendingBalance,
+		&foundUser.IsMerchant,
+		&foundUser.MerchantCategory, &foundUser.Tier,
 		&foundUser.CreatedAt, &foundUser.UpdatedAt,
 	)
 
@@ -98,13 +109,16 @@ func (ur *userRepository) FindUserByIDRepository(ctx context.Context, id uuid.UU
 }
 
 func (ur *userRepository) FindUserByEmailRepository(ctx context.Context, email string) (response.UserResponse, *http_error.HttpError) {
-	query := "SELECT id, email, password, first_name, last_name, document, balance, is_merchant, created_at, updated_at FROM users WHERE email = $1"
+	query := "SELECT id, email, password, first_name, last_name, document, document_type, balance, pending_balance, is_merchant, merchant_category, tier, created_at, updated_at FROM users WHERE email = $1"
 	var foundUser response.UserResponse
 	err := ur.conn.QueryRow(ctx, query, email).Scan(
 		&foundUser.ID, &foundUser.Email,
 		&foundUser.Password, &foundUser.FirstName,
 		&foundUser.LastName, &foundUser.Document,
-		&foundUser.Balance, &foundUser.IsMerchant,
+		&foundUser.DocumentType,
+		&foundUser.Balance, &foundUser.PendingBalance,
+		&foundUser.IsMerchant,
+		&foundUser.MerchantCategory, &foundUser.Tier,
 		&foundUser.CreatedAt, &foundUser.UpdatedAt,
 	)
 
@@ -126,10 +140,12 @@ func (ur *userRepository) UpdateUserRepository(ctx context.Context, user domain.
 			last_name = $2, 
 			balance = $3, 
 			is_merchant = $4, 
+			tier = COALESCE(NULLIF($5, ''), tier),
+			merchant_category = COALESCE(NULLIF($6, ''), merchant_category),
 			updated_at = now() 
 		WHERE 
-			id = $5
-		RETURNING id, email, password, first_name, last_name, document, balance, is_merchant, created_at, updated_at
+			id = $7
+		RETURNING id, email, password, first_name, last_name, document, document_type, balance, pending_balance, is_merchant, merchant_category, tier, created_at, updated_at
 	`
 
 	var updatedUser response.UserResponse
@@ -138,6 +154,8 @@ func (ur *userRepository) UpdateUserRepository(ctx context.Context, user domain.
 		user.GetLastName(),
 		user.GetBalance(),
 		user.GetIsMerchant(),
+		user.GetTier(),
+		user.GetMerchantCategory(),
 		id,
 	).Scan(
 		&updatedUser.ID,
@@ -146,8 +164,12 @@ func (ur *userRepository) UpdateUserRepository(ctx context.Context, user domain.
 		&updatedUser.FirstName,
 		&updatedUser.LastName,
 		&updatedUser.Document,
+		&updatedUser.DocumentType,
 		&updatedUser.Balance,
+		&updatedUser.PendingBalance,
 		&updatedUser.IsMerchant,
+		&updatedUser.MerchantCategory,
+		&updatedUser.Tier,
 		&updatedUser.CreatedAt,
 		&updatedUser.UpdatedAt,
 	)
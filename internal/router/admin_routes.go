@@ -0,0 +1,23 @@
+package router
+
+import (
+	"github.com/felipeversiane/picpay-golang.git/config/logger"
+	"github.com/felipeversiane/picpay-golang.git/internal/handler"
+	"github.com/felipeversiane/picpay-golang.git/internal/middleware"
+	"github.com/gin-gonic/gin"
+)
+
+// AdminRoutes registers the routes that change the behaviour of the API for
+// everyone, such as the log level and the fees charged to merchants, behind
+// the admin token.
+func AdminRoutes(r *gin.Engine, token string, feePlanHandler handler.FeePlanHandler) *gin.RouterGroup {
+	admin := r.Group("/admin", middleware.AdminAuth(token))
+	{
+		admin.GET("/log-level", gin.WrapH(logger.LevelHandler()))
+		admin.PUT("/log-level", gin.WrapH(logger.LevelHandler()))
+		admin.POST("/fee_plan", feePlanHandler.InsertFeePlanHandler)
+		admin.DELETE("/fee_plan/:id", feePlanHandler.DeleteFeePlanHandler)
+	}
+
+	return admin
+}
@@ -0,0 +1,16 @@
+package router
+
+import (
+	"github.com/felipeversiane/picpay-golang.git/internal/handler"
+	"github.com/gin-gonic/gin"
+)
+
+func AnticipationRoutes(r *gin.RouterGroup, handler handler.AnticipationHandler) *gin.RouterGroup {
+	user := r.Group("/user")
+	{
+		user.POST("/:id/anticipation/quote", handler.QuoteAnticipationHandler)
+		user.POST("/:id/anticipation", handler.AcceptAnticipationHandler)
+	}
+
+	return user
+}
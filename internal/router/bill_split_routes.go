@@ -0,0 +1,17 @@
+package router
+
+import (
+	"github.com/felipeversiane/picpay-golang.git/internal/handler"
+	"github.com/gin-gonic/gin"
+)
+
+func BillSplitRoutes(r *gin.RouterGroup, handler handler.BillSplitHandler) *gin.RouterGroup {
+	billSplit := r.Group("/bill_split")
+	{
+		billSplit.POST("/", handler.InsertBillSplitHandler)
+		billSplit.GET("/:id", handler.FindBillSplitByIDHandler)
+		billSplit.POST("/:id/remind", handler.RemindBillSplitHandler)
+	}
+
+	return billSplit
+}
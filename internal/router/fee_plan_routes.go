@@ -0,0 +1,18 @@
+package router
+
+import (
+	"github.com/felipeversiane/picpay-golang.git/internal/handler"
+	"github.com/gin-gonic/gin"
+)
+
+// FeePlanRoutes registers the public fee plan routes, which only read. Plans
+// are created and deleted through the admin routes.
+func FeePlanRoutes(r *gin.RouterGroup, handler handler.FeePlanHandler) *gin.RouterGroup {
+	feePlan := r.Group("/fee_plan")
+	{
+		feePlan.GET("/", handler.FindFeePlansHandler)
+		feePlan.GET("/:id", handler.FindFeePlanByIDHandler)
+	}
+
+	return feePlan
+}
@@ -0,0 +1,17 @@
+package router
+
+import (
+	"github.com/felipeversiane/picpay-golang.git/internal/handler"
+	"github.com/gin-gonic/gin"
+)
+
+func HealthRoutes(r *gin.Engine, handler handler.HealthHandler) *gin.RouterGroup {
+	health := r.Group("/health")
+	{
+		health.GET("", handler.LivenessHandler)
+		health.GET("/live", handler.LivenessHandler)
+		health.GET("/ready", handler.ReadinessHandler)
+	}
+
+	return health
+}
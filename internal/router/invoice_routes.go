@@ -0,0 +1,23 @@
+package router
+
+import (
+	"github.com/felipeversiane/picpay-golang.git/internal/handler"
+	"github.com/gin-gonic/gin"
+)
+
+func InvoiceRoutes(r *gin.RouterGroup, handler handler.InvoiceHandler) *gin.RouterGroup {
+	invoice := r.Group("/invoice")
+	{
+		invoice.POST("/", handler.InsertInvoiceHandler)
+		invoice.GET("/:id", handler.FindInvoiceByIDHandler)
+		invoice.PUT("/:id/pay", handler.PayInvoiceHandler)
+		invoice.PUT("/:id/cancel", handler.CancelInvoiceHandler)
+	}
+
+	user := r.Group("/user")
+	{
+		user.GET("/:id/invoices", handler.FindInvoicesByUserHandler)
+	}
+
+	return invoice
+}
@@ -0,0 +1,19 @@
+package router
+
+import (
+	"github.com/felipeversiane/picpay-golang.git/internal/handler"
+	"github.com/gin-gonic/gin"
+)
+
+func PaymentLinkRoutes(r *gin.RouterGroup, handler handler.PaymentLinkHandler) *gin.RouterGroup {
+	paymentLink := r.Group("/payment_link")
+	{
+		paymentLink.POST("/", handler.InsertPaymentLinkHandler)
+		paymentLink.GET("/:id", handler.FindPaymentLinkByIDHandler)
+		paymentLink.GET("/:id/payments", handler.FindPaymentLinkPaymentsHandler)
+		paymentLink.GET("/public/:slug", handler.ResolvePaymentLinkHandler)
+		paymentLink.POST("/public/:slug/pay", handler.PayPaymentLinkHandler)
+	}
+
+	return paymentLink
+}
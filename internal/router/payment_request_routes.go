@@ -0,0 +1,23 @@
+package router
+
+import (
+	"github.com/felipeversiane/picpay-golang.git/internal/handler"
+	"github.com/gin-gonic/gin"
+)
+
+func PaymentRequestRoutes(r *gin.RouterGroup, handler handler.PaymentRequestHandler) *gin.RouterGroup {
+	paymentRequest := r.Group("/payment_request")
+	{
+		paymentRequest.POST("/", handler.InsertPaymentRequestHandler)
+		paymentRequest.GET("/:id", handler.FindPaymentRequestByIDHandler)
+		paymentRequest.PUT("/:id/accept", handler.AcceptPaymentRequestHandler)
+		paymentRequest.PUT("/:id/decline", handler.DeclinePaymentRequestHandler)
+	}
+
+	user := r.Group("/user")
+	{
+		user.GET("/:id/payment_requests", handler.FindPendingPaymentRequestsHandler)
+	}
+
+	return paymentRequest
+}
@@ -0,0 +1,17 @@
+package router
+
+import (
+	"github.com/felipeversiane/picpay-golang.git/internal/handler"
+	"github.com/gin-gonic/gin"
+)
+
+func PayoutRoutes(r *gin.RouterGroup, handler handler.PayoutHandler) *gin.RouterGroup {
+	payout := r.Group("/payouts")
+	{
+		payout.POST("/batch", handler.InsertPayoutBatchHandler)
+		payout.GET("/batch/:id", handler.FindPayoutBatchByIDHandler)
+		payout.GET("/batch/:id/result", handler.FindPayoutBatchResultHandler)
+	}
+
+	return payout
+}
@@ -0,0 +1,26 @@
+package router
+
+import (
+	"github.com/felipeversiane/picpay-golang.git/internal/handler"
+	"github.com/gin-gonic/gin"
+)
+
+func PixKeyRoutes(r *gin.RouterGroup, handler handler.PixKeyHandler) *gin.RouterGroup {
+	pixKey := r.Group("/pix_key")
+	{
+		pixKey.POST("/", handler.InsertPixKeyHandler)
+		pixKey.GET("/lookup/:key", handler.LookupPixKeyHandler)
+		pixKey.PUT("/:id/verify", handler.VerifyPixKeyHandler)
+		pixKey.DELETE("/:id", handler.DeletePixKeyHandler)
+		pixKey.POST("/claim", handler.InsertPixKeyClaimHandler)
+		pixKey.PUT("/claim/:id/confirm", handler.ConfirmPixKeyClaimHandler)
+		pixKey.PUT("/claim/:id/cancel", handler.CancelPixKeyClaimHandler)
+	}
+
+	user := r.Group("/user")
+	{
+		user.GET("/:id/pix_keys", handler.FindPixKeysByUserHandler)
+	}
+
+	return pixKey
+}
@@ -0,0 +1,22 @@
+package router
+
+import (
+	"github.com/felipeversiane/picpay-golang.git/internal/handler"
+	"github.com/gin-gonic/gin"
+)
+
+func QRCodeRoutes(r *gin.RouterGroup, handler handler.QRCodeHandler) *gin.RouterGroup {
+	qrCode := r.Group("/qr_code")
+	{
+		qrCode.POST("/", handler.InsertQRCodeHandler)
+		qrCode.GET("/:id", handler.FindQRCodeByIDHandler)
+		qrCode.GET("/:id/image", handler.FindQRCodeImageHandler)
+	}
+
+	order := r.Group("/order")
+	{
+		order.POST("/qr", handler.PayQRCodeHandler)
+	}
+
+	return qrCode
+}
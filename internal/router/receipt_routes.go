@@ -0,0 +1,20 @@
+package router
+
+import (
+	"github.com/felipeversiane/picpay-golang.git/internal/handler"
+	"github.com/gin-gonic/gin"
+)
+
+func ReceiptRoutes(r *gin.RouterGroup, handler handler.ReceiptHandler) *gin.RouterGroup {
+	order := r.Group("/order")
+	{
+		order.GET("/:id/receipt", handler.FindReceiptHandler)
+	}
+
+	receipts := r.Group("/receipts")
+	{
+		receipts.POST("/verify", handler.VerifyReceiptHandler)
+	}
+
+	return receipts
+}
@@ -0,0 +1,20 @@
+package router
+
+import (
+	"github.com/felipeversiane/picpay-golang.git/internal/handler"
+	"github.com/gin-gonic/gin"
+)
+
+func RecurrenceRoutes(r *gin.RouterGroup, handler handler.RecurrenceHandler) *gin.RouterGroup {
+	recurrence := r.Group("/recurrence")
+	{
+		recurrence.POST("/", handler.InsertRecurrenceHandler)
+		recurrence.GET("/:id", handler.FindRecurrenceByIDHandler)
+		recurrence.GET("/:id/executions", handler.FindRecurrenceExecutionsHandler)
+		recurrence.PUT("/:id/pause", handler.PauseRecurrenceHandler)
+		recurrence.PUT("/:id/resume", handler.ResumeRecurrenceHandler)
+		recurrence.DELETE("/:id", handler.CancelRecurrenceHandler)
+	}
+
+	return recurrence
+}
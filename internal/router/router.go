@@ -1,26 +1,74 @@
 package router
 
 import (
-	"net/http"
+	"strings"
 
+	"github.com/felipeversiane/picpay-golang.git/config"
+	"github.com/felipeversiane/picpay-golang.git/config/tracing"
 	_ "github.com/felipeversiane/picpay-golang.git/docs"
+	"github.com/felipeversiane/picpay-golang.git/internal/handler"
+	"github.com/felipeversiane/picpay-golang.git/internal/metrics"
+	"github.com/felipeversiane/picpay-golang.git/internal/middleware"
 
 	"github.com/gin-gonic/gin"
 	swaggerFiles "github.com/swaggo/files"
 	swagger "github.com/swaggo/gin-swagger"
 )
 
-func InitRoutes(r *gin.Engine) {
+// Handlers holds one handler of each resource. It is built once by the
+// composition root in cmd/api.
+type Handlers struct {
+	User            handler.UserHandler
+	Order           handler.OrderHandler
+	OrderAttachment handler.OrderAttachmentHandler
+	Recurrence      handler.RecurrenceHandler
+	TransferLimit   handler.TransferLimitHandler
+	FeePlan         handler.FeePlanHandler
+	Settlement      handler.SettlementHandler
+	Anticipation    handler.AnticipationHandler
+	PixKey          handler.PixKeyHandler
+	QRCode          handler.QRCodeHandler
+	PaymentRequest  handler.PaymentRequestHandler
+	BillSplit       handler.BillSplitHandler
+	PaymentLink     handler.PaymentLinkHandler
+	Invoice         handler.InvoiceHandler
+	Payout          handler.PayoutHandler
+	Receipt         handler.ReceiptHandler
+	Health          handler.HealthHandler
+}
+
+func InitRoutes(r *gin.Engine, h Handlers, cfg config.Config) {
+	r.Use(tracing.Middleware())
+	r.Use(middleware.RequestID())
+	r.Use(middleware.AccessLog())
+	r.Use(metrics.Middleware())
+	r.Use(middleware.Timeout(cfg.Server))
+
 	v1 := r.Group("/api/v1")
 	{
-		UserRoutes(v1)
-		OrderRoutes(v1)
+		UserRoutes(v1, h.User)
+		OrderRoutes(v1, h.Order, h.OrderAttachment)
+		RecurrenceRoutes(v1, h.Recurrence)
+		TransferLimitRoutes(v1, h.TransferLimit)
+		FeePlanRoutes(v1, h.FeePlan)
+		SettlementRoutes(v1, h.Settlement)
+		AnticipationRoutes(v1, h.Anticipation)
+		PixKeyRoutes(v1, h.PixKey)
+		QRCodeRoutes(v1, h.QRCode)
+		PaymentRequestRoutes(v1, h.PaymentRequest)
+		BillSplitRoutes(v1, h.BillSplit)
+		PaymentLinkRoutes(v1, h.PaymentLink)
+		InvoiceRoutes(v1, h.Invoice)
+		PayoutRoutes(v1, h.Payout)
+		ReceiptRoutes(v1, h.Receipt)
 
 	}
 
-	r.GET("/health", func(c *gin.Context) {
-		c.Status(http.StatusOK)
-	})
+	HealthRoutes(r, h.Health)
+	if strings.TrimSpace(cfg.Admin.Token) != "" {
+		AdminRoutes(r, cfg.Admin.Token, h.FeePlan)
+	}
+	r.GET("/metrics", gin.WrapH(metrics.Handler()))
 	r.GET("/docs/*any", swagger.WrapHandler(swaggerFiles.Handler))
 
 }
@@ -0,0 +1,16 @@
+package router
+
+import (
+	"github.com/felipeversiane/picpay-golang.git/internal/handler"
+	"github.com/gin-gonic/gin"
+)
+
+func SettlementRoutes(r *gin.RouterGroup, handler handler.SettlementHandler) *gin.RouterGroup {
+	user := r.Group("/user")
+	{
+		user.GET("/:id/settlements", handler.FindPendingSettlementsHandler)
+		user.GET("/:id/settlements/schedule", handler.FindSettlementScheduleHandler)
+	}
+
+	return user
+}
@@ -0,0 +1,15 @@
+package router
+
+import (
+	"github.com/felipeversiane/picpay-golang.git/internal/handler"
+	"github.com/gin-gonic/gin"
+)
+
+func TransferLimitRoutes(r *gin.RouterGroup, handler handler.TransferLimitHandler) *gin.RouterGroup {
+	user := r.Group("/user")
+	{
+		user.GET("/:id/limits", handler.FindTransferLimitsHandler)
+	}
+
+	return user
+}
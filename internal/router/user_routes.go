@@ -1,18 +1,11 @@
 package router
 
 import (
-	"github.com/felipeversiane/picpay-golang.git/config/db"
 	"github.com/felipeversiane/picpay-golang.git/internal/handler"
-	"github.com/felipeversiane/picpay-golang.git/internal/repository"
-	"github.com/felipeversiane/picpay-golang.git/internal/service"
 	"github.com/gin-gonic/gin"
 )
 
-func UserRoutes(r *gin.RouterGroup) *gin.RouterGroup {
-	repo := repository.NewUserRepository(db.Conn)
-	service := service.NewUserService(repo)
-	handler := handler.NewUserHandler(service)
-
+func UserRoutes(r *gin.RouterGroup, handler handler.UserHandler) *gin.RouterGroup {
 	user := r.Group("/user")
 	{
 		user.POST("/", handler.InsertUserHandler)
@@ -0,0 +1,176 @@
+package schedule
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Schedule is a parsed five field cron expression
+// (minute, hour, day of month, month, day of week).
+type Schedule struct {
+	minute     map[int]bool
+	hour       map[int]bool
+	dayOfMonth map[int]bool
+	month      map[int]bool
+	dayOfWeek  map[int]bool
+	anyDom     bool
+	anyDow     bool
+}
+
+var macros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+type bounds struct {
+	min, max int
+}
+
+var (
+	minuteBounds     = bounds{0, 59}
+	hourBounds       = bounds{0, 23}
+	dayOfMonthBounds = bounds{1, 31}
+	monthBounds      = bounds{1, 12}
+	dayOfWeekBounds  = bounds{0, 7}
+)
+
+// Parse parses a cron expression such as "0 9 * * 1" or one of the
+// @yearly, @monthly, @weekly, @daily and @hourly macros.
+func Parse(expression string) (*Schedule, error) {
+	expression = strings.TrimSpace(expression)
+	if macro, ok := macros[strings.ToLower(expression)]; ok {
+		expression = macro
+	}
+
+	fields := strings.Fields(expression)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("expected 5 fields, found %d", len(fields))
+	}
+
+	minute, err := parseField(fields[0], minuteBounds)
+	if err != nil {
+		return nil, fmt.Errorf("minute: %w", err)
+	}
+	hour, err := parseField(fields[1], hourBounds)
+	if err != nil {
+		return nil, fmt.Errorf("hour: %w", err)
+	}
+	dayOfMonth, err := parseField(fields[2], dayOfMonthBounds)
+	if err != nil {
+		return nil, fmt.Errorf("day of month: %w", err)
+	}
+	month, err := parseField(fields[3], monthBounds)
+	if err != nil {
+		return nil, fmt.Errorf("month: %w", err)
+	}
+	dayOfWeek, err := parseField(fields[4], dayOfWeekBounds)
+	if err != nil {
+		return nil, fmt.Errorf("day of week: %w", err)
+	}
+	if dayOfWeek[7] {
+		dayOfWeek[0] = true
+	}
+
+	return &Schedule{
+		minute:     minute,
+		hour:       hour,
+		dayOfMonth: dayOfMonth,
+		month:      month,
+		dayOfWeek:  dayOfWeek,
+		anyDom:     fields[2] == "*",
+		anyDow:     fields[4] == "*",
+	}, nil
+}
+
+// Next returns the first activation strictly after t, or the zero time
+// when the expression never matches within the next five years.
+func (s *Schedule) Next(t time.Time) time.Time {
+	loc := t.Location()
+	t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), 0, 0, loc).Add(time.Minute)
+	limit := t.AddDate(5, 0, 0)
+
+	for t.Before(limit) {
+		if !s.month[int(t.Month())] {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, loc)
+			continue
+		}
+		if !s.matchesDay(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc)
+			continue
+		}
+		if !s.hour[t.Hour()] {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, loc)
+			continue
+		}
+		if !s.minute[t.Minute()] {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t
+	}
+
+	return time.Time{}
+}
+
+func (s *Schedule) matchesDay(t time.Time) bool {
+	dom := s.dayOfMonth[t.Day()]
+	dow := s.dayOfWeek[int(t.Weekday())]
+	if s.anyDom || s.anyDow {
+		return dom && dow
+	}
+	return dom || dow
+}
+
+func parseField(field string, b bounds) (map[int]bool, error) {
+	values := map[int]bool{}
+	for _, part := range strings.Split(field, ",") {
+		if err := parsePart(part, b, values); err != nil {
+			return nil, err
+		}
+	}
+	return values, nil
+}
+
+func parsePart(part string, b bounds, values map[int]bool) error {
+	step := 1
+	if rangePart, stepPart, ok := strings.Cut(part, "/"); ok {
+		var err error
+		if step, err = strconv.Atoi(stepPart); err != nil || step <= 0 {
+			return fmt.Errorf("invalid step %q", stepPart)
+		}
+		part = rangePart
+	}
+
+	start, end := b.min, b.max
+	if part != "*" {
+		from, to, isRange := strings.Cut(part, "-")
+		var err error
+		if start, err = strconv.Atoi(from); err != nil {
+			return fmt.Errorf("invalid value %q", from)
+		}
+		end = start
+		if isRange {
+			if end, err = strconv.Atoi(to); err != nil {
+				return fmt.Errorf("invalid value %q", to)
+			}
+		} else if step > 1 {
+			end = b.max
+		}
+	}
+
+	if start < b.min || end > b.max || start > end {
+		return fmt.Errorf("value out of range %d-%d", b.min, b.max)
+	}
+
+	for v := start; v <= end; v += step {
+		values[v] = true
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+package schedule
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParse_ShouldRejectInvalidExpressions(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression string
+	}{
+		{"empty", ""},
+		{"too few fields", "0 9 * *"},
+		{"too many fields", "0 9 * * * *"},
+		{"unknown macro", "@fortnightly"},
+		{"minute out of range", "60 * * * *"},
+		{"hour out of range", "0 24 * * *"},
+		{"day of month zero", "0 0 0 * *"},
+		{"month out of range", "0 0 1 13 *"},
+		{"day of week out of range", "0 0 * * 8"},
+		{"reversed range", "0 0 * * 5-1"},
+		{"zero step", "*/0 * * * *"},
+		{"invalid step", "*/x * * * *"},
+		{"not a number", "a * * * *"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Parse(tt.expression); err == nil {
+				t.Errorf("Expected %q to be rejected", tt.expression)
+			}
+		})
+	}
+}
+
+func TestSchedule_Next(t *testing.T) {
+	// 2024-01-15 is a Monday.
+	from := time.Date(2024, time.January, 15, 10, 30, 45, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		expression string
+		expected   time.Time
+	}{
+		{"every minute", "* * * * *", time.Date(2024, 1, 15, 10, 31, 0, 0, time.UTC)},
+		{"strictly after", "30 10 * * *", time.Date(2024, 1, 16, 10, 30, 0, 0, time.UTC)},
+		{"later today", "0 18 * * *", time.Date(2024, 1, 15, 18, 0, 0, 0, time.UTC)},
+		{"step", "*/20 * * * *", time.Date(2024, 1, 15, 10, 40, 0, 0, time.UTC)},
+		{"list", "5,50 * * * *", time.Date(2024, 1, 15, 10, 50, 0, 0, time.UTC)},
+		{"range with step", "0 8-18/4 * * *", time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC)},
+		{"day of week", "0 9 * * 5", time.Date(2024, 1, 19, 9, 0, 0, 0, time.UTC)},
+		{"sunday as 7", "0 9 * * 7", time.Date(2024, 1, 21, 9, 0, 0, 0, time.UTC)},
+		{"day of month", "0 0 1 * *", time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)},
+		{"day of month or day of week", "0 0 20 * 3", time.Date(2024, 1, 17, 0, 0, 0, 0, time.UTC)},
+		{"leap day", "0 0 29 2 *", time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)},
+		{"month rollover", "0 0 1 3 *", time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{"yearly macro", "@yearly", time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"weekly macro", "@weekly", time.Date(2024, 1, 21, 0, 0, 0, 0, time.UTC)},
+		{"hourly macro", "@HOURLY", time.Date(2024, 1, 15, 11, 0, 0, 0, time.UTC)},
+		{"never", "0 0 30 2 *", time.Time{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sched, err := Parse(tt.expression)
+			if err != nil {
+				t.Fatalf("Unexpected error parsing %q: %v", tt.expression, err)
+			}
+			if got := sched.Next(from); !got.Equal(tt.expected) {
+				t.Errorf("Expected %s and received %s", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestSchedule_Next_ShouldKeepTheLocation(t *testing.T) {
+	loc := time.FixedZone("BRT", -3*60*60)
+	sched, err := Parse("0 9 * * *")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := sched.Next(time.Date(2024, 1, 15, 10, 0, 0, 0, loc))
+	expected := time.Date(2024, 1, 16, 9, 0, 0, 0, loc)
+	if !got.Equal(expected) || got.Location() != loc {
+		t.Errorf("Expected %s and received %s", expected, got)
+	}
+}
@@ -0,0 +1,135 @@
+package service
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/felipeversiane/picpay-golang.git/config/http_error"
+	"github.com/felipeversiane/picpay-golang.git/config/logger"
+	"github.com/felipeversiane/picpay-golang.git/internal/calendar"
+	"github.com/felipeversiane/picpay-golang.git/internal/entity/response"
+	"github.com/felipeversiane/picpay-golang.git/internal/repository"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type anticipationService struct {
+	anticipationRepository repository.AnticipationRepository
+	userService            UserService
+	monthlyRate            float64
+}
+
+// NewAnticipationService charges monthlyRate percent per 30 days left until
+// each settlement is due.
+func NewAnticipationService(
+	anticipationRepository repository.AnticipationRepository,
+	userService UserService,
+	monthlyRate float64,
+) AnticipationService {
+	return &anticipationService{
+		anticipationRepository,
+		userService,
+		monthlyRate,
+	}
+}
+
+type AnticipationService interface {
+	QuoteAnticipationService(ctx context.Context, merchantID uuid.UUID, settlementIDs []uuid.UUID) (response.AnticipationQuoteResponse, *http_error.HttpError)
+	AcceptAnticipationService(ctx context.Context, merchantID uuid.UUID, settlementIDs []uuid.UUID) (response.AnticipationResponse, *http_error.HttpError)
+}
+
+func (as *anticipationService) QuoteAnticipationService(ctx context.Context, merchantID uuid.UUID, settlementIDs []uuid.UUID) (response.AnticipationQuoteResponse, *http_error.HttpError) {
+	if _, err := as.userService.FindUserByIDService(ctx, merchantID); err != nil {
+		return response.AnticipationQuoteResponse{}, err
+	}
+
+	settlementIDs = uniqueIDs(settlementIDs)
+	settlements, err := as.anticipationRepository.FindAnticipableSettlementsRepository(ctx, merchantID, settlementIDs)
+	if err != nil {
+		logger.FromContext(ctx).Error("Error trying to call repository",
+			err,
+			zap.String("journey", "QuoteAnticipation"))
+		return response.AnticipationQuoteResponse{}, err
+	}
+
+	return quoteAnticipation(merchantID, settlementIDs, settlements, as.monthlyRate, time.Now())
+}
+
+func (as *anticipationService) AcceptAnticipationService(ctx context.Context, merchantID uuid.UUID, settlementIDs []uuid.UUID) (response.AnticipationResponse, *http_error.HttpError) {
+	if _, err := as.userService.FindUserByIDService(ctx, merchantID); err != nil {
+		return response.AnticipationResponse{}, err
+	}
+
+	settlementIDs = uniqueIDs(settlementIDs)
+	rate := as.monthlyRate
+	now := time.Now()
+
+	result, err := as.anticipationRepository.AcceptAnticipationRepository(ctx, merchantID, settlementIDs,
+		func(settlements []response.SettlementResponse) (response.AnticipationQuoteResponse, *http_error.HttpError) {
+			return quoteAnticipation(merchantID, settlementIDs, settlements, rate, now)
+		})
+	if err != nil {
+		logger.FromContext(ctx).Error("Error trying to call repository",
+			err,
+			zap.String("journey", "AcceptAnticipation"))
+		return response.AnticipationResponse{}, err
+	}
+	return result, nil
+}
+
+// quoteAnticipation discounts every settlement pro rata by the calendar days
+// left until its scheduled date, using a 30-day month.
+func quoteAnticipation(
+	merchantID uuid.UUID,
+	settlementIDs []uuid.UUID,
+	settlements []response.SettlementResponse,
+	rate float64,
+	now time.Time,
+) (response.AnticipationQuoteResponse, *http_error.HttpError) {
+	if len(settlements) != len(settlementIDs) {
+		return response.AnticipationQuoteResponse{}, http_error.NewBadRequestError("Some settlements are not pending or do not belong to the merchant")
+	}
+
+	local := now.In(calendar.Location)
+	today := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, time.UTC)
+
+	quote := response.AnticipationQuoteResponse{
+		MerchantID:  merchantID,
+		MonthlyRate: rate,
+		Settlements: []response.AnticipationItemResponse{},
+	}
+	for _, settlement := range settlements {
+		scheduled := time.Date(settlement.ScheduledFor.Year(), settlement.ScheduledFor.Month(), settlement.ScheduledFor.Day(), 0, 0, 0, 0, time.UTC)
+		days := max(0, int(scheduled.Sub(today).Hours()/24))
+		fee := math.Round(settlement.Amount*rate/100*float64(days)/30*100) / 100
+
+		quote.Settlements = append(quote.Settlements, response.AnticipationItemResponse{
+			SettlementID: settlement.ID,
+			ScheduledFor: settlement.ScheduledFor,
+			Days:         days,
+			Amount:       settlement.Amount,
+			Fee:          fee,
+		})
+		quote.GrossAmount += settlement.Amount
+		quote.Fee += fee
+	}
+
+	quote.GrossAmount = math.Round(quote.GrossAmount*100) / 100
+	quote.Fee = math.Round(quote.Fee*100) / 100
+	quote.NetAmount = math.Round((quote.GrossAmount-quote.Fee)*100) / 100
+
+	return quote, nil
+}
+
+func uniqueIDs(ids []uuid.UUID) []uuid.UUID {
+	seen := map[uuid.UUID]bool{}
+	unique := []uuid.UUID{}
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+	return unique
+}
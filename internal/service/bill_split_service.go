@@ -0,0 +1,179 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/felipeversiane/picpay-golang.git/config/http_error"
+	"github.com/felipeversiane/picpay-golang.git/config/logger"
+	domain "github.com/felipeversiane/picpay-golang.git/internal"
+	"github.com/felipeversiane/picpay-golang.git/internal/entity/response"
+	"github.com/felipeversiane/picpay-golang.git/internal/notification"
+	"github.com/felipeversiane/picpay-golang.git/internal/repository"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type billSplitService struct {
+	billSplitRepository repository.BillSplitRepository
+	userService         UserService
+	notifier            notification.Notifier
+}
+
+func NewBillSplitService(
+	billSplitRepository repository.BillSplitRepository,
+	userService UserService,
+	notifier notification.Notifier,
+) BillSplitService {
+	return &billSplitService{
+		billSplitRepository,
+		userService,
+		notifier,
+	}
+}
+
+type BillSplitService interface {
+	InsertBillSplitService(ctx context.Context, billSplit domain.BillSplitDomainInterface) (response.BillSplitResponse, *http_error.HttpError)
+	FindBillSplitByIDService(ctx context.Context, id uuid.UUID) (response.BillSplitResponse, *http_error.HttpError)
+	RemindBillSplitService(ctx context.Context, id uuid.UUID) (response.BillSplitReminderResponse, *http_error.HttpError)
+}
+
+// InsertBillSplitService creates one payment request from the owner to every
+// participant. In equal splits the owner keeps one of the shares; in custom
+// splits the owner covers whatever the participants' shares leave out.
+func (bs *billSplitService) InsertBillSplitService(ctx context.Context, billSplit domain.BillSplitDomainInterface) (response.BillSplitResponse, *http_error.HttpError) {
+	if _, err := bs.userService.FindUserByIDService(ctx, billSplit.GetOwner()); err != nil {
+		return response.BillSplitResponse{}, http_error.NewBadRequestError("Owner not found")
+	}
+
+	seen := map[uuid.UUID]bool{}
+	for _, share := range billSplit.GetShares() {
+		if share.Payer == billSplit.GetOwner() {
+			return response.BillSplitResponse{}, http_error.NewBadRequestError("The owner cannot be a participant")
+		}
+		if seen[share.Payer] {
+			return response.BillSplitResponse{}, http_error.NewBadRequestError("Participants must be unique")
+		}
+		seen[share.Payer] = true
+
+		participant, err := bs.userService.FindUserByIDService(ctx, share.Payer)
+		if err != nil {
+			return response.BillSplitResponse{}, http_error.NewBadRequestError("Participant not found: " + share.Payer.String())
+		}
+		if participant.IsMerchant {
+			return response.BillSplitResponse{}, http_error.NewBadRequestError("Merchants cannot send money")
+		}
+	}
+
+	shares, err := splitShares(billSplit.GetTotalAmount(), billSplit.GetMode(), billSplit.GetShares())
+	if err != nil {
+		return response.BillSplitResponse{}, err
+	}
+	billSplit.SetShares(shares)
+
+	paymentRequests := make([]domain.PaymentRequestDomainInterface, 0, len(shares))
+	for _, share := range shares {
+		paymentRequest := domain.NewPaymentRequestDomain(billSplit.GetOwner(), share.Payer, share.Amount, billSplit.GetDescription(), billSplit.GetExpiresAt())
+		paymentRequest.SetSplitID(billSplit.GetID())
+		paymentRequests = append(paymentRequests, paymentRequest)
+	}
+
+	if err := bs.billSplitRepository.InsertBillSplitRepository(ctx, billSplit, paymentRequests); err != nil {
+		logger.FromContext(ctx).Error("Error trying to call repository",
+			err,
+			zap.String("journey", "InsertBillSplit"))
+		return response.BillSplitResponse{}, err
+	}
+
+	return bs.FindBillSplitByIDService(ctx, billSplit.GetID())
+}
+
+func (bs *billSplitService) FindBillSplitByIDService(ctx context.Context, id uuid.UUID) (response.BillSplitResponse, *http_error.HttpError) {
+	result, err := bs.billSplitRepository.FindBillSplitByIDRepository(ctx, id)
+	if err != nil {
+		logger.FromContext(ctx).Error("Error trying to call repository",
+			err,
+			zap.String("journey", "FindBillSplitByID"))
+		return response.BillSplitResponse{}, err
+	}
+	return result, nil
+}
+
+// RemindBillSplitService notifies every participant whose share is still
+// pending.
+func (bs *billSplitService) RemindBillSplitService(ctx context.Context, id uuid.UUID) (response.BillSplitReminderResponse, *http_error.HttpError) {
+	billSplit, err := bs.FindBillSplitByIDService(ctx, id)
+	if err != nil {
+		return response.BillSplitReminderResponse{}, err
+	}
+	if billSplit.Status == domain.BillSplitStatusSettled {
+		return response.BillSplitReminderResponse{}, http_error.NewBadRequestError("Bill split is already settled")
+	}
+
+	owner, err := bs.userService.FindUserByIDService(ctx, billSplit.Owner)
+	if err != nil {
+		return response.BillSplitReminderResponse{}, err
+	}
+
+	now := time.Now()
+	result := response.BillSplitReminderResponse{}
+	for _, share := range billSplit.Shares {
+		if share.Status != domain.PaymentRequestStatusPending || !now.Before(share.ExpiresAt) {
+			continue
+		}
+
+		participant, err := bs.userService.FindUserByIDService(ctx, share.Payer)
+		if err != nil {
+			logger.FromContext(ctx).Error("Error trying to find participant", err, zap.String("journey", "RemindBillSplit"))
+			continue
+		}
+
+		message := fmt.Sprintf("%s %s is waiting for your share of R$ %.2f in %q. Accept payment request %s to pay it",
+			owner.FirstName, owner.LastName, share.Amount, billSplit.Description, share.ID)
+		if err := bs.notifier.Send(ctx, notification.KindBillSplitReminder, participant.Email, message); err != nil {
+			logger.FromContext(ctx).Error("Error trying to send notification", err, zap.String("journey", "RemindBillSplit"))
+			continue
+		}
+		result.Reminded++
+	}
+
+	return result, nil
+}
+
+func splitShares(total float64, mode string, shares []domain.BillSplitShare) ([]domain.BillSplitShare, *http_error.HttpError) {
+	totalCents := int64(math.Round(total * 100))
+
+	if mode == domain.BillSplitModeEqual {
+		parts := int64(len(shares) + 1)
+		base, remainder := totalCents/parts, totalCents%parts
+		if base == 0 {
+			return nil, http_error.NewBadRequestError("Total amount is too small to be split")
+		}
+
+		result := make([]domain.BillSplitShare, len(shares))
+		for i, share := range shares {
+			cents := base
+			if int64(i) < remainder {
+				cents++
+			}
+			result[i] = domain.BillSplitShare{Payer: share.Payer, Amount: float64(cents) / 100}
+		}
+		return result, nil
+	}
+
+	var sum int64
+	for _, share := range shares {
+		cents := int64(math.Round(share.Amount * 100))
+		if cents <= 0 {
+			return nil, http_error.NewBadRequestError("Every participant needs an amount in custom splits")
+		}
+		sum += cents
+	}
+	if sum > totalCents {
+		return nil, http_error.NewBadRequestError("The shares exceed the total amount")
+	}
+
+	return shares, nil
+}
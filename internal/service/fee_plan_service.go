@@ -0,0 +1,119 @@
+package service
+
+import (
+	"context"
+	"math"
+	"net/http"
+
+	"github.com/felipeversiane/picpay-golang.git/config/http_error"
+	"github.com/felipeversiane/picpay-golang.git/config/logger"
+	domain "github.com/felipeversiane/picpay-golang.git/internal"
+	"github.com/felipeversiane/picpay-golang.git/internal/entity/response"
+	"github.com/felipeversiane/picpay-golang.git/internal/repository"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type feePlanService struct {
+	feePlanRepository repository.FeePlanRepository
+	userService       UserService
+}
+
+func NewFeePlanService(
+	feePlanRepository repository.FeePlanRepository,
+	userService UserService,
+) FeePlanService {
+	return &feePlanService{
+		feePlanRepository, userService,
+	}
+}
+
+type FeePlanService interface {
+	InsertFeePlanService(ctx context.Context, feePlan domain.FeePlanDomainInterface) (response.FeePlanResponse, *http_error.HttpError)
+	FindFeePlanByIDService(ctx context.Context, id uuid.UUID) (response.FeePlanResponse, *http_error.HttpError)
+	FindFeePlansService(ctx context.Context) ([]response.FeePlanResponse, *http_error.HttpError)
+	DeleteFeePlanService(ctx context.Context, id uuid.UUID) *http_error.HttpError
+	CalculateFeeService(ctx context.Context, merchant response.UserResponse, amount float64) (float64, *response.FeePlanResponse, *http_error.HttpError)
+}
+
+func (fs *feePlanService) InsertFeePlanService(ctx context.Context, feePlan domain.FeePlanDomainInterface) (response.FeePlanResponse, *http_error.HttpError) {
+	if feePlan.GetMerchantID() != nil {
+		merchant, err := fs.userService.FindUserByIDService(ctx, *feePlan.GetMerchantID())
+		if err != nil {
+			return response.FeePlanResponse{}, http_error.NewBadRequestError("Merchant not found")
+		}
+		if !merchant.IsMerchant {
+			return response.FeePlanResponse{}, http_error.NewBadRequestError("Fee plans can only be assigned to merchants")
+		}
+	}
+
+	result, err := fs.feePlanRepository.InsertFeePlanRepository(ctx, feePlan)
+	if err != nil {
+		logger.FromContext(ctx).Error("Error trying to call repository",
+			err,
+			zap.String("journey", "InsertFeePlan"))
+		return response.FeePlanResponse{}, err
+	}
+	return result, nil
+}
+
+func (fs *feePlanService) FindFeePlanByIDService(ctx context.Context, id uuid.UUID) (response.FeePlanResponse, *http_error.HttpError) {
+	result, err := fs.feePlanRepository.FindFeePlanByIDRepository(ctx, id)
+	if err != nil {
+		logger.FromContext(ctx).Error("Error trying to call repository",
+			err,
+			zap.String("journey", "FindFeePlanByID"))
+		return response.FeePlanResponse{}, err
+	}
+	return result, nil
+}
+
+func (fs *feePlanService) FindFeePlansService(ctx context.Context) ([]response.FeePlanResponse, *http_error.HttpError) {
+	result, err := fs.feePlanRepository.FindFeePlansRepository(ctx)
+	if err != nil {
+		logger.FromContext(ctx).Error("Error trying to call repository",
+			err,
+			zap.String("journey", "FindFeePlans"))
+		return nil, err
+	}
+	return result, nil
+}
+
+func (fs *feePlanService) DeleteFeePlanService(ctx context.Context, id uuid.UUID) *http_error.HttpError {
+	if _, err := fs.FindFeePlanByIDService(ctx, id); err != nil {
+		return err
+	}
+
+	err := fs.feePlanRepository.DeleteFeePlanRepository(ctx, id)
+	if err != nil {
+		logger.FromContext(ctx).Error("Error trying to call repository",
+			err,
+			zap.String("journey", "DeleteFeePlan"))
+		return err
+	}
+	return nil
+}
+
+func (fs *feePlanService) CalculateFeeService(ctx context.Context, merchant response.UserResponse, amount float64) (float64, *response.FeePlanResponse, *http_error.HttpError) {
+	if !merchant.IsMerchant {
+		return 0, nil, nil
+	}
+
+	feePlan, err := fs.feePlanRepository.FindApplicableFeePlanRepository(ctx, merchant.ID, merchant.MerchantCategory)
+	if err != nil {
+		if err.Code == http.StatusNotFound {
+			return 0, nil, nil
+		}
+		logger.FromContext(ctx).Error("Error trying to call repository",
+			err,
+			zap.String("journey", "CalculateFee"))
+		return 0, nil, err
+	}
+
+	fee := math.Round((amount*feePlan.Percentage/100+feePlan.FixedFee)*100) / 100
+	if fee > amount {
+		fee = amount
+	}
+
+	return fee, &feePlan, nil
+}
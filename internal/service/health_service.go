@@ -0,0 +1,180 @@
+package service
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/felipeversiane/picpay-golang.git/config/logger"
+	"github.com/felipeversiane/picpay-golang.git/internal/entity/response"
+	"github.com/felipeversiane/picpay-golang.git/internal/repository"
+	"go.uber.org/zap"
+)
+
+const (
+	HealthStatusUp   = "up"
+	HealthStatusDown = "down"
+
+	// ExpectedMigrationVersion is the version of the last file in
+	// migrations/. Bump it together with every new migration; a test fails
+	// when it does not match the files.
+	ExpectedMigrationVersion uint = 21
+
+	healthCheckTimeout = 2 * time.Second
+)
+
+type healthService struct {
+	healthRepository repository.HealthRepository
+	httpClient       *http.Client
+	authorizationURL string
+	shuttingDown     atomic.Bool
+}
+
+func NewHealthService(
+	healthRepository repository.HealthRepository,
+	authorizationURL string,
+) HealthService {
+	return &healthService{
+		healthRepository: healthRepository,
+		authorizationURL: authorizationURL,
+		httpClient: &http.Client{
+			Transport: &http.Transport{
+				TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+			},
+		},
+	}
+}
+
+type HealthService interface {
+	LivenessService() response.HealthResponse
+	ReadinessService(ctx context.Context) response.HealthResponse
+	MarkShuttingDown()
+}
+
+type healthCheck struct {
+	name  string
+	check func(ctx context.Context) error
+}
+
+func (hs *healthService) LivenessService() response.HealthResponse {
+	return response.HealthResponse{Status: HealthStatusUp}
+}
+
+// ReadinessService checks every dependency concurrently, each bounded by its
+// own timeout. The service is ready only when all of them are up and it is
+// not shutting down.
+func (hs *healthService) ReadinessService(ctx context.Context) response.HealthResponse {
+	if hs.shuttingDown.Load() {
+		return response.HealthResponse{
+			Status: HealthStatusDown,
+			Components: map[string]response.ComponentHealth{
+				"server": {Status: HealthStatusDown, Error: "shutting down"},
+			},
+		}
+	}
+
+	checks := []healthCheck{
+		{"database", hs.checkDatabase},
+		{"migrations", hs.checkMigrations},
+		{"authorizer", hs.checkAuthorizer},
+	}
+
+	result := response.HealthResponse{
+		Status:     HealthStatusUp,
+		Components: make(map[string]response.ComponentHealth, len(checks)),
+	}
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for _, hc := range checks {
+		wg.Add(1)
+		go func(hc healthCheck) {
+			defer wg.Done()
+			component := runHealthCheck(ctx, hc)
+
+			mu.Lock()
+			defer mu.Unlock()
+			result.Components[hc.name] = component
+			if component.Status != HealthStatusUp {
+				result.Status = HealthStatusDown
+			}
+		}(hc)
+	}
+	wg.Wait()
+
+	return result
+}
+
+func (hs *healthService) MarkShuttingDown() {
+	hs.shuttingDown.Store(true)
+}
+
+func runHealthCheck(ctx context.Context, hc healthCheck) response.ComponentHealth {
+	ctx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+	defer cancel()
+
+	start := time.Now()
+	err := hc.check(ctx)
+	component := response.ComponentHealth{
+		Status:    HealthStatusUp,
+		LatencyMs: float64(time.Since(start).Microseconds()) / 1000,
+	}
+	if err != nil {
+		logger.FromContext(ctx).Warn("Health check failed",
+			zap.String("component", hc.name),
+			zap.String("error", err.Error()),
+			zap.String("journey", "Readiness"))
+		component.Status = HealthStatusDown
+		component.Error = err.Error()
+	}
+	return component
+}
+
+func (hs *healthService) checkDatabase(ctx context.Context) error {
+	if err := hs.healthRepository.PingRepository(ctx); err != nil {
+		return errors.New(err.Message)
+	}
+	return nil
+}
+
+// checkMigrations fails when the schema is behind the code. A schema ahead
+// of the code is accepted, since migrations run before a rolling deploy
+// replaces the instances still on the previous version.
+func (hs *healthService) checkMigrations(ctx context.Context) error {
+	version, dirty, err := hs.healthRepository.FindMigrationVersionRepository(ctx)
+	if err != nil {
+		return errors.New(err.Message)
+	}
+	if dirty {
+		return fmt.Errorf("migration %d is dirty", version)
+	}
+	if version < ExpectedMigrationVersion {
+		return fmt.Errorf("migration version is %d, expected at least %d", version, ExpectedMigrationVersion)
+	}
+	return nil
+}
+
+// checkAuthorizer only verifies that the authorizer answers. A denial is
+// still an answer, so any status below 500 counts as up.
+func (hs *healthService) checkAuthorizer(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, hs.authorizationURL, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := hs.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusInternalServerError {
+		return fmt.Errorf("authorizer returned status %d", resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,36 @@
+package service
+
+import (
+	"os"
+	"regexp"
+	"strconv"
+	"testing"
+)
+
+var migrationFile = regexp.MustCompile(`^(\d+)_.+\.up\.sql$`)
+
+func TestExpectedMigrationVersion_MatchesTheLastMigration(t *testing.T) {
+	entries, err := os.ReadDir("../../migrations")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	var last uint
+	for _, entry := range entries {
+		match := migrationFile.FindStringSubmatch(entry.Name())
+		if match == nil {
+			continue
+		}
+		version, err := strconv.ParseUint(match[1], 10, 64)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		if uint(version) > last {
+			last = uint(version)
+		}
+	}
+
+	if ExpectedMigrationVersion != last {
+		t.Errorf("ExpectedMigrationVersion: expected %d and received %d", last, ExpectedMigrationVersion)
+	}
+}
@@ -0,0 +1,204 @@
+package service
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/felipeversiane/picpay-golang.git/config/http_error"
+	"github.com/felipeversiane/picpay-golang.git/config/logger"
+	domain "github.com/felipeversiane/picpay-golang.git/internal"
+	"github.com/felipeversiane/picpay-golang.git/internal/calendar"
+	"github.com/felipeversiane/picpay-golang.git/internal/entity/response"
+	"github.com/felipeversiane/picpay-golang.git/internal/repository"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type invoiceService struct {
+	invoiceRepository repository.InvoiceRepository
+	userService       UserService
+	orderService      OrderService
+}
+
+func NewInvoiceService(
+	invoiceRepository repository.InvoiceRepository,
+	userService UserService,
+	orderService OrderService,
+) InvoiceService {
+	return &invoiceService{
+		invoiceRepository,
+		userService,
+		orderService,
+	}
+}
+
+type InvoiceService interface {
+	InsertInvoiceService(ctx context.Context, invoice domain.InvoiceDomainInterface) (response.InvoiceResponse, *http_error.HttpError)
+	FindInvoiceByIDService(ctx context.Context, id uuid.UUID) (response.InvoiceResponse, *http_error.HttpError)
+	FindInvoicesByUserService(ctx context.Context, userID uuid.UUID) ([]response.InvoiceResponse, *http_error.HttpError)
+	PayInvoiceService(ctx context.Context, id uuid.UUID) (response.InvoiceResponse, *http_error.HttpError)
+	CancelInvoiceService(ctx context.Context, id uuid.UUID) (response.InvoiceResponse, *http_error.HttpError)
+	MarkOverdueInvoicesService(ctx context.Context) *http_error.HttpError
+}
+
+func (is *invoiceService) InsertInvoiceService(ctx context.Context, invoice domain.InvoiceDomainInterface) (response.InvoiceResponse, *http_error.HttpError) {
+	if invoice.GetMerchantID() == invoice.GetCustomerID() {
+		return response.InvoiceResponse{}, http_error.NewBadRequestError("Merchant and customer must be different")
+	}
+	if invoice.GetDueDate().Before(calendar.StartOfDay(time.Now())) {
+		return response.InvoiceResponse{}, http_error.NewBadRequestError("Due date cannot be in the past")
+	}
+
+	merchant, err := is.userService.FindUserByIDService(ctx, invoice.GetMerchantID())
+	if err != nil {
+		return response.InvoiceResponse{}, http_error.NewBadRequestError("Merchant not found")
+	}
+	if !merchant.IsMerchant {
+		return response.InvoiceResponse{}, http_error.NewBadRequestError("Only merchants can issue invoices")
+	}
+
+	customer, err := is.userService.FindUserByIDService(ctx, invoice.GetCustomerID())
+	if err != nil {
+		return response.InvoiceResponse{}, http_error.NewBadRequestError("Customer not found")
+	}
+	if customer.IsMerchant {
+		return response.InvoiceResponse{}, http_error.NewBadRequestError("Merchants cannot send money")
+	}
+
+	if err := is.invoiceRepository.InsertInvoiceRepository(ctx, invoice); err != nil {
+		logger.FromContext(ctx).Error("Error trying to call repository",
+			err,
+			zap.String("journey", "InsertInvoice"))
+		return response.InvoiceResponse{}, err
+	}
+
+	return is.FindInvoiceByIDService(ctx, invoice.GetID())
+}
+
+func (is *invoiceService) FindInvoiceByIDService(ctx context.Context, id uuid.UUID) (response.InvoiceResponse, *http_error.HttpError) {
+	result, err := is.invoiceRepository.FindInvoiceByIDRepository(ctx, id)
+	if err != nil {
+		logger.FromContext(ctx).Error("Error trying to call repository",
+			err,
+			zap.String("journey", "FindInvoiceByID"))
+		return response.InvoiceResponse{}, err
+	}
+	result.AmountDue = invoiceAmountDue(result, time.Now())
+	return result, nil
+}
+
+func (is *invoiceService) FindInvoicesByUserService(ctx context.Context, userID uuid.UUID) ([]response.InvoiceResponse, *http_error.HttpError) {
+	if _, err := is.userService.FindUserByIDService(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	result, err := is.invoiceRepository.FindInvoicesByUserRepository(ctx, userID)
+	if err != nil {
+		logger.FromContext(ctx).Error("Error trying to call repository",
+			err,
+			zap.String("journey", "FindInvoicesByUser"))
+		return nil, err
+	}
+
+	now := time.Now()
+	for i := range result {
+		result[i].AmountDue = invoiceAmountDue(result[i], now)
+	}
+	return result, nil
+}
+
+// PayInvoiceService charges the customer the amount due at this moment,
+// including late fee and interest, through the regular order flow. The
+// invoice is marked as paid in the transaction of the transfer, so it stays
+// open if the transfer fails.
+func (is *invoiceService) PayInvoiceService(ctx context.Context, id uuid.UUID) (response.InvoiceResponse, *http_error.HttpError) {
+	invoice, err := is.FindInvoiceByIDService(ctx, id)
+	if err != nil {
+		return response.InvoiceResponse{}, err
+	}
+	if invoice.Status != domain.InvoiceStatusOpen && invoice.Status != domain.InvoiceStatusOverdue {
+		return response.InvoiceResponse{}, http_error.NewBadRequestError("Invoice is already " + invoice.Status)
+	}
+
+	if _, err := is.orderService.InsertOrderService(ctx, domain.NewOrderDomain(invoice.AmountDue, invoice.MerchantID, invoice.CustomerID),
+		is.invoiceRepository.PayInvoiceRepository(id)); err != nil {
+		return response.InvoiceResponse{}, err
+	}
+
+	return is.FindInvoiceByIDService(ctx, id)
+}
+
+func (is *invoiceService) CancelInvoiceService(ctx context.Context, id uuid.UUID) (response.InvoiceResponse, *http_error.HttpError) {
+	invoice, err := is.FindInvoiceByIDService(ctx, id)
+	if err != nil {
+		return response.InvoiceResponse{}, err
+	}
+	if invoice.Status != domain.InvoiceStatusOpen && invoice.Status != domain.InvoiceStatusOverdue {
+		return response.InvoiceResponse{}, http_error.NewBadRequestError("Invoice is already " + invoice.Status)
+	}
+
+	if err := is.updateStatus(ctx, id, invoice.Status, domain.InvoiceStatusCancelled, "CancelInvoice"); err != nil {
+		return response.InvoiceResponse{}, err
+	}
+
+	return is.FindInvoiceByIDService(ctx, id)
+}
+
+func (is *invoiceService) MarkOverdueInvoicesService(ctx context.Context) *http_error.HttpError {
+	today := calendar.StartOfDay(time.Now())
+
+	overdue, err := is.invoiceRepository.MarkOverdueInvoicesRepository(ctx, today)
+	if err != nil {
+		logger.FromContext(ctx).Error("Error trying to call repository",
+			err,
+			zap.String("journey", "MarkOverdueInvoices"))
+		return err
+	}
+
+	if overdue > 0 {
+		logger.FromContext(ctx).Info("Invoices marked as overdue", zap.Int64("invoices", overdue), zap.String("journey", "MarkOverdueInvoices"))
+	}
+	return nil
+}
+
+func (is *invoiceService) updateStatus(ctx context.Context, id uuid.UUID, from string, to string, journey string) *http_error.HttpError {
+	updated, err := is.invoiceRepository.UpdateInvoiceStatusRepository(ctx, id, from, to)
+	if err != nil {
+		logger.FromContext(ctx).Error("Error trying to call repository",
+			err,
+			zap.String("journey", journey))
+		return err
+	}
+	if !updated {
+		return http_error.NewBadRequestError("Invoice was changed by another request")
+	}
+	return nil
+}
+
+// invoiceAmountDue returns how much the customer has to pay at now. After
+// the due date the late fee is charged once and the interest for every day
+// late, both as percentages of the invoice amount.
+func invoiceAmountDue(invoice response.InvoiceResponse, now time.Time) float64 {
+	switch invoice.Status {
+	case domain.InvoiceStatusPaid:
+		if invoice.PaidAmount != nil {
+			return *invoice.PaidAmount
+		}
+		return invoice.Amount
+	case domain.InvoiceStatusCancelled:
+		return 0
+	}
+
+	today := calendar.StartOfDay(now)
+	dueDate := time.Date(invoice.DueDate.Year(), invoice.DueDate.Month(), invoice.DueDate.Day(), 0, 0, 0, 0, calendar.Location)
+	if !today.After(dueDate) {
+		return invoice.Amount
+	}
+
+	daysLate := math.Round(today.Sub(dueDate).Hours() / 24)
+	lateFee := invoice.Amount * invoice.LateFee / 100
+	interest := invoice.Amount * invoice.DailyInterest / 100 * daysLate
+
+	return math.Round((invoice.Amount+lateFee+interest)*100) / 100
+}
@@ -0,0 +1,119 @@
+package service
+
+import (
+	"context"
+	"io"
+
+	"github.com/felipeversiane/picpay-golang.git/config/http_error"
+	"github.com/felipeversiane/picpay-golang.git/config/logger"
+	domain "github.com/felipeversiane/picpay-golang.git/internal"
+	"github.com/felipeversiane/picpay-golang.git/internal/entity/response"
+	"github.com/felipeversiane/picpay-golang.git/internal/repository"
+	"github.com/felipeversiane/picpay-golang.git/internal/storage"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type orderAttachmentService struct {
+	orderAttachmentRepository repository.OrderAttachmentRepository
+	orderService              OrderService
+	storage                   storage.Storage
+}
+
+func NewOrderAttachmentService(
+	orderAttachmentRepository repository.OrderAttachmentRepository,
+	orderService OrderService,
+	storage storage.Storage,
+) OrderAttachmentService {
+	return &orderAttachmentService{
+		orderAttachmentRepository,
+		orderService,
+		storage,
+	}
+}
+
+type OrderAttachmentService interface {
+	InsertOrderAttachmentService(ctx context.Context, attachment domain.OrderAttachmentDomainInterface, content io.Reader) (response.OrderAttachmentResponse, *http_error.HttpError)
+	FindOrderAttachmentsService(ctx context.Context, orderID uuid.UUID, userID uuid.UUID) ([]response.OrderAttachmentResponse, *http_error.HttpError)
+	OpenOrderAttachmentService(ctx context.Context, orderID uuid.UUID, id uuid.UUID, userID uuid.UUID) (response.OrderAttachmentResponse, io.ReadCloser, *http_error.HttpError)
+}
+
+// InsertOrderAttachmentService stores a receipt sent by one of the parties of
+// the order. The file is saved before the row, and removed again if the row
+// cannot be stored, so no attachment points to a missing file.
+func (as *orderAttachmentService) InsertOrderAttachmentService(ctx context.Context, attachment domain.OrderAttachmentDomainInterface, content io.Reader) (response.OrderAttachmentResponse, *http_error.HttpError) {
+	if err := as.checkParty(ctx, attachment.GetOrderID(), attachment.GetUploadedBy(), "Only the payer or the payee can attach files to the order"); err != nil {
+		return response.OrderAttachmentResponse{}, err
+	}
+	if !domain.OrderAttachmentContentTypes[attachment.GetContentType()] {
+		return response.OrderAttachmentResponse{}, http_error.NewBadRequestError("Attachments must be PDF, JPEG or PNG files")
+	}
+
+	if saveErr := as.storage.Save(ctx, attachment.GetStorageKey(), content); saveErr != nil {
+		logger.FromContext(ctx).Error("Error trying to save attachment", saveErr, zap.String("journey", "InsertOrderAttachment"))
+		return response.OrderAttachmentResponse{}, http_error.NewInternalServerError("Error saving attachment")
+	}
+
+	result, err := as.orderAttachmentRepository.InsertOrderAttachmentRepository(ctx, attachment)
+	if err != nil {
+		logger.FromContext(ctx).Error("Error trying to call repository",
+			err,
+			zap.String("journey", "InsertOrderAttachment"))
+		if deleteErr := as.storage.Delete(ctx, attachment.GetStorageKey()); deleteErr != nil {
+			logger.FromContext(ctx).Error("Error trying to delete attachment", deleteErr, zap.String("journey", "InsertOrderAttachment"))
+		}
+		return response.OrderAttachmentResponse{}, err
+	}
+	return result, nil
+}
+
+func (as *orderAttachmentService) FindOrderAttachmentsService(ctx context.Context, orderID uuid.UUID, userID uuid.UUID) ([]response.OrderAttachmentResponse, *http_error.HttpError) {
+	if err := as.checkParty(ctx, orderID, userID, "Only the payer or the payee can see the attachments of the order"); err != nil {
+		return nil, err
+	}
+
+	result, err := as.orderAttachmentRepository.FindOrderAttachmentsRepository(ctx, orderID)
+	if err != nil {
+		logger.FromContext(ctx).Error("Error trying to call repository",
+			err,
+			zap.String("journey", "FindOrderAttachments"))
+		return nil, err
+	}
+	return result, nil
+}
+
+func (as *orderAttachmentService) OpenOrderAttachmentService(ctx context.Context, orderID uuid.UUID, id uuid.UUID, userID uuid.UUID) (response.OrderAttachmentResponse, io.ReadCloser, *http_error.HttpError) {
+	if err := as.checkParty(ctx, orderID, userID, "Only the payer or the payee can see the attachments of the order"); err != nil {
+		return response.OrderAttachmentResponse{}, nil, err
+	}
+
+	attachment, err := as.orderAttachmentRepository.FindOrderAttachmentByIDRepository(ctx, orderID, id)
+	if err != nil {
+		logger.FromContext(ctx).Error("Error trying to call repository",
+			err,
+			zap.String("journey", "OpenOrderAttachment"))
+		return response.OrderAttachmentResponse{}, nil, err
+	}
+
+	content, openErr := as.storage.Open(ctx, attachment.StorageKey)
+	if openErr != nil {
+		logger.FromContext(ctx).Error("Error trying to open attachment", openErr, zap.String("journey", "OpenOrderAttachment"))
+		if openErr == storage.ErrNotFound {
+			return response.OrderAttachmentResponse{}, nil, http_error.NewNotFoundError("Attachment not found")
+		}
+		return response.OrderAttachmentResponse{}, nil, http_error.NewInternalServerError("Error opening attachment")
+	}
+	return attachment, content, nil
+}
+
+// checkParty makes sure the order exists and userID is its payer or payee.
+func (as *orderAttachmentService) checkParty(ctx context.Context, orderID uuid.UUID, userID uuid.UUID, message string) *http_error.HttpError {
+	order, err := as.orderService.FindOrderByIDService(ctx, orderID)
+	if err != nil {
+		return err
+	}
+	if userID != order.Payer && userID != order.Payee {
+		return http_error.NewForbiddenError(message)
+	}
+	return nil
+}
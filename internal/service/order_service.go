@@ -5,44 +5,106 @@ import (
 	"crypto/tls"
 	"encoding/json"
 	"io"
+	"math"
 	"net/http"
-	"os"
+	"time"
 
+	"github.com/felipeversiane/picpay-golang.git/config"
 	"github.com/felipeversiane/picpay-golang.git/config/http_error"
 	"github.com/felipeversiane/picpay-golang.git/config/logger"
+	"github.com/felipeversiane/picpay-golang.git/config/tracing"
 	domain "github.com/felipeversiane/picpay-golang.git/internal"
+	"github.com/felipeversiane/picpay-golang.git/internal/calendar"
 	"github.com/felipeversiane/picpay-golang.git/internal/entity/response"
+	"github.com/felipeversiane/picpay-golang.git/internal/metrics"
+	"github.com/felipeversiane/picpay-golang.git/internal/moderation"
 	"github.com/felipeversiane/picpay-golang.git/internal/repository"
 	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 )
 
 type orderService struct {
-	orderRepository repository.OrderRepository
-	userService     UserService
+	orderRepository  repository.OrderRepository
+	userService      UserService
+	feePlanService   FeePlanService
+	authorizationURL string
+	nightHours       config.TransferLimit
 }
 
 func NewOrderService(
 	orderRepository repository.OrderRepository,
 	userService UserService,
+	feePlanService FeePlanService,
+	authorizationURL string,
+	nightHours config.TransferLimit,
 ) OrderService {
 	return &orderService{
-		orderRepository, userService,
+		orderRepository, userService, feePlanService, authorizationURL, nightHours,
 	}
 }
 
 type OrderService interface {
-	InsertOrderService(ctx context.Context, order domain.OrderDomainInterface) (response.OrderResponse, *http_error.HttpError)
-	ValidateAuthorization() bool
+	InsertOrderService(ctx context.Context, order domain.OrderDomainInterface, hooks ...repository.OrderHook) (response.OrderResponse, *http_error.HttpError)
+	InsertOrderBatchService(ctx context.Context, payer uuid.UUID, orders []repository.BatchOrder) ([]response.OrderResponse, *http_error.HttpError)
+	ValidateAuthorization(ctx context.Context) bool
 	FindOrderByIDService(ctx context.Context, id uuid.UUID) (response.OrderResponse, *http_error.HttpError)
 }
 
-func (oc *orderService) InsertOrderService(ctx context.Context, order domain.OrderDomainInterface) (response.OrderResponse, *http_error.HttpError) {
-	payer, err := oc.userService.FindUserByIDService(order.GetPayer(), ctx)
+// InsertOrderService makes the transfer and records its outcome. Every flow
+// that moves money between users goes through here or, for payouts, through
+// InsertOrderBatchService, so the transfer metrics cover all of them. The hooks let those flows claim and link their
+// own records in the same transaction as the transfer.
+func (oc *orderService) InsertOrderService(ctx context.Context, order domain.OrderDomainInterface, hooks ...repository.OrderHook) (response.OrderResponse, *http_error.HttpError) {
+	ctx, span := tracing.Start(ctx, "OrderService.InsertOrder", trace.WithAttributes(
+		attribute.String("order.id", order.GetID().String()),
+		attribute.Float64("order.amount", order.GetAmount()),
+	))
+	defer span.End()
+
+	result, err := oc.insertOrder(ctx, order, hooks)
+	outcome := transferOutcome(err)
+	if outcome == metrics.TransferOutcomeFailed {
+		tracing.RecordError(span, err)
+	}
+	metrics.ObserveTransfer(outcome, order.GetAmount())
+	span.SetAttributes(attribute.String("order.outcome", outcome))
+
+	return result, err
+}
+
+// InsertOrderBatchService makes every order of a batch from the same payer
+// in a single transaction, with one authorization and the limits checked
+// against the whole batch. Batches are how merchants pay out, so unlike a
+// single order the payer may be a merchant.
+func (oc *orderService) InsertOrderBatchService(ctx context.Context, payer uuid.UUID, orders []repository.BatchOrder) ([]response.OrderResponse, *http_error.HttpError) {
+	ctx, span := tracing.Start(ctx, "OrderService.InsertOrderBatch", trace.WithAttributes(
+		attribute.String("order.payer", payer.String()),
+		attribute.Int("order.count", len(orders)),
+	))
+	defer span.End()
+
+	result, err := oc.insertOrderBatch(ctx, payer, orders)
+	outcome := transferOutcome(err)
+	if outcome == metrics.TransferOutcomeFailed {
+		tracing.RecordError(span, err)
+	}
+	for _, batchOrder := range orders {
+		metrics.ObserveTransfer(outcome, batchOrder.Order.GetAmount())
+	}
+	span.SetAttributes(attribute.String("order.outcome", outcome))
+
+	return result, err
+}
+
+func (oc *orderService) insertOrder(ctx context.Context, order domain.OrderDomainInterface, hooks []repository.OrderHook) (response.OrderResponse, *http_error.HttpError) {
+	payer, err := oc.userService.FindUserByIDService(ctx, order.GetPayer())
 	if err != nil {
 		return response.OrderResponse{}, http_error.NewBadRequestError("Payer not found")
 	}
-	payee, err := oc.userService.FindUserByIDService(order.GetPayee(), ctx)
+	payee, err := oc.userService.FindUserByIDService(ctx, order.GetPayee())
 	if err != nil {
 		return response.OrderResponse{}, http_error.NewBadRequestError("Payee not found")
 	}
@@ -55,82 +117,172 @@ func (oc *orderService) InsertOrderService(ctx context.Context, order domain.Ord
 		return response.OrderResponse{}, http_error.NewBadRequestError("Merchants cannot send money")
 	}
 
-	_, err = oc.userService.FindUserByIDService(order.GetPayee(), ctx)
-	if err != nil {
-		return response.OrderResponse{}, http_error.NewBadRequestError("Payee not found")
+	message, cleanErr := moderation.CleanMessage(order.GetMessage())
+	if cleanErr != nil {
+		return response.OrderResponse{}, http_error.NewBadRequestError("Message cannot contain links")
+	}
+	order.SetMessage(message)
+
+	if err := oc.applyFeePlan(ctx, order, payee); err != nil {
+		return response.OrderResponse{}, err
 	}
 
-	if !oc.ValidateAuthorization() {
+	if !oc.ValidateAuthorization(ctx) {
 		return response.OrderResponse{}, http_error.NewBadRequestError("Order not authorized")
 	}
 
-	result, err := oc.orderRepository.InsertOrderRepository(ctx, order)
+	result, err := oc.orderRepository.InsertOrderRepository(ctx, order, transferWindows(time.Now(), oc.nightHours),
+		func(payerBalance float64, limits response.TransferLimitsResponse) *http_error.HttpError {
+			if payerBalance < order.GetAmount() {
+				return http_error.NewBadRequestError("Insufficient balance")
+			}
+			return checkTransferLimits(limits, order.GetAmount())
+		}, hooks...)
 	if err != nil {
-		logger.Error("Error trying to call repository",
+		logger.FromContext(ctx).Error("Error trying to call repository",
 			err,
 			zap.String("journey", "InsertOrder"))
 		return response.OrderResponse{}, err
 	}
 
-	payer.Balance -= order.GetAmount()
-	payerUpdate := domain.NewUserUpdateDomain(payer.FirstName, payer.LastName, payer.Balance, payer.IsMerchant)
-	if _, err := oc.userService.UpdateUserService(payer.ID, payerUpdate, ctx); err != nil {
-		logger.Error("Error updating payer balance", err, zap.String("journey", "UpdatePayerBalance"))
-		return response.OrderResponse{}, http_error.NewInternalServerError("Error updating payer balance")
+	return result, nil
+}
+
+func (oc *orderService) insertOrderBatch(ctx context.Context, payer uuid.UUID, orders []repository.BatchOrder) ([]response.OrderResponse, *http_error.HttpError) {
+	if _, err := oc.userService.FindUserByIDService(ctx, payer); err != nil {
+		return nil, http_error.NewBadRequestError("Payer not found")
+	}
+
+	amounts := make([]float64, 0, len(orders))
+	var cents int64
+	for _, batchOrder := range orders {
+		order := batchOrder.Order
+		if order.GetPayer() != payer {
+			return nil, http_error.NewInternalServerError("Every order of a batch must have the same payer")
+		}
+		payee, err := oc.userService.FindUserByIDService(ctx, order.GetPayee())
+		if err != nil {
+			return nil, http_error.NewBadRequestError("Payee not found")
+		}
+		if err := oc.applyFeePlan(ctx, order, payee); err != nil {
+			return nil, err
+		}
+		amounts = append(amounts, order.GetAmount())
+		cents += int64(math.Round(order.GetAmount() * 100))
 	}
+	total := float64(cents) / 100
 
-	payee.Balance += order.GetAmount()
-	payeeUpdate := domain.NewUserUpdateDomain(payee.FirstName, payee.LastName, payee.Balance, payee.IsMerchant)
-	if _, err := oc.userService.UpdateUserService(payee.ID, payeeUpdate, ctx); err != nil {
-		logger.Error("Error updating payee balance", err, zap.String("journey", "UpdatePayeeBalance"))
+	if !oc.ValidateAuthorization(ctx) {
+		return nil, http_error.NewBadRequestError("Order not authorized")
+	}
 
-		return response.OrderResponse{}, http_error.NewInternalServerError("Error updating payee balance")
+	result, err := oc.orderRepository.InsertOrderBatchRepository(ctx, payer, orders, transferWindows(time.Now(), oc.nightHours),
+		func(payerBalance float64, limits response.TransferLimitsResponse) *http_error.HttpError {
+			if payerBalance < total {
+				return http_error.NewBadRequestError("Insufficient balance")
+			}
+			return checkBatchTransferLimits(limits, amounts)
+		})
+	if err != nil {
+		logger.FromContext(ctx).Error("Error trying to call repository",
+			err,
+			zap.String("journey", "InsertOrderBatch"))
+		return nil, err
 	}
 
 	return result, nil
 }
 
-func (oc *orderService) ValidateAuthorization() bool {
-	url := os.Getenv("AUTHORIZATION_URL")
+// applyFeePlan charges the fee of the payee plan on the order and, when the
+// plan settles later, schedules the settlement.
+func (oc *orderService) applyFeePlan(ctx context.Context, order domain.OrderDomainInterface, payee response.UserResponse) *http_error.HttpError {
+	fee, feePlan, err := oc.feePlanService.CalculateFeeService(ctx, payee, order.GetAmount())
+	if err != nil {
+		return err
+	}
+	if feePlan != nil {
+		order.SetFee(fee, &feePlan.ID)
+		if feePlan.SettlementDays > 0 {
+			order.SetSettlementDate(calendar.SettlementDate(time.Now(), feePlan.SettlementDays))
+		}
+	}
+	return nil
+}
+
+func transferOutcome(err *http_error.HttpError) string {
+	switch {
+	case err == nil:
+		return metrics.TransferOutcomeCompleted
+	case err.Code < http.StatusInternalServerError:
+		return metrics.TransferOutcomeRejected
+	default:
+		return metrics.TransferOutcomeFailed
+	}
+}
+
+func (oc *orderService) ValidateAuthorization(ctx context.Context) bool {
+	ctx, span := tracing.Start(ctx, "Authorizer.Authorize", trace.WithSpanKind(trace.SpanKindClient))
+	defer span.End()
+
+	start := time.Now()
+	result := oc.callAuthorizer(ctx)
+	metrics.ObserveAuthorizer(result, time.Since(start))
+
+	span.SetAttributes(attribute.String("authorizer.result", result))
+	if result == metrics.AuthorizerResultError {
+		span.SetStatus(codes.Error, "authorizer call failed")
+	}
+	return result == metrics.AuthorizerResultAuthorized
+}
 
+func (oc *orderService) callAuthorizer(ctx context.Context) string {
 	httpClient := &http.Client{
 		Transport: &http.Transport{
 			TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
 		},
 	}
 
-	resp, err := httpClient.Get(url)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, oc.authorizationURL, nil)
 	if err != nil {
-		logger.Error("Error calling authorization service", err, zap.String("journey", "ValidateAuthorization"))
-		return false
+		logger.FromContext(ctx).Error("Error creating authorization request", err, zap.String("journey", "ValidateAuthorization"))
+		return metrics.AuthorizerResultError
+	}
+	tracing.InjectHeaders(ctx, req.Header)
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		logger.FromContext(ctx).Error("Error calling authorization service", err, zap.String("journey", "ValidateAuthorization"))
+		return metrics.AuthorizerResultError
 	}
 	defer resp.Body.Close()
 
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		logger.Error("Error reading response body", err, zap.String("journey", "ValidateAuthorization"))
-		return false
+		logger.FromContext(ctx).Error("Error reading response body", err, zap.String("journey", "ValidateAuthorization"))
+		return metrics.AuthorizerResultError
 	}
 
 	var result map[string]interface{}
 	if err := json.Unmarshal(body, &result); err != nil {
-		logger.Error("Error unmarshalling JSON", err, zap.String("journey", "ValidateAuthorization"))
-		return false
+		logger.FromContext(ctx).Error("Error unmarshalling JSON", err, zap.String("journey", "ValidateAuthorization"))
+		return metrics.AuthorizerResultError
 	}
 
 	data, ok := result["data"].(map[string]interface{})
 	if !ok {
-		return false
+		return metrics.AuthorizerResultDenied
 	}
 
-	authorization, ok := data["authorization"].(bool)
-	return ok && authorization
+	if authorization, ok := data["authorization"].(bool); ok && authorization {
+		return metrics.AuthorizerResultAuthorized
+	}
+	return metrics.AuthorizerResultDenied
 }
 
 func (oc *orderService) FindOrderByIDService(ctx context.Context, id uuid.UUID) (response.OrderResponse, *http_error.HttpError) {
 	result, err := oc.orderRepository.FindOrderByIDRepository(ctx, id)
 	if err != nil {
-		logger.Error("Error trying to call repository",
+		logger.FromContext(ctx).Error("Error trying to call repository",
 			err,
 			zap.String("journey", "FindOrderByID"))
 		return response.OrderResponse{}, err
@@ -0,0 +1,186 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"math/big"
+	"time"
+
+	"github.com/felipeversiane/picpay-golang.git/config/http_error"
+	"github.com/felipeversiane/picpay-golang.git/config/logger"
+	domain "github.com/felipeversiane/picpay-golang.git/internal"
+	"github.com/felipeversiane/picpay-golang.git/internal/entity/response"
+	"github.com/felipeversiane/picpay-golang.git/internal/repository"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	paymentLinkSlugAlphabet = "abcdefghijkmnpqrstuvwxyzABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+	paymentLinkSlugSize     = 10
+)
+
+type paymentLinkService struct {
+	paymentLinkRepository repository.PaymentLinkRepository
+	userService           UserService
+	orderService          OrderService
+}
+
+func NewPaymentLinkService(
+	paymentLinkRepository repository.PaymentLinkRepository,
+	userService UserService,
+	orderService OrderService,
+) PaymentLinkService {
+	return &paymentLinkService{
+		paymentLinkRepository,
+		userService,
+		orderService,
+	}
+}
+
+type PaymentLinkService interface {
+	InsertPaymentLinkService(ctx context.Context, paymentLink domain.PaymentLinkDomainInterface) (response.PaymentLinkResponse, *http_error.HttpError)
+	FindPaymentLinkByIDService(ctx context.Context, id uuid.UUID) (response.PaymentLinkResponse, *http_error.HttpError)
+	FindPaymentLinkPaymentsService(ctx context.Context, id uuid.UUID) ([]response.PaymentLinkPaymentResponse, *http_error.HttpError)
+	ResolvePaymentLinkService(ctx context.Context, slug string) (response.PaymentLinkPublicResponse, *http_error.HttpError)
+	PayPaymentLinkService(ctx context.Context, slug string, payer uuid.UUID, amount float64) (response.OrderResponse, *http_error.HttpError)
+}
+
+func (ps *paymentLinkService) InsertPaymentLinkService(ctx context.Context, paymentLink domain.PaymentLinkDomainInterface) (response.PaymentLinkResponse, *http_error.HttpError) {
+	merchant, err := ps.userService.FindUserByIDService(ctx, paymentLink.GetMerchantID())
+	if err != nil {
+		return response.PaymentLinkResponse{}, err
+	}
+	if !merchant.IsMerchant {
+		return response.PaymentLinkResponse{}, http_error.NewBadRequestError("Only merchants can create payment links")
+	}
+
+	slug, err := paymentLinkSlug()
+	if err != nil {
+		return response.PaymentLinkResponse{}, err
+	}
+	paymentLink.SetSlug(slug)
+
+	result, err := ps.paymentLinkRepository.InsertPaymentLinkRepository(ctx, paymentLink)
+	if err != nil {
+		logger.FromContext(ctx).Error("Error trying to call repository",
+			err,
+			zap.String("journey", "InsertPaymentLink"))
+		return response.PaymentLinkResponse{}, err
+	}
+	return result, nil
+}
+
+func (ps *paymentLinkService) FindPaymentLinkByIDService(ctx context.Context, id uuid.UUID) (response.PaymentLinkResponse, *http_error.HttpError) {
+	result, err := ps.paymentLinkRepository.FindPaymentLinkByIDRepository(ctx, id)
+	if err != nil {
+		logger.FromContext(ctx).Error("Error trying to call repository",
+			err,
+			zap.String("journey", "FindPaymentLinkByID"))
+		return response.PaymentLinkResponse{}, err
+	}
+	return result, nil
+}
+
+func (ps *paymentLinkService) FindPaymentLinkPaymentsService(ctx context.Context, id uuid.UUID) ([]response.PaymentLinkPaymentResponse, *http_error.HttpError) {
+	if _, err := ps.FindPaymentLinkByIDService(ctx, id); err != nil {
+		return nil, err
+	}
+
+	result, err := ps.paymentLinkRepository.FindPaymentLinkPaymentsRepository(ctx, id)
+	if err != nil {
+		logger.FromContext(ctx).Error("Error trying to call repository",
+			err,
+			zap.String("journey", "FindPaymentLinkPayments"))
+		return nil, err
+	}
+	return result, nil
+}
+
+// ResolvePaymentLinkService returns what a payer needs to see before paying.
+// Links past their expiration are reported as expired.
+func (ps *paymentLinkService) ResolvePaymentLinkService(ctx context.Context, slug string) (response.PaymentLinkPublicResponse, *http_error.HttpError) {
+	paymentLink, err := ps.paymentLinkRepository.FindPaymentLinkBySlugRepository(ctx, slug)
+	if err != nil {
+		return response.PaymentLinkPublicResponse{}, err
+	}
+
+	merchant, err := ps.userService.FindUserByIDService(ctx, paymentLink.MerchantID)
+	if err != nil {
+		return response.PaymentLinkPublicResponse{}, err
+	}
+
+	status := paymentLink.Status
+	if status == domain.PaymentLinkStatusActive && paymentLinkExpired(paymentLink) {
+		status = domain.PaymentLinkStatusExpired
+	}
+
+	return response.PaymentLinkPublicResponse{
+		Slug:         paymentLink.Slug,
+		MerchantName: merchant.FirstName + " " + merchant.LastName,
+		Description:  paymentLink.Description,
+		Amount:       paymentLink.Amount,
+		Reusable:     paymentLink.Reusable,
+		Status:       status,
+		ExpiresAt:    paymentLink.ExpiresAt,
+	}, nil
+}
+
+// PayPaymentLinkService transfers the amount to the merchant through the
+// regular order flow and records the order against the link in the same
+// transaction, which also uses up single-use links.
+func (ps *paymentLinkService) PayPaymentLinkService(ctx context.Context, slug string, payer uuid.UUID, amount float64) (response.OrderResponse, *http_error.HttpError) {
+	paymentLink, err := ps.paymentLinkRepository.FindPaymentLinkBySlugRepository(ctx, slug)
+	if err != nil {
+		return response.OrderResponse{}, err
+	}
+
+	switch paymentLink.Status {
+	case domain.PaymentLinkStatusUsed:
+		return response.OrderResponse{}, http_error.NewBadRequestError("Payment link was already paid")
+	case domain.PaymentLinkStatusExpired:
+		return response.OrderResponse{}, http_error.NewBadRequestError("Payment link is expired")
+	}
+
+	if paymentLinkExpired(paymentLink) {
+		if _, err := ps.paymentLinkRepository.UpdatePaymentLinkStatusRepository(ctx, paymentLink.ID, domain.PaymentLinkStatusActive, domain.PaymentLinkStatusExpired); err != nil {
+			logger.FromContext(ctx).Error("Error trying to expire payment link", err, zap.String("journey", "PayPaymentLink"))
+		}
+		return response.OrderResponse{}, http_error.NewBadRequestError("Payment link is expired")
+	}
+
+	if paymentLink.Amount != nil {
+		if amount != 0 && amount != *paymentLink.Amount {
+			return response.OrderResponse{}, http_error.NewBadRequestError("Amount does not match the payment link amount")
+		}
+		amount = *paymentLink.Amount
+	}
+	if amount <= 0 {
+		return response.OrderResponse{}, http_error.NewBadRequestError("Amount is required for payment links without a fixed amount")
+	}
+
+	order, err := ps.orderService.InsertOrderService(ctx, domain.NewOrderDomain(amount, paymentLink.MerchantID, payer),
+		ps.paymentLinkRepository.PayPaymentLinkRepository(paymentLink.ID))
+	if err != nil {
+		return response.OrderResponse{}, err
+	}
+
+	return order, nil
+}
+
+func paymentLinkExpired(paymentLink response.PaymentLinkResponse) bool {
+	return paymentLink.ExpiresAt != nil && !time.Now().Before(*paymentLink.ExpiresAt)
+}
+
+func paymentLinkSlug() (string, *http_error.HttpError) {
+	slug := make([]byte, paymentLinkSlugSize)
+	max := big.NewInt(int64(len(paymentLinkSlugAlphabet)))
+	for i := range slug {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", http_error.NewInternalServerError("Error generating payment link")
+		}
+		slug[i] = paymentLinkSlugAlphabet[n.Int64()]
+	}
+	return string(slug), nil
+}
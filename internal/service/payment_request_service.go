@@ -0,0 +1,182 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/felipeversiane/picpay-golang.git/config/http_error"
+	"github.com/felipeversiane/picpay-golang.git/config/logger"
+	domain "github.com/felipeversiane/picpay-golang.git/internal"
+	"github.com/felipeversiane/picpay-golang.git/internal/entity/response"
+	"github.com/felipeversiane/picpay-golang.git/internal/repository"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type paymentRequestService struct {
+	paymentRequestRepository repository.PaymentRequestRepository
+	billSplitRepository      repository.BillSplitRepository
+	userService              UserService
+	orderService             OrderService
+}
+
+func NewPaymentRequestService(
+	paymentRequestRepository repository.PaymentRequestRepository,
+	billSplitRepository repository.BillSplitRepository,
+	userService UserService,
+	orderService OrderService,
+) PaymentRequestService {
+	return &paymentRequestService{
+		paymentRequestRepository,
+		billSplitRepository,
+		userService,
+		orderService,
+	}
+}
+
+type PaymentRequestService interface {
+	InsertPaymentRequestService(ctx context.Context, paymentRequest domain.PaymentRequestDomainInterface) (response.PaymentRequestResponse, *http_error.HttpError)
+	FindPaymentRequestByIDService(ctx context.Context, id uuid.UUID) (response.PaymentRequestResponse, *http_error.HttpError)
+	FindPendingPaymentRequestsService(ctx context.Context, userID uuid.UUID) ([]response.PaymentRequestResponse, *http_error.HttpError)
+	AcceptPaymentRequestService(ctx context.Context, id uuid.UUID) (response.PaymentRequestResponse, *http_error.HttpError)
+	DeclinePaymentRequestService(ctx context.Context, id uuid.UUID) (response.PaymentRequestResponse, *http_error.HttpError)
+	ExpirePaymentRequestsService(ctx context.Context) *http_error.HttpError
+}
+
+func (ps *paymentRequestService) InsertPaymentRequestService(ctx context.Context, paymentRequest domain.PaymentRequestDomainInterface) (response.PaymentRequestResponse, *http_error.HttpError) {
+	if paymentRequest.GetRequester() == paymentRequest.GetPayer() {
+		return response.PaymentRequestResponse{}, http_error.NewBadRequestError("Requester and payer must be different")
+	}
+
+	if _, err := ps.userService.FindUserByIDService(ctx, paymentRequest.GetRequester()); err != nil {
+		return response.PaymentRequestResponse{}, http_error.NewBadRequestError("Requester not found")
+	}
+
+	payer, err := ps.userService.FindUserByIDService(ctx, paymentRequest.GetPayer())
+	if err != nil {
+		return response.PaymentRequestResponse{}, http_error.NewBadRequestError("Payer not found")
+	}
+	if payer.IsMerchant {
+		return response.PaymentRequestResponse{}, http_error.NewBadRequestError("Merchants cannot send money")
+	}
+
+	result, err := ps.paymentRequestRepository.InsertPaymentRequestRepository(ctx, paymentRequest)
+	if err != nil {
+		logger.FromContext(ctx).Error("Error trying to call repository",
+			err,
+			zap.String("journey", "InsertPaymentRequest"))
+		return response.PaymentRequestResponse{}, err
+	}
+	return result, nil
+}
+
+func (ps *paymentRequestService) FindPaymentRequestByIDService(ctx context.Context, id uuid.UUID) (response.PaymentRequestResponse, *http_error.HttpError) {
+	result, err := ps.paymentRequestRepository.FindPaymentRequestByIDRepository(ctx, id)
+	if err != nil {
+		logger.FromContext(ctx).Error("Error trying to call repository",
+			err,
+			zap.String("journey", "FindPaymentRequestByID"))
+		return response.PaymentRequestResponse{}, err
+	}
+	return result, nil
+}
+
+func (ps *paymentRequestService) FindPendingPaymentRequestsService(ctx context.Context, userID uuid.UUID) ([]response.PaymentRequestResponse, *http_error.HttpError) {
+	if _, err := ps.userService.FindUserByIDService(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	result, err := ps.paymentRequestRepository.FindPendingPaymentRequestsRepository(ctx, userID, time.Now())
+	if err != nil {
+		logger.FromContext(ctx).Error("Error trying to call repository",
+			err,
+			zap.String("journey", "FindPendingPaymentRequests"))
+		return nil, err
+	}
+	return result, nil
+}
+
+// AcceptPaymentRequestService pays the request through the regular order
+// flow. The request is accepted and linked to the order in the transaction
+// of the transfer, so it cannot be paid twice and stays pending if the
+// transfer fails. Paying the last share of a bill split settles the split.
+func (ps *paymentRequestService) AcceptPaymentRequestService(ctx context.Context, id uuid.UUID) (response.PaymentRequestResponse, *http_error.HttpError) {
+	paymentRequest, err := ps.findPendingPaymentRequest(ctx, id, "AcceptPaymentRequest")
+	if err != nil {
+		return response.PaymentRequestResponse{}, err
+	}
+
+	hooks := []repository.OrderHook{ps.paymentRequestRepository.AcceptPaymentRequestRepository(id)}
+	if paymentRequest.SplitID != nil {
+		hooks = append(hooks, ps.billSplitRepository.SettleBillSplitRepository(*paymentRequest.SplitID))
+	}
+
+	if _, err := ps.orderService.InsertOrderService(ctx, domain.NewOrderDomain(paymentRequest.Amount, paymentRequest.Requester, paymentRequest.Payer), hooks...); err != nil {
+		return response.PaymentRequestResponse{}, err
+	}
+
+	return ps.FindPaymentRequestByIDService(ctx, id)
+}
+
+func (ps *paymentRequestService) DeclinePaymentRequestService(ctx context.Context, id uuid.UUID) (response.PaymentRequestResponse, *http_error.HttpError) {
+	if _, err := ps.findPendingPaymentRequest(ctx, id, "DeclinePaymentRequest"); err != nil {
+		return response.PaymentRequestResponse{}, err
+	}
+
+	if err := ps.updateStatus(ctx, id, domain.PaymentRequestStatusPending, domain.PaymentRequestStatusDeclined, "DeclinePaymentRequest"); err != nil {
+		return response.PaymentRequestResponse{}, err
+	}
+
+	return ps.FindPaymentRequestByIDService(ctx, id)
+}
+
+func (ps *paymentRequestService) ExpirePaymentRequestsService(ctx context.Context) *http_error.HttpError {
+	expired, err := ps.paymentRequestRepository.ExpirePaymentRequestsRepository(ctx, time.Now())
+	if err != nil {
+		logger.FromContext(ctx).Error("Error trying to call repository",
+			err,
+			zap.String("journey", "ExpirePaymentRequests"))
+		return err
+	}
+
+	if expired > 0 {
+		logger.FromContext(ctx).Info("Payment requests expired", zap.Int64("requests", expired), zap.String("journey", "ExpirePaymentRequests"))
+	}
+	return nil
+}
+
+// findPendingPaymentRequest returns the request if it can still be answered,
+// expiring it on the spot when the worker has not done it yet.
+func (ps *paymentRequestService) findPendingPaymentRequest(ctx context.Context, id uuid.UUID, journey string) (response.PaymentRequestResponse, *http_error.HttpError) {
+	paymentRequest, err := ps.FindPaymentRequestByIDService(ctx, id)
+	if err != nil {
+		return response.PaymentRequestResponse{}, err
+	}
+
+	if paymentRequest.Status != domain.PaymentRequestStatusPending {
+		return response.PaymentRequestResponse{}, http_error.NewBadRequestError("Payment request is already " + paymentRequest.Status)
+	}
+
+	if !time.Now().Before(paymentRequest.ExpiresAt) {
+		if _, err := ps.paymentRequestRepository.UpdatePaymentRequestStatusRepository(ctx, id, domain.PaymentRequestStatusPending, domain.PaymentRequestStatusExpired); err != nil {
+			logger.FromContext(ctx).Error("Error trying to expire payment request", err, zap.String("journey", journey))
+		}
+		return response.PaymentRequestResponse{}, http_error.NewBadRequestError("Payment request is already expired")
+	}
+
+	return paymentRequest, nil
+}
+
+func (ps *paymentRequestService) updateStatus(ctx context.Context, id uuid.UUID, from string, to string, journey string) *http_error.HttpError {
+	updated, err := ps.paymentRequestRepository.UpdatePaymentRequestStatusRepository(ctx, id, from, to)
+	if err != nil {
+		logger.FromContext(ctx).Error("Error trying to call repository",
+			err,
+			zap.String("journey", journey))
+		return err
+	}
+	if !updated {
+		return http_error.NewBadRequestError("Payment request was already answered")
+	}
+	return nil
+}
@@ -0,0 +1,231 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/felipeversiane/picpay-golang.git/config/http_error"
+	"github.com/felipeversiane/picpay-golang.git/config/logger"
+	domain "github.com/felipeversiane/picpay-golang.git/internal"
+	"github.com/felipeversiane/picpay-golang.git/internal/entity/response"
+	"github.com/felipeversiane/picpay-golang.git/internal/repository"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	// maxPayoutBatchesPerRun bounds how many batches a single worker run
+	// processes, so one run never holds the worker for too long.
+	maxPayoutBatchesPerRun = 10
+	// payoutBatchStaleAfter is how long a batch may stay processing before
+	// another run claims it again.
+	payoutBatchStaleAfter = 15 * time.Minute
+)
+
+type payoutService struct {
+	payoutRepository repository.PayoutRepository
+	userService      UserService
+	orderService     OrderService
+}
+
+func NewPayoutService(
+	payoutRepository repository.PayoutRepository,
+	userService UserService,
+	orderService OrderService,
+) PayoutService {
+	return &payoutService{
+		payoutRepository,
+		userService,
+		orderService,
+	}
+}
+
+type PayoutService interface {
+	InsertPayoutBatchService(ctx context.Context, batch domain.PayoutBatchDomainInterface) (response.PayoutBatchResponse, *http_error.HttpError)
+	FindPayoutBatchByIDService(ctx context.Context, id uuid.UUID) (response.PayoutBatchResponse, *http_error.HttpError)
+	FindPayoutBatchResultService(ctx context.Context, id uuid.UUID) ([]response.PayoutItemResponse, *http_error.HttpError)
+	ProcessPayoutBatchesService(ctx context.Context) *http_error.HttpError
+}
+
+// InsertPayoutBatchService validates every item up front and queues the
+// batch. The transfers are made by the payout worker.
+func (ps *payoutService) InsertPayoutBatchService(ctx context.Context, batch domain.PayoutBatchDomainInterface) (response.PayoutBatchResponse, *http_error.HttpError) {
+	payer, err := ps.userService.FindUserByIDService(ctx, batch.GetPayerID())
+	if err != nil {
+		return response.PayoutBatchResponse{}, err
+	}
+	if !payer.IsMerchant {
+		return response.PayoutBatchResponse{}, http_error.NewBadRequestError("Only merchants can send payouts")
+	}
+
+	ids := make([]uuid.UUID, 0, len(batch.GetItems()))
+	for _, item := range batch.GetItems() {
+		ids = append(ids, item.Payee)
+	}
+	missing, err := ps.payoutRepository.FindMissingUsersRepository(ctx, ids)
+	if err != nil {
+		logger.FromContext(ctx).Error("Error trying to call repository",
+			err,
+			zap.String("journey", "InsertPayoutBatch"))
+		return response.PayoutBatchResponse{}, err
+	}
+	notFound := map[uuid.UUID]bool{}
+	for _, id := range missing {
+		notFound[id] = true
+	}
+
+	causes := []http_error.Causes{}
+	for i, item := range batch.GetItems() {
+		field := fmt.Sprintf("items[%d].payee", i)
+		switch {
+		case item.Payee == batch.GetPayerID():
+			causes = append(causes, http_error.Causes{Field: field, Message: "Payee cannot be the payer"})
+		case notFound[item.Payee]:
+			causes = append(causes, http_error.Causes{Field: field, Message: "Payee not found"})
+		}
+	}
+	if len(causes) > 0 {
+		return response.PayoutBatchResponse{}, http_error.NewBadRequestValidationError("Some payout items are invalid", causes)
+	}
+
+	if batch.GetMode() == domain.PayoutModeAllOrNothing && payer.Balance < batch.GetTotalAmount() {
+		return response.PayoutBatchResponse{}, http_error.NewBadRequestError("Insufficient balance for the payout batch")
+	}
+
+	if err := ps.payoutRepository.InsertPayoutBatchRepository(ctx, batch); err != nil {
+		logger.FromContext(ctx).Error("Error trying to call repository",
+			err,
+			zap.String("journey", "InsertPayoutBatch"))
+		return response.PayoutBatchResponse{}, err
+	}
+
+	return ps.FindPayoutBatchByIDService(ctx, batch.GetID())
+}
+
+func (ps *payoutService) FindPayoutBatchByIDService(ctx context.Context, id uuid.UUID) (response.PayoutBatchResponse, *http_error.HttpError) {
+	result, err := ps.payoutRepository.FindPayoutBatchByIDRepository(ctx, id)
+	if err != nil {
+		logger.FromContext(ctx).Error("Error trying to call repository",
+			err,
+			zap.String("journey", "FindPayoutBatchByID"))
+		return response.PayoutBatchResponse{}, err
+	}
+	return result, nil
+}
+
+// FindPayoutBatchResultService returns the items of a batch that has
+// finished processing.
+func (ps *payoutService) FindPayoutBatchResultService(ctx context.Context, id uuid.UUID) ([]response.PayoutItemResponse, *http_error.HttpError) {
+	batch, err := ps.FindPayoutBatchByIDService(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if batch.Status == domain.PayoutBatchStatusPending || batch.Status == domain.PayoutBatchStatusProcessing {
+		return nil, http_error.NewBadRequestError("Payout batch is still being processed")
+	}
+
+	items, err := ps.payoutRepository.FindPayoutItemsRepository(ctx, id)
+	if err != nil {
+		logger.FromContext(ctx).Error("Error trying to call repository",
+			err,
+			zap.String("journey", "FindPayoutBatchResult"))
+		return nil, err
+	}
+	return items, nil
+}
+
+func (ps *payoutService) ProcessPayoutBatchesService(ctx context.Context) *http_error.HttpError {
+	processed := 0
+	for processed < maxPayoutBatchesPerRun {
+		batch, claimed, err := ps.payoutRepository.ClaimPayoutBatchRepository(ctx, time.Now().Add(-payoutBatchStaleAfter))
+		if err != nil {
+			logger.FromContext(ctx).Error("Error trying to call repository",
+				err,
+				zap.String("journey", "ProcessPayoutBatches"))
+			return err
+		}
+		if !claimed {
+			break
+		}
+
+		if err := ps.processPayoutBatch(ctx, batch); err != nil {
+			return err
+		}
+		processed++
+	}
+
+	if processed > 0 {
+		logger.FromContext(ctx).Info("Payout batches processed", zap.Int("batches", processed), zap.String("journey", "ProcessPayoutBatches"))
+	}
+	return nil
+}
+
+// processPayoutBatch pays the pending items of the batch as regular orders
+// from the merchant, so they get receipts, statements, fees, limits and the
+// authorizer like any other transfer. An all-or-nothing batch is paid in a
+// single transaction; otherwise every item is paid on its own and a rejected
+// item is recorded as failed without stopping the others.
+func (ps *payoutService) processPayoutBatch(ctx context.Context, batch response.PayoutBatchResponse) *http_error.HttpError {
+	items, err := ps.payoutRepository.FindPayoutItemsRepository(ctx, batch.ID)
+	if err != nil {
+		logger.FromContext(ctx).Error("Error trying to call repository",
+			err,
+			zap.String("journey", "ProcessPayoutBatch"))
+		return err
+	}
+
+	orders := []repository.BatchOrder{}
+	pending := []response.PayoutItemResponse{}
+	for _, item := range items {
+		if item.Status != domain.PayoutItemStatusPending {
+			continue
+		}
+		pending = append(pending, item)
+		orders = append(orders, repository.BatchOrder{
+			Order: domain.NewOrderDomain(item.Amount, item.Payee, batch.PayerID),
+			Hooks: []repository.OrderHook{ps.payoutRepository.PayPayoutItemRepository(item.ID)},
+		})
+	}
+
+	if batch.Mode == domain.PayoutModeAllOrNothing {
+		if len(orders) > 0 {
+			if _, err := ps.orderService.InsertOrderBatchService(ctx, batch.PayerID, orders); err != nil {
+				if err.Code >= http.StatusInternalServerError {
+					return err
+				}
+				if err := ps.payoutRepository.FailPayoutItemsRepository(ctx, batch.ID, err.Message); err != nil {
+					logger.FromContext(ctx).Error("Error trying to call repository",
+						err,
+						zap.String("journey", "ProcessPayoutBatch"))
+					return err
+				}
+			}
+		}
+	} else {
+		for i, order := range orders {
+			_, err := ps.orderService.InsertOrderBatchService(ctx, batch.PayerID, []repository.BatchOrder{order})
+			if err == nil {
+				continue
+			}
+			if err.Code >= http.StatusInternalServerError {
+				return err
+			}
+			if err := ps.payoutRepository.FailPayoutItemRepository(ctx, pending[i].ID, err.Message); err != nil {
+				logger.FromContext(ctx).Error("Error trying to call repository",
+					err,
+					zap.String("journey", "ProcessPayoutBatch"))
+				return err
+			}
+		}
+	}
+
+	if err := ps.payoutRepository.CompletePayoutBatchRepository(ctx, batch.ID); err != nil {
+		logger.FromContext(ctx).Error("Error trying to call repository",
+			err,
+			zap.String("journey", "ProcessPayoutBatch"))
+		return err
+	}
+	return nil
+}
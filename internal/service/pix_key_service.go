@@ -0,0 +1,319 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/felipeversiane/picpay-golang.git/config/http_error"
+	"github.com/felipeversiane/picpay-golang.git/config/logger"
+	"github.com/felipeversiane/picpay-golang.git/config/validation"
+	domain "github.com/felipeversiane/picpay-golang.git/internal"
+	"github.com/felipeversiane/picpay-golang.git/internal/entity/response"
+	"github.com/felipeversiane/picpay-golang.git/internal/notification"
+	"github.com/felipeversiane/picpay-golang.git/internal/repository"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	maxPixKeysPerCPF   = 5
+	maxPixKeysPerCNPJ  = 20
+	pixKeyCodeValidity = 10 * time.Minute
+	pixKeyCodeAttempts = 5
+	// pixKeyClaimConfirmPeriod is how long the claimer has to send the code
+	// once the donor window is over.
+	pixKeyClaimConfirmPeriod = 7 * 24 * time.Hour
+)
+
+var phonePattern = regexp.MustCompile(`^\+[1-9][0-9]{10,14}$`)
+
+type pixKeyService struct {
+	pixKeyRepository repository.PixKeyRepository
+	userService      UserService
+	notifier         notification.Notifier
+	claimWindow      time.Duration
+}
+
+func NewPixKeyService(
+	pixKeyRepository repository.PixKeyRepository,
+	userService UserService,
+	notifier notification.Notifier,
+	claimWindow time.Duration,
+) PixKeyService {
+	return &pixKeyService{
+		pixKeyRepository,
+		userService,
+		notifier,
+		claimWindow,
+	}
+}
+
+type PixKeyService interface {
+	InsertPixKeyService(ctx context.Context, key domain.PixKeyDomainInterface) (response.PixKeyResponse, *http_error.HttpError)
+	VerifyPixKeyService(ctx context.Context, id uuid.UUID, code string) (response.PixKeyResponse, *http_error.HttpError)
+	FindPixKeysByUserService(ctx context.Context, userID uuid.UUID) ([]response.PixKeyResponse, *http_error.HttpError)
+	LookupPixKeyService(ctx context.Context, key string) (response.PixKeyLookupResponse, *http_error.HttpError)
+	ResolvePixKeyService(ctx context.Context, key string) (uuid.UUID, *http_error.HttpError)
+	DeletePixKeyService(ctx context.Context, id uuid.UUID) *http_error.HttpError
+	InsertPixKeyClaimService(ctx context.Context, claimerID uuid.UUID, key string) (response.PixKeyClaimResponse, *http_error.HttpError)
+	ConfirmPixKeyClaimService(ctx context.Context, id uuid.UUID, code string) (response.PixKeyClaimResponse, *http_error.HttpError)
+	CancelPixKeyClaimService(ctx context.Context, id uuid.UUID, userID uuid.UUID) (response.PixKeyClaimResponse, *http_error.HttpError)
+}
+
+func (ps *pixKeyService) InsertPixKeyService(ctx context.Context, key domain.PixKeyDomainInterface) (response.PixKeyResponse, *http_error.HttpError) {
+	user, err := ps.userService.FindUserByIDService(ctx, key.GetUserID())
+	if err != nil {
+		return response.PixKeyResponse{}, err
+	}
+
+	count, err := ps.pixKeyRepository.CountPixKeysByUserRepository(ctx, user.ID)
+	if err != nil {
+		logger.FromContext(ctx).Error("Error trying to call repository",
+			err,
+			zap.String("journey", "InsertPixKey"))
+		return response.PixKeyResponse{}, err
+	}
+	limit := maxPixKeysPerCPF
+	if user.DocumentType == validation.DocumentTypeCNPJ {
+		limit = maxPixKeysPerCNPJ
+	}
+	if count >= limit {
+		return response.PixKeyResponse{}, http_error.NewBadRequestError(fmt.Sprintf("Users with a %s can register at most %d keys", strings.ToUpper(user.DocumentType), limit))
+	}
+
+	switch key.GetKeyType() {
+	case domain.PixKeyTypeEmail, domain.PixKeyTypePhone:
+		normalized, err := normalizePixKey(key.GetKeyType(), key.GetKey())
+		if err != nil {
+			return response.PixKeyResponse{}, err
+		}
+		key.SetKey(normalized)
+	case domain.PixKeyTypeDocument:
+		if key.GetKey() != "" && validation.NormalizeDocument(key.GetKey()) != user.Document {
+			return response.PixKeyResponse{}, http_error.NewBadRequestError("Document keys must match the user document")
+		}
+		key.SetKey(user.Document)
+		key.Activate()
+	case domain.PixKeyTypeEVP:
+		key.SetKey(uuid.NewString())
+		key.Activate()
+	}
+
+	if _, err := ps.pixKeyRepository.FindPixKeyOwnerRepository(ctx, key.GetKey()); err == nil {
+		return response.PixKeyResponse{}, http_error.NewBadRequestError("Key is already registered. Open a portability claim to move it to this account")
+	}
+
+	if key.GetStatus() == domain.PixKeyStatusPending {
+		code, err := verificationCode()
+		if err != nil {
+			return response.PixKeyResponse{}, err
+		}
+		key.SetVerificationCode(code, time.Now().Add(pixKeyCodeValidity))
+	}
+
+	result, err := ps.pixKeyRepository.InsertPixKeyRepository(ctx, key)
+	if err != nil {
+		logger.FromContext(ctx).Error("Error trying to call repository",
+			err,
+			zap.String("journey", "InsertPixKey"))
+		return response.PixKeyResponse{}, err
+	}
+
+	if key.GetStatus() == domain.PixKeyStatusPending {
+		ps.notify(ctx, notification.KindPixKeyVerification, key.GetKey(), fmt.Sprintf("Your key verification code is %s", key.GetVerificationCode()))
+	}
+
+	return result, nil
+}
+
+func (ps *pixKeyService) VerifyPixKeyService(ctx context.Context, id uuid.UUID, code string) (response.PixKeyResponse, *http_error.HttpError) {
+	if _, err := ps.pixKeyRepository.FindPixKeyByIDRepository(ctx, id); err != nil {
+		return response.PixKeyResponse{}, err
+	}
+
+	result, err := ps.pixKeyRepository.VerifyPixKeyRepository(ctx, id, code, time.Now(), pixKeyCodeAttempts)
+	if err != nil {
+		logger.FromContext(ctx).Error("Error trying to call repository",
+			err,
+			zap.String("journey", "VerifyPixKey"))
+		return response.PixKeyResponse{}, err
+	}
+	return result, nil
+}
+
+func (ps *pixKeyService) FindPixKeysByUserService(ctx context.Context, userID uuid.UUID) ([]response.PixKeyResponse, *http_error.HttpError) {
+	if _, err := ps.userService.FindUserByIDService(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	result, err := ps.pixKeyRepository.FindPixKeysByUserRepository(ctx, userID)
+	if err != nil {
+		logger.FromContext(ctx).Error("Error trying to call repository",
+			err,
+			zap.String("journey", "FindPixKeysByUser"))
+		return nil, err
+	}
+	return result, nil
+}
+
+func (ps *pixKeyService) LookupPixKeyService(ctx context.Context, key string) (response.PixKeyLookupResponse, *http_error.HttpError) {
+	owner, err := ps.pixKeyRepository.FindPixKeyOwnerRepository(ctx, detectPixKey(key))
+	if err != nil {
+		return response.PixKeyLookupResponse{}, err
+	}
+
+	return response.PixKeyLookupResponse{
+		KeyType:       owner.KeyType,
+		Key:           owner.Key,
+		OwnerName:     validation.MaskName(owner.FirstName, owner.LastName),
+		OwnerDocument: validation.MaskDocument(owner.Document),
+		IsMerchant:    owner.IsMerchant,
+	}, nil
+}
+
+func (ps *pixKeyService) ResolvePixKeyService(ctx context.Context, key string) (uuid.UUID, *http_error.HttpError) {
+	owner, err := ps.pixKeyRepository.FindPixKeyOwnerRepository(ctx, detectPixKey(key))
+	if err != nil {
+		return uuid.UUID{}, err
+	}
+	return owner.UserID, nil
+}
+
+func (ps *pixKeyService) DeletePixKeyService(ctx context.Context, id uuid.UUID) *http_error.HttpError {
+	if _, err := ps.pixKeyRepository.FindPixKeyByIDRepository(ctx, id); err != nil {
+		return err
+	}
+
+	err := ps.pixKeyRepository.DeletePixKeyRepository(ctx, id)
+	if err != nil {
+		logger.FromContext(ctx).Error("Error trying to call repository",
+			err,
+			zap.String("journey", "DeletePixKey"))
+		return err
+	}
+	return nil
+}
+
+func (ps *pixKeyService) InsertPixKeyClaimService(ctx context.Context, claimerID uuid.UUID, key string) (response.PixKeyClaimResponse, *http_error.HttpError) {
+	if _, err := ps.userService.FindUserByIDService(ctx, claimerID); err != nil {
+		return response.PixKeyClaimResponse{}, err
+	}
+
+	owner, err := ps.pixKeyRepository.FindPixKeyOwnerRepository(ctx, detectPixKey(key))
+	if err != nil {
+		return response.PixKeyClaimResponse{}, err
+	}
+	if owner.UserID == claimerID {
+		return response.PixKeyClaimResponse{}, http_error.NewBadRequestError("Key already belongs to this user")
+	}
+	if owner.KeyType != domain.PixKeyTypeEmail && owner.KeyType != domain.PixKeyTypePhone {
+		return response.PixKeyClaimResponse{}, http_error.NewBadRequestError("Only email and phone keys can be claimed")
+	}
+
+	code, err := verificationCode()
+	if err != nil {
+		return response.PixKeyClaimResponse{}, err
+	}
+
+	claimableAt := time.Now().Add(ps.claimWindow)
+	result, err := ps.pixKeyRepository.InsertPixKeyClaimRepository(ctx, owner.KeyID, claimerID, owner.UserID, code, claimableAt, claimableAt.Add(pixKeyClaimConfirmPeriod))
+	if err != nil {
+		logger.FromContext(ctx).Error("Error trying to call repository",
+			err,
+			zap.String("journey", "InsertPixKeyClaim"))
+		return response.PixKeyClaimResponse{}, err
+	}
+
+	ps.notify(ctx, notification.KindPixKeyClaimCode, owner.Key, fmt.Sprintf("Your key portability code is %s. It can be used from %s", code, claimableAt.UTC().Format(time.RFC3339)))
+	if donor, err := ps.userService.FindUserByIDService(ctx, owner.UserID); err == nil {
+		ps.notify(ctx, notification.KindPixKeyClaimNotice, donor.Email, fmt.Sprintf("A portability claim was opened for your key %s. Cancel claim %s before %s if you did not request it", owner.Key, result.ID, claimableAt.UTC().Format(time.RFC3339)))
+	}
+
+	return result, nil
+}
+
+func (ps *pixKeyService) ConfirmPixKeyClaimService(ctx context.Context, id uuid.UUID, code string) (response.PixKeyClaimResponse, *http_error.HttpError) {
+	if _, err := ps.pixKeyRepository.FindPixKeyClaimByIDRepository(ctx, id); err != nil {
+		return response.PixKeyClaimResponse{}, err
+	}
+
+	result, err := ps.pixKeyRepository.CompletePixKeyClaimRepository(ctx, id, code, time.Now(), pixKeyCodeAttempts)
+	if err != nil {
+		logger.FromContext(ctx).Error("Error trying to call repository",
+			err,
+			zap.String("journey", "ConfirmPixKeyClaim"))
+		return response.PixKeyClaimResponse{}, err
+	}
+	return result, nil
+}
+
+// CancelPixKeyClaimService cancels an open claim. Only the current owner of
+// the key and the claimer can cancel it.
+func (ps *pixKeyService) CancelPixKeyClaimService(ctx context.Context, id uuid.UUID, userID uuid.UUID) (response.PixKeyClaimResponse, *http_error.HttpError) {
+	result, err := ps.pixKeyRepository.CancelPixKeyClaimRepository(ctx, id, userID)
+	if err != nil {
+		logger.FromContext(ctx).Error("Error trying to call repository",
+			err,
+			zap.String("journey", "CancelPixKeyClaim"))
+		return response.PixKeyClaimResponse{}, err
+	}
+	return result, nil
+}
+
+func (ps *pixKeyService) notify(ctx context.Context, kind string, to string, message string) {
+	if err := ps.notifier.Send(ctx, kind, to, message); err != nil {
+		logger.FromContext(ctx).Error("Error trying to send notification", err, zap.String("journey", "PixKeyNotification"))
+	}
+}
+
+func normalizePixKey(keyType string, key string) (string, *http_error.HttpError) {
+	key = strings.TrimSpace(key)
+
+	switch keyType {
+	case domain.PixKeyTypeEmail:
+		key = strings.ToLower(key)
+		if validation.Validate.Var(key, "email") != nil {
+			return "", http_error.NewBadRequestError("Invalid email key")
+		}
+	case domain.PixKeyTypePhone:
+		key = strings.NewReplacer(" ", "", "-", "", "(", "", ")", "").Replace(key)
+		if !phonePattern.MatchString(key) {
+			return "", http_error.NewBadRequestError("Phone keys must be in international format, such as +5511987654321")
+		}
+	}
+
+	return key, nil
+}
+
+// detectPixKey normalizes a key typed by a payer without knowing its type.
+func detectPixKey(key string) string {
+	key = strings.TrimSpace(key)
+
+	switch {
+	case strings.Contains(key, "@"):
+		return strings.ToLower(key)
+	case strings.HasPrefix(key, "+"):
+		phone, _ := normalizePixKey(domain.PixKeyTypePhone, key)
+		return phone
+	case validation.DocumentType(key) != "":
+		return validation.NormalizeDocument(key)
+	}
+
+	if id, err := uuid.Parse(key); err == nil {
+		return id.String()
+	}
+	return key
+}
+
+func verificationCode() (string, *http_error.HttpError) {
+	n, err := rand.Int(rand.Reader, big.NewInt(1000000))
+	if err != nil {
+		return "", http_error.NewInternalServerError("Error generating verification code")
+	}
+	return fmt.Sprintf("%06d", n.Int64()), nil
+}
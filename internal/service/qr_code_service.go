@@ -0,0 +1,142 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/felipeversiane/picpay-golang.git/config/http_error"
+	"github.com/felipeversiane/picpay-golang.git/config/logger"
+	domain "github.com/felipeversiane/picpay-golang.git/internal"
+	"github.com/felipeversiane/picpay-golang.git/internal/emv"
+	"github.com/felipeversiane/picpay-golang.git/internal/entity/response"
+	"github.com/felipeversiane/picpay-golang.git/internal/repository"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const defaultQRCodeCity = "SAO PAULO"
+
+type qrCodeService struct {
+	qrCodeRepository repository.QRCodeRepository
+	userService      UserService
+	orderService     OrderService
+}
+
+func NewQRCodeService(
+	qrCodeRepository repository.QRCodeRepository,
+	userService UserService,
+	orderService OrderService,
+) QRCodeService {
+	return &qrCodeService{
+		qrCodeRepository,
+		userService,
+		orderService,
+	}
+}
+
+type QRCodeService interface {
+	InsertQRCodeService(ctx context.Context, qrCode domain.QRCodeDomainInterface, city string) (response.QRCodeResponse, *http_error.HttpError)
+	FindQRCodeByIDService(ctx context.Context, id uuid.UUID) (response.QRCodeResponse, *http_error.HttpError)
+	PayQRCodeService(ctx context.Context, payer uuid.UUID, payload string, amount float64) (response.OrderResponse, *http_error.HttpError)
+}
+
+func (qs *qrCodeService) InsertQRCodeService(ctx context.Context, qrCode domain.QRCodeDomainInterface, city string) (response.QRCodeResponse, *http_error.HttpError) {
+	merchant, err := qs.userService.FindUserByIDService(ctx, qrCode.GetMerchantID())
+	if err != nil {
+		return response.QRCodeResponse{}, err
+	}
+	if !merchant.IsMerchant {
+		return response.QRCodeResponse{}, http_error.NewBadRequestError("Only merchants can generate QR codes")
+	}
+
+	if city == "" {
+		city = defaultQRCodeCity
+	}
+	qrCode.SetPayload(emv.Encode(emv.Payload{
+		Dynamic:      qrCode.IsDynamic(),
+		MerchantID:   merchant.ID.String(),
+		MerchantName: strings.ToUpper(merchant.FirstName + " " + merchant.LastName),
+		MerchantCity: strings.ToUpper(city),
+		Amount:       qrCode.GetAmount(),
+		Reference:    qrCode.GetReference(),
+	}))
+
+	result, err := qs.qrCodeRepository.InsertQRCodeRepository(ctx, qrCode)
+	if err != nil {
+		logger.FromContext(ctx).Error("Error trying to call repository",
+			err,
+			zap.String("journey", "InsertQRCode"))
+		return response.QRCodeResponse{}, err
+	}
+	return result, nil
+}
+
+func (qs *qrCodeService) FindQRCodeByIDService(ctx context.Context, id uuid.UUID) (response.QRCodeResponse, *http_error.HttpError) {
+	result, err := qs.qrCodeRepository.FindQRCodeByIDRepository(ctx, id)
+	if err != nil {
+		logger.FromContext(ctx).Error("Error trying to call repository",
+			err,
+			zap.String("journey", "FindQRCodeByID"))
+		return response.QRCodeResponse{}, err
+	}
+	return result, nil
+}
+
+func (qs *qrCodeService) PayQRCodeService(ctx context.Context, payer uuid.UUID, payload string, amount float64) (response.OrderResponse, *http_error.HttpError) {
+	payload = strings.TrimSpace(payload)
+
+	decoded, decodeErr := emv.Decode(payload)
+	if decodeErr != nil {
+		return response.OrderResponse{}, http_error.NewBadRequestError("Invalid QR code payload")
+	}
+
+	id, parseErr := uuid.Parse(decoded.Reference)
+	if parseErr != nil {
+		return response.OrderResponse{}, http_error.NewBadRequestError("Invalid QR code payload")
+	}
+
+	qrCode, err := qs.qrCodeRepository.FindQRCodeByIDRepository(ctx, id)
+	if err != nil {
+		return response.OrderResponse{}, err
+	}
+	if qrCode.Payload != payload || qrCode.MerchantID.String() != decoded.MerchantID {
+		return response.OrderResponse{}, http_error.NewBadRequestError("QR code payload does not match the issued QR code")
+	}
+
+	switch qrCode.Status {
+	case domain.QRCodeStatusPaid:
+		return response.OrderResponse{}, http_error.NewBadRequestError("QR code was already paid")
+	case domain.QRCodeStatusExpired:
+		return response.OrderResponse{}, http_error.NewBadRequestError("QR code is expired")
+	}
+
+	if qrCode.ExpiresAt != nil && time.Now().After(*qrCode.ExpiresAt) {
+		if _, err := qs.qrCodeRepository.UpdateQRCodeStatusRepository(ctx, qrCode.ID, domain.QRCodeStatusActive, domain.QRCodeStatusExpired); err != nil {
+			logger.FromContext(ctx).Error("Error trying to expire QR code", err, zap.String("journey", "PayQRCode"))
+		}
+		return response.OrderResponse{}, http_error.NewBadRequestError("QR code is expired")
+	}
+
+	if qrCode.Amount != nil {
+		if amount != 0 && amount != *qrCode.Amount {
+			return response.OrderResponse{}, http_error.NewBadRequestError("Amount does not match the QR code amount")
+		}
+		amount = *qrCode.Amount
+	}
+	if amount <= 0 {
+		return response.OrderResponse{}, http_error.NewBadRequestError("Amount is required for QR codes without a fixed amount")
+	}
+
+	hooks := []repository.OrderHook{}
+	if qrCode.Type == domain.QRCodeTypeDynamic {
+		hooks = append(hooks, qs.qrCodeRepository.PayQRCodeRepository(qrCode.ID))
+	}
+
+	order, err := qs.orderService.InsertOrderService(ctx, domain.NewOrderDomain(amount, qrCode.MerchantID, payer), hooks...)
+	if err != nil {
+		return response.OrderResponse{}, err
+	}
+
+	return order, nil
+}
@@ -0,0 +1,103 @@
+package service
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/felipeversiane/picpay-golang.git/config/http_error"
+	"github.com/felipeversiane/picpay-golang.git/config/validation"
+	"github.com/felipeversiane/picpay-golang.git/internal/entity/response"
+	"github.com/felipeversiane/picpay-golang.git/internal/receipt"
+	"github.com/google/uuid"
+)
+
+type receiptService struct {
+	orderService OrderService
+	userService  UserService
+	signer       receipt.Signer
+}
+
+func NewReceiptService(
+	orderService OrderService,
+	userService UserService,
+	signer receipt.Signer,
+) ReceiptService {
+	return &receiptService{
+		orderService,
+		userService,
+		signer,
+	}
+}
+
+type ReceiptService interface {
+	FindReceiptService(ctx context.Context, orderID uuid.UUID) (response.ReceiptResponse, *http_error.HttpError)
+	VerifyReceiptService(ctx context.Context, orderID uuid.UUID, code string) (response.ReceiptVerificationResponse, *http_error.HttpError)
+}
+
+// FindReceiptService builds the receipt of an order with masked payer and
+// payee data and its authentication code.
+func (rs *receiptService) FindReceiptService(ctx context.Context, orderID uuid.UUID) (response.ReceiptResponse, *http_error.HttpError) {
+	order, err := rs.orderService.FindOrderByIDService(ctx, orderID)
+	if err != nil {
+		return response.ReceiptResponse{}, err
+	}
+
+	payer, err := rs.userService.FindUserByIDService(ctx, order.Payer)
+	if err != nil {
+		return response.ReceiptResponse{}, err
+	}
+	payee, err := rs.userService.FindUserByIDService(ctx, order.Payee)
+	if err != nil {
+		return response.ReceiptResponse{}, err
+	}
+
+	return response.ReceiptResponse{
+		OrderID: order.ID,
+		Amount:  order.Amount,
+		Message: order.Message,
+		Payer: response.ReceiptPartyResponse{
+			Name:     validation.MaskName(payer.FirstName, payer.LastName),
+			Document: validation.MaskDocument(payer.Document),
+		},
+		Payee: response.ReceiptPartyResponse{
+			Name:     validation.MaskName(payee.FirstName, payee.LastName),
+			Document: validation.MaskDocument(payee.Document),
+		},
+		CreatedAt:          order.CreatedAt,
+		AuthenticationCode: rs.signer.Sign(orderFields(order)),
+	}, nil
+}
+
+// VerifyReceiptService checks the authentication code against the stored
+// order. A valid code returns the receipt, so the holder can compare it with
+// the document they were given. Unknown orders are reported as invalid
+// rather than not found, to not reveal which order IDs exist.
+func (rs *receiptService) VerifyReceiptService(ctx context.Context, orderID uuid.UUID, code string) (response.ReceiptVerificationResponse, *http_error.HttpError) {
+	order, err := rs.orderService.FindOrderByIDService(ctx, orderID)
+	if err != nil {
+		if err.Code == http.StatusNotFound {
+			return response.ReceiptVerificationResponse{Valid: false}, nil
+		}
+		return response.ReceiptVerificationResponse{}, err
+	}
+
+	if !rs.signer.Verify(orderFields(order), code) {
+		return response.ReceiptVerificationResponse{Valid: false}, nil
+	}
+
+	result, err := rs.FindReceiptService(ctx, orderID)
+	if err != nil {
+		return response.ReceiptVerificationResponse{}, err
+	}
+	return response.ReceiptVerificationResponse{Valid: true, Receipt: &result}, nil
+}
+
+func orderFields(order response.OrderResponse) receipt.Fields {
+	return receipt.Fields{
+		OrderID:   order.ID,
+		Amount:    order.Amount,
+		Payer:     order.Payer,
+		Payee:     order.Payee,
+		CreatedAt: order.CreatedAt,
+	}
+}
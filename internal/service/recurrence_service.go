@@ -0,0 +1,279 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/felipeversiane/picpay-golang.git/config/http_error"
+	"github.com/felipeversiane/picpay-golang.git/config/logger"
+	domain "github.com/felipeversiane/picpay-golang.git/internal"
+	"github.com/felipeversiane/picpay-golang.git/internal/entity/response"
+	"github.com/felipeversiane/picpay-golang.git/internal/repository"
+	"github.com/felipeversiane/picpay-golang.git/internal/schedule"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	recurrenceRetryInterval = time.Hour
+	recurrenceBatchSize     = 100
+)
+
+type recurrenceService struct {
+	recurrenceRepository repository.RecurrenceRepository
+	orderService         OrderService
+	userService          UserService
+}
+
+func NewRecurrenceService(
+	recurrenceRepository repository.RecurrenceRepository,
+	orderService OrderService,
+	userService UserService,
+) RecurrenceService {
+	return &recurrenceService{
+		recurrenceRepository, orderService, userService,
+	}
+}
+
+type RecurrenceService interface {
+	InsertRecurrenceService(ctx context.Context, recurrence domain.RecurrenceDomainInterface) (response.RecurrenceResponse, *http_error.HttpError)
+	FindRecurrenceByIDService(ctx context.Context, id uuid.UUID) (response.RecurrenceResponse, *http_error.HttpError)
+	FindRecurrenceExecutionsService(ctx context.Context, id uuid.UUID) ([]response.RecurrenceExecutionResponse, *http_error.HttpError)
+	PauseRecurrenceService(ctx context.Context, id uuid.UUID) (response.RecurrenceResponse, *http_error.HttpError)
+	ResumeRecurrenceService(ctx context.Context, id uuid.UUID) (response.RecurrenceResponse, *http_error.HttpError)
+	CancelRecurrenceService(ctx context.Context, id uuid.UUID) (response.RecurrenceResponse, *http_error.HttpError)
+	ExecuteDueRecurrencesService(ctx context.Context) *http_error.HttpError
+}
+
+func (rs *recurrenceService) InsertRecurrenceService(ctx context.Context, recurrence domain.RecurrenceDomainInterface) (response.RecurrenceResponse, *http_error.HttpError) {
+	sched, parseErr := schedule.Parse(recurrence.GetSchedule())
+	if parseErr != nil {
+		return response.RecurrenceResponse{}, http_error.NewBadRequestError("Invalid schedule: " + parseErr.Error())
+	}
+
+	nextRunAt := sched.Next(time.Now().UTC())
+	if nextRunAt.IsZero() {
+		return response.RecurrenceResponse{}, http_error.NewBadRequestError("Schedule never runs")
+	}
+	recurrence.SetNextRunAt(nextRunAt)
+
+	if recurrence.GetPayer() == recurrence.GetPayee() {
+		return response.RecurrenceResponse{}, http_error.NewBadRequestError("Payer and payee must be different")
+	}
+
+	payer, err := rs.userService.FindUserByIDService(ctx, recurrence.GetPayer())
+	if err != nil {
+		return response.RecurrenceResponse{}, http_error.NewBadRequestError("Payer not found")
+	}
+	if payer.IsMerchant {
+		return response.RecurrenceResponse{}, http_error.NewBadRequestError("Merchants cannot send money")
+	}
+
+	if _, err := rs.userService.FindUserByIDService(ctx, recurrence.GetPayee()); err != nil {
+		return response.RecurrenceResponse{}, http_error.NewBadRequestError("Payee not found")
+	}
+
+	result, err := rs.recurrenceRepository.InsertRecurrenceRepository(ctx, recurrence)
+	if err != nil {
+		logger.FromContext(ctx).Error("Error trying to call repository",
+			err,
+			zap.String("journey", "InsertRecurrence"))
+		return response.RecurrenceResponse{}, err
+	}
+	return result, nil
+}
+
+func (rs *recurrenceService) FindRecurrenceByIDService(ctx context.Context, id uuid.UUID) (response.RecurrenceResponse, *http_error.HttpError) {
+	result, err := rs.recurrenceRepository.FindRecurrenceByIDRepository(ctx, id)
+	if err != nil {
+		logger.FromContext(ctx).Error("Error trying to call repository",
+			err,
+			zap.String("journey", "FindRecurrenceByID"))
+		return response.RecurrenceResponse{}, err
+	}
+	return result, nil
+}
+
+func (rs *recurrenceService) FindRecurrenceExecutionsService(ctx context.Context, id uuid.UUID) ([]response.RecurrenceExecutionResponse, *http_error.HttpError) {
+	if _, err := rs.FindRecurrenceByIDService(ctx, id); err != nil {
+		return nil, err
+	}
+
+	result, err := rs.recurrenceRepository.FindRecurrenceExecutionsRepository(ctx, id)
+	if err != nil {
+		logger.FromContext(ctx).Error("Error trying to call repository",
+			err,
+			zap.String("journey", "FindRecurrenceExecutions"))
+		return nil, err
+	}
+	return result, nil
+}
+
+func (rs *recurrenceService) PauseRecurrenceService(ctx context.Context, id uuid.UUID) (response.RecurrenceResponse, *http_error.HttpError) {
+	recurrence, err := rs.FindRecurrenceByIDService(ctx, id)
+	if err != nil {
+		return response.RecurrenceResponse{}, err
+	}
+	if recurrence.Status != domain.RecurrenceStatusActive {
+		return response.RecurrenceResponse{}, http_error.NewBadRequestError("Only active recurrences can be paused")
+	}
+
+	return rs.updateRecurrenceState(ctx, id, domain.RecurrenceStatusPaused, recurrence.NextRunAt, 0, "PauseRecurrence")
+}
+
+func (rs *recurrenceService) ResumeRecurrenceService(ctx context.Context, id uuid.UUID) (response.RecurrenceResponse, *http_error.HttpError) {
+	recurrence, err := rs.FindRecurrenceByIDService(ctx, id)
+	if err != nil {
+		return response.RecurrenceResponse{}, err
+	}
+	if recurrence.Status != domain.RecurrenceStatusPaused {
+		return response.RecurrenceResponse{}, http_error.NewBadRequestError("Only paused recurrences can be resumed")
+	}
+
+	sched, parseErr := schedule.Parse(recurrence.Schedule)
+	if parseErr != nil {
+		return response.RecurrenceResponse{}, http_error.NewInternalServerError(parseErr.Error())
+	}
+
+	nextRunAt := sched.Next(time.Now().UTC())
+	if nextRunAt.IsZero() {
+		return response.RecurrenceResponse{}, http_error.NewBadRequestError("Schedule never runs again")
+	}
+
+	return rs.updateRecurrenceState(ctx, id, domain.RecurrenceStatusActive, nextRunAt, 0, "ResumeRecurrence")
+}
+
+func (rs *recurrenceService) CancelRecurrenceService(ctx context.Context, id uuid.UUID) (response.RecurrenceResponse, *http_error.HttpError) {
+	recurrence, err := rs.FindRecurrenceByIDService(ctx, id)
+	if err != nil {
+		return response.RecurrenceResponse{}, err
+	}
+	if recurrence.Status == domain.RecurrenceStatusCancelled {
+		return response.RecurrenceResponse{}, http_error.NewBadRequestError("Recurrence is already cancelled")
+	}
+	if recurrence.Status == domain.RecurrenceStatusFinished {
+		return response.RecurrenceResponse{}, http_error.NewBadRequestError("Recurrence is already finished")
+	}
+
+	return rs.updateRecurrenceState(ctx, id, domain.RecurrenceStatusCancelled, recurrence.NextRunAt, 0, "CancelRecurrence")
+}
+
+func (rs *recurrenceService) ExecuteDueRecurrencesService(ctx context.Context) *http_error.HttpError {
+	now := time.Now().UTC()
+
+	recurrences, err := rs.recurrenceRepository.FindDueRecurrencesRepository(ctx, now, recurrenceBatchSize)
+	if err != nil {
+		logger.FromContext(ctx).Error("Error trying to call repository",
+			err,
+			zap.String("journey", "ExecuteDueRecurrences"))
+		return err
+	}
+
+	for _, recurrence := range recurrences {
+		if ctx.Err() != nil {
+			return nil
+		}
+		rs.executeRecurrence(ctx, recurrence, now)
+	}
+
+	return nil
+}
+
+func (rs *recurrenceService) executeRecurrence(ctx context.Context, recurrence response.RecurrenceResponse, now time.Time) {
+	sched, parseErr := schedule.Parse(recurrence.Schedule)
+	if parseErr != nil {
+		logger.FromContext(ctx).Error("Invalid recurrence schedule", parseErr,
+			zap.String("journey", "ExecuteRecurrence"),
+			zap.String("recurrence_id", recurrence.ID.String()))
+		rs.recordExecution(ctx, recurrence.ID, nil, domain.ExecutionStatusFailed, "Invalid schedule")
+		rs.updateRecurrenceState(ctx, recurrence.ID, domain.RecurrenceStatusPaused, recurrence.NextRunAt, 0, "ExecuteRecurrence")
+		return
+	}
+	// A schedule with no further run executes this last time and finishes,
+	// so the claim does not leave it due again on the next tick.
+	status := domain.RecurrenceStatusActive
+	nextRunAt := sched.Next(now)
+	if nextRunAt.IsZero() {
+		status = domain.RecurrenceStatusFinished
+		nextRunAt = recurrence.NextRunAt
+	}
+
+	claimed, err := rs.recurrenceRepository.ClaimRecurrenceRepository(ctx, recurrence.ID, recurrence.NextRunAt, nextRunAt, status)
+	if err != nil {
+		logger.FromContext(ctx).Error("Error trying to claim recurrence", err,
+			zap.String("journey", "ExecuteRecurrence"),
+			zap.String("recurrence_id", recurrence.ID.String()))
+		return
+	}
+	if !claimed {
+		return
+	}
+
+	payer, err := rs.userService.FindUserByIDService(ctx, recurrence.Payer)
+	if err != nil {
+		rs.recordExecution(ctx, recurrence.ID, nil, domain.ExecutionStatusFailed, "Payer not found")
+		return
+	}
+
+	if payer.Balance < recurrence.Amount {
+		if status == domain.RecurrenceStatusFinished {
+			rs.recordExecution(ctx, recurrence.ID, nil, domain.ExecutionStatusFailed, "Insufficient balance on the last run")
+			return
+		}
+		rs.handleInsufficientFunds(ctx, recurrence, now, nextRunAt)
+		return
+	}
+
+	order := domain.NewOrderDomain(recurrence.Amount, recurrence.Payee, recurrence.Payer)
+	result, err := rs.orderService.InsertOrderService(ctx, order)
+	if err != nil {
+		rs.recordExecution(ctx, recurrence.ID, nil, domain.ExecutionStatusFailed, err.Message)
+		return
+	}
+
+	rs.recordExecution(ctx, recurrence.ID, &result.ID, domain.ExecutionStatusSucceeded, "")
+	if recurrence.RetryCount > 0 {
+		rs.updateRecurrenceState(ctx, recurrence.ID, status, nextRunAt, 0, "ExecuteRecurrence")
+	}
+}
+
+func (rs *recurrenceService) handleInsufficientFunds(ctx context.Context, recurrence response.RecurrenceResponse, now time.Time, nextRunAt time.Time) {
+	switch recurrence.OnInsufficientFunds {
+	case domain.InsufficientFundsPause:
+		rs.recordExecution(ctx, recurrence.ID, nil, domain.ExecutionStatusPaused, "Insufficient balance")
+		rs.updateRecurrenceState(ctx, recurrence.ID, domain.RecurrenceStatusPaused, nextRunAt, 0, "ExecuteRecurrence")
+	case domain.InsufficientFundsRetry:
+		retryAt := now.Add(recurrenceRetryInterval)
+		if recurrence.RetryCount < recurrence.MaxRetries && retryAt.Before(nextRunAt) {
+			rs.recordExecution(ctx, recurrence.ID, nil, domain.ExecutionStatusRetrying, "Insufficient balance")
+			rs.updateRecurrenceState(ctx, recurrence.ID, domain.RecurrenceStatusActive, retryAt, recurrence.RetryCount+1, "ExecuteRecurrence")
+			return
+		}
+		rs.recordExecution(ctx, recurrence.ID, nil, domain.ExecutionStatusFailed, "Insufficient balance after retries")
+		rs.updateRecurrenceState(ctx, recurrence.ID, domain.RecurrenceStatusActive, nextRunAt, 0, "ExecuteRecurrence")
+	default:
+		rs.recordExecution(ctx, recurrence.ID, nil, domain.ExecutionStatusSkipped, "Insufficient balance")
+		if recurrence.RetryCount > 0 {
+			rs.updateRecurrenceState(ctx, recurrence.ID, domain.RecurrenceStatusActive, nextRunAt, 0, "ExecuteRecurrence")
+		}
+	}
+}
+
+func (rs *recurrenceService) recordExecution(ctx context.Context, recurrenceID uuid.UUID, orderID *uuid.UUID, status string, message string) {
+	if err := rs.recurrenceRepository.InsertRecurrenceExecutionRepository(ctx, recurrenceID, orderID, status, message); err != nil {
+		logger.FromContext(ctx).Error("Error trying to record recurrence execution", err,
+			zap.String("journey", "ExecuteRecurrence"),
+			zap.String("recurrence_id", recurrenceID.String()))
+	}
+}
+
+func (rs *recurrenceService) updateRecurrenceState(ctx context.Context, id uuid.UUID, status string, nextRunAt time.Time, retryCount int, journey string) (response.RecurrenceResponse, *http_error.HttpError) {
+	result, err := rs.recurrenceRepository.UpdateRecurrenceStateRepository(ctx, id, status, nextRunAt, retryCount)
+	if err != nil {
+		logger.FromContext(ctx).Error("Error trying to call repository",
+			err,
+			zap.String("journey", journey))
+		return response.RecurrenceResponse{}, err
+	}
+	return result, nil
+}
@@ -0,0 +1,82 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/felipeversiane/picpay-golang.git/config/http_error"
+	"github.com/felipeversiane/picpay-golang.git/config/logger"
+	"github.com/felipeversiane/picpay-golang.git/internal/calendar"
+	"github.com/felipeversiane/picpay-golang.git/internal/entity/response"
+	"github.com/felipeversiane/picpay-golang.git/internal/repository"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type settlementService struct {
+	settlementRepository repository.SettlementRepository
+	userService          UserService
+}
+
+func NewSettlementService(
+	settlementRepository repository.SettlementRepository,
+	userService UserService,
+) SettlementService {
+	return &settlementService{
+		settlementRepository,
+		userService,
+	}
+}
+
+type SettlementService interface {
+	FindPendingSettlementsService(ctx context.Context, merchantID uuid.UUID) ([]response.SettlementResponse, *http_error.HttpError)
+	FindSettlementScheduleService(ctx context.Context, merchantID uuid.UUID) ([]response.SettlementScheduleResponse, *http_error.HttpError)
+	ReleaseDueSettlementsService(ctx context.Context) *http_error.HttpError
+}
+
+func (ss *settlementService) FindPendingSettlementsService(ctx context.Context, merchantID uuid.UUID) ([]response.SettlementResponse, *http_error.HttpError) {
+	if _, err := ss.userService.FindUserByIDService(ctx, merchantID); err != nil {
+		return nil, err
+	}
+
+	result, err := ss.settlementRepository.FindPendingSettlementsRepository(ctx, merchantID)
+	if err != nil {
+		logger.FromContext(ctx).Error("Error trying to call repository",
+			err,
+			zap.String("journey", "FindPendingSettlements"))
+		return nil, err
+	}
+	return result, nil
+}
+
+func (ss *settlementService) FindSettlementScheduleService(ctx context.Context, merchantID uuid.UUID) ([]response.SettlementScheduleResponse, *http_error.HttpError) {
+	if _, err := ss.userService.FindUserByIDService(ctx, merchantID); err != nil {
+		return nil, err
+	}
+
+	result, err := ss.settlementRepository.FindSettlementScheduleRepository(ctx, merchantID)
+	if err != nil {
+		logger.FromContext(ctx).Error("Error trying to call repository",
+			err,
+			zap.String("journey", "FindSettlementSchedule"))
+		return nil, err
+	}
+	return result, nil
+}
+
+func (ss *settlementService) ReleaseDueSettlementsService(ctx context.Context) *http_error.HttpError {
+	today := calendar.StartOfDay(time.Now().In(calendar.Location))
+
+	merchants, err := ss.settlementRepository.ReleaseDueSettlementsRepository(ctx, today)
+	if err != nil {
+		logger.FromContext(ctx).Error("Error trying to call repository",
+			err,
+			zap.String("journey", "ReleaseDueSettlements"))
+		return err
+	}
+
+	if merchants > 0 {
+		logger.FromContext(ctx).Info("Pending settlements released", zap.Int64("merchants", merchants), zap.String("journey", "ReleaseDueSettlements"))
+	}
+	return nil
+}
@@ -0,0 +1,143 @@
+package service
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/felipeversiane/picpay-golang.git/config"
+	"github.com/felipeversiane/picpay-golang.git/config/http_error"
+	"github.com/felipeversiane/picpay-golang.git/config/logger"
+	"github.com/felipeversiane/picpay-golang.git/internal/calendar"
+	"github.com/felipeversiane/picpay-golang.git/internal/entity/response"
+	"github.com/felipeversiane/picpay-golang.git/internal/repository"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type transferLimitService struct {
+	transferLimitRepository repository.TransferLimitRepository
+	nightHours              config.TransferLimit
+}
+
+func NewTransferLimitService(
+	transferLimitRepository repository.TransferLimitRepository,
+	nightHours config.TransferLimit,
+) TransferLimitService {
+	return &transferLimitService{
+		transferLimitRepository,
+		nightHours,
+	}
+}
+
+type TransferLimitService interface {
+	FindTransferLimitsService(ctx context.Context, userID uuid.UUID) (response.TransferLimitsResponse, *http_error.HttpError)
+}
+
+func (ts *transferLimitService) FindTransferLimitsService(ctx context.Context, userID uuid.UUID) (response.TransferLimitsResponse, *http_error.HttpError) {
+	result, err := ts.transferLimitRepository.FindTransferLimitsRepository(ctx, userID, transferWindows(time.Now(), ts.nightHours))
+	if err != nil {
+		logger.FromContext(ctx).Error("Error trying to call repository",
+			err,
+			zap.String("journey", "FindTransferLimits"))
+		return response.TransferLimitsResponse{}, err
+	}
+	return withRemainingAllowance(result), nil
+}
+
+// transferWindows returns the periods the limits are counted over at now.
+// The night runs from nightHours.NightStartHour up to NightEndHour, in the
+// local time of the calendar. It crosses midnight when the start hour is
+// after the end hour, such as 20 to 6, and stays within a day otherwise,
+// such as 0 to 6.
+func transferWindows(now time.Time, nightHours config.TransferLimit) repository.TransferWindows {
+	local := now.In(calendar.Location)
+	startHour := nightHours.NightStartHour
+	endHour := nightHours.NightEndHour
+
+	windows := repository.TransferWindows{
+		DayStart:   calendar.StartOfDay(local),
+		MonthStart: calendar.StartOfMonth(local),
+		HourStart:  now.Add(-time.Hour),
+		NightStart: now,
+	}
+
+	today := calendar.StartOfDay(local)
+	hour := local.Hour()
+	switch {
+	case startHour < endHour:
+		if hour >= startHour && hour < endHour {
+			windows.IsNight = true
+			windows.NightStart = today.Add(time.Duration(startHour) * time.Hour)
+		}
+	case hour >= startHour:
+		windows.IsNight = true
+		windows.NightStart = today.Add(time.Duration(startHour) * time.Hour)
+	case hour < endHour:
+		windows.IsNight = true
+		windows.NightStart = today.AddDate(0, 0, -1).Add(time.Duration(startHour) * time.Hour)
+	}
+
+	return windows
+}
+
+func withRemainingAllowance(limits response.TransferLimitsResponse) response.TransferLimitsResponse {
+	limits.DailyRemaining = math.Max(0, limits.DailyLimit-limits.DailyUsed)
+	limits.MonthlyRemaining = math.Max(0, limits.MonthlyLimit-limits.MonthlyUsed)
+	limits.NightlyRemaining = math.Max(0, limits.NightlyLimit-limits.NightlyUsed)
+	limits.HourlyTransfersRemaining = max(0, limits.MaxTransfersPerHour-limits.TransfersLastHour)
+
+	available := math.Min(limits.MaxPerTransaction, math.Min(limits.DailyRemaining, limits.MonthlyRemaining))
+	if limits.IsNight {
+		available = math.Min(available, limits.NightlyRemaining)
+	}
+	if limits.HourlyTransfersRemaining == 0 {
+		available = 0
+	}
+	limits.AvailableForTransfer = available
+
+	return limits
+}
+
+func checkTransferLimits(limits response.TransferLimitsResponse, amount float64) *http_error.HttpError {
+	return checkBatchTransferLimits(limits, []float64{amount})
+}
+
+// checkBatchTransferLimits applies the limits to transfers made at once: each
+// amount against the per transaction limit, their number against the hourly
+// count and their total against the nightly, daily and monthly allowances.
+func checkBatchTransferLimits(limits response.TransferLimitsResponse, amounts []float64) *http_error.HttpError {
+	limits = withRemainingAllowance(limits)
+
+	var largest float64
+	var cents int64
+	for _, amount := range amounts {
+		largest = math.Max(largest, amount)
+		cents += int64(math.Round(amount * 100))
+	}
+	total := float64(cents) / 100
+
+	switch {
+	case largest > limits.MaxPerTransaction:
+		return limitExceededError("Amount exceeds the per transaction limit", "per_transaction_limit_exceeded", limits.MaxPerTransaction, limits)
+	case len(amounts) > limits.HourlyTransfersRemaining:
+		return limitExceededError("Too many transfers in the last hour", "hourly_transfer_limit_exceeded", limits.MaxTransfersPerHour, limits)
+	case limits.IsNight && total > limits.NightlyRemaining:
+		return limitExceededError("Amount exceeds the nightly limit", "nightly_limit_exceeded", limits.NightlyLimit, limits)
+	case total > limits.DailyRemaining:
+		return limitExceededError("Amount exceeds the daily limit", "daily_limit_exceeded", limits.DailyLimit, limits)
+	case total > limits.MonthlyRemaining:
+		return limitExceededError("Amount exceeds the monthly limit", "monthly_limit_exceeded", limits.MonthlyLimit, limits)
+	}
+
+	return nil
+}
+
+func limitExceededError(message string, code string, limit interface{}, limits response.TransferLimitsResponse) *http_error.HttpError {
+	return http_error.NewUnprocessableEntityError(message, code, map[string]interface{}{
+		"tier":                       limits.Tier,
+		"limit":                      limit,
+		"remaining":                  limits.AvailableForTransfer,
+		"hourly_transfers_remaining": limits.HourlyTransfersRemaining,
+	})
+}
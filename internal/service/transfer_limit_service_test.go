@@ -0,0 +1,45 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/felipeversiane/picpay-golang.git/config"
+	"github.com/felipeversiane/picpay-golang.git/internal/calendar"
+)
+
+func at(day int, hour int, minute int) time.Time {
+	return time.Date(2024, time.March, day, hour, minute, 0, 0, calendar.Location)
+}
+
+func TestTransferWindows_Night(t *testing.T) {
+	tests := []struct {
+		name       string
+		start, end int
+		now        time.Time
+		isNight    bool
+		nightStart time.Time
+	}{
+		{"crossing midnight, evening", 20, 6, at(10, 22, 30), true, at(10, 20, 0)},
+		{"crossing midnight, at the start", 20, 6, at(10, 20, 0), true, at(10, 20, 0)},
+		{"crossing midnight, early morning", 20, 6, at(10, 3, 30), true, at(9, 20, 0)},
+		{"crossing midnight, at the end", 20, 6, at(10, 6, 0), false, time.Time{}},
+		{"crossing midnight, afternoon", 20, 6, at(10, 15, 30), false, time.Time{}},
+		{"within a day, inside", 0, 6, at(10, 3, 30), true, at(10, 0, 0)},
+		{"within a day, at the end", 0, 6, at(10, 6, 0), false, time.Time{}},
+		{"within a day, afternoon", 0, 6, at(10, 15, 30), false, time.Time{}},
+		{"within a day, evening", 0, 6, at(10, 23, 30), false, time.Time{}},
+		{"within a day, late start", 1, 5, at(10, 0, 30), false, time.Time{}},
+	}
+
+	for _, tt := range tests {
+		windows := transferWindows(tt.now, config.TransferLimit{NightStartHour: tt.start, NightEndHour: tt.end})
+		if windows.IsNight != tt.isNight {
+			t.Errorf("%s: expected night %t and received %t", tt.name, tt.isNight, windows.IsNight)
+			continue
+		}
+		if tt.isNight && !windows.NightStart.Equal(tt.nightStart) {
+			t.Errorf("%s: expected the night to start at %s and received %s", tt.name, tt.nightStart, windows.NightStart)
+		}
+	}
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/felipeversiane/picpay-golang.git/config/http_error"
 	"github.com/felipeversiane/picpay-golang.git/config/logger"
+	"github.com/felipeversiane/picpay-golang.git/config/validation"
 	domain "github.com/felipeversiane/picpay-golang.git/internal"
 	"github.com/felipeversiane/picpay-golang.git/internal/entity/response"
 	"github.com/felipeversiane/picpay-golang.git/internal/repository"
@@ -28,31 +29,35 @@ type UserService interface {
 	InsertUserService(ctx context.Context, user domain.UserDomainInterface) (
 		response.UserResponse, *http_error.HttpError)
 	FindUserByDocumentService(
-		document string, ctx context.Context,
+		ctx context.Context, document string,
 	) (response.UserResponse, *http_error.HttpError)
 	FindUserByIDService(
-		id uuid.UUID, ctx context.Context,
+		ctx context.Context, id uuid.UUID,
 	) (response.UserResponse, *http_error.HttpError)
 	FindUserByEmailService(
-		email string, ctx context.Context,
+		ctx context.Context, email string,
 	) (response.UserResponse, *http_error.HttpError)
-	UpdateUserService(id uuid.UUID, user domain.UserDomainInterface, ctx context.Context) (response.UserResponse, *http_error.HttpError)
-	DeleteUserService(id uuid.UUID, ctx context.Context) *http_error.HttpError
+	UpdateUserService(ctx context.Context, id uuid.UUID, user domain.UserDomainInterface) (response.UserResponse, *http_error.HttpError)
+	DeleteUserService(ctx context.Context, id uuid.UUID) *http_error.HttpError
 }
 
 func (uc *userService) InsertUserService(ctx context.Context, user domain.UserDomainInterface) (response.UserResponse, *http_error.HttpError) {
+	if user.GetIsMerchant() && user.GetDocumentType() != validation.DocumentTypeCNPJ {
+		return response.UserResponse{}, http_error.NewBadRequestError("Merchants must be registered with a CNPJ")
+	}
+
 	user.EncryptPassword()
-	_, err := uc.FindUserByDocumentService(user.GetDocument(), ctx)
+	_, err := uc.FindUserByDocumentService(ctx, user.GetDocument())
 	if err == nil {
 		return response.UserResponse{}, http_error.NewBadRequestError("Document is already registered in another account")
 	}
-	_, err = uc.FindUserByEmailService(user.GetEmail(), ctx)
+	_, err = uc.FindUserByEmailService(ctx, user.GetEmail())
 	if err == nil {
 		return response.UserResponse{}, http_error.NewBadRequestError("Email is already registered in another account")
 	}
 	result, err := uc.userRepository.InsertUserRepository(ctx, user)
 	if err != nil {
-		logger.Error("Error trying to call repository",
+		logger.FromContext(ctx).Error("Error trying to call repository",
 			err,
 			zap.String("journey", "InsertUser"))
 		return response.UserResponse{}, err
@@ -60,10 +65,10 @@ func (uc *userService) InsertUserService(ctx context.Context, user domain.UserDo
 	return result, nil
 }
 
-func (uc *userService) FindUserByDocumentService(document string, ctx context.Context) (response.UserResponse, *http_error.HttpError) {
+func (uc *userService) FindUserByDocumentService(ctx context.Context, document string) (response.UserResponse, *http_error.HttpError) {
 	result, err := uc.userRepository.FindUserByDocumentRepository(ctx, document)
 	if err != nil {
-		logger.Error("Error trying to call repository",
+		logger.FromContext(ctx).Error("Error trying to call repository",
 			err,
 			zap.String("journey", "FindUserByDocument"))
 		return response.UserResponse{}, err
@@ -71,10 +76,10 @@ func (uc *userService) FindUserByDocumentService(document string, ctx context.Co
 	return result, nil
 }
 
-func (uc *userService) FindUserByIDService(id uuid.UUID, ctx context.Context) (response.UserResponse, *http_error.HttpError) {
+func (uc *userService) FindUserByIDService(ctx context.Context, id uuid.UUID) (response.UserResponse, *http_error.HttpError) {
 	result, err := uc.userRepository.FindUserByIDRepository(ctx, id)
 	if err != nil {
-		logger.Error("Error trying to call repository",
+		logger.FromContext(ctx).Error("Error trying to call repository",
 			err,
 			zap.String("journey", "FindUserByDocument"))
 		return response.UserResponse{}, err
@@ -82,10 +87,10 @@ func (uc *userService) FindUserByIDService(id uuid.UUID, ctx context.Context) (r
 	return result, nil
 }
 
-func (uc *userService) FindUserByEmailService(email string, ctx context.Context) (response.UserResponse, *http_error.HttpError) {
+func (uc *userService) FindUserByEmailService(ctx context.Context, email string) (response.UserResponse, *http_error.HttpError) {
 	result, err := uc.userRepository.FindUserByEmailRepository(ctx, email)
 	if err != nil {
-		logger.Error("Error trying to call repository",
+		logger.FromContext(ctx).Error("Error trying to call repository",
 			err,
 			zap.String("journey", "FindUserByEmail"))
 		return response.UserResponse{}, err
@@ -93,15 +98,18 @@ func (uc *userService) FindUserByEmailService(email string, ctx context.Context)
 	return result, nil
 }
 
-func (uc *userService) UpdateUserService(id uuid.UUID, user domain.UserDomainInterface, ctx context.Context) (response.UserResponse, *http_error.HttpError) {
-	_, err := uc.FindUserByIDService(id, ctx)
+func (uc *userService) UpdateUserService(ctx context.Context, id uuid.UUID, user domain.UserDomainInterface) (response.UserResponse, *http_error.HttpError) {
+	current, err := uc.FindUserByIDService(ctx, id)
 	if err != nil {
 		return response.UserResponse{}, http_error.NewNotFoundError("User not found")
 	}
+	if user.GetIsMerchant() && current.DocumentType != validation.DocumentTypeCNPJ {
+		return response.UserResponse{}, http_error.NewBadRequestError("Merchants must be registered with a CNPJ")
+	}
 
 	result, err := uc.userRepository.UpdateUserRepository(ctx, user, id)
 	if err != nil {
-		logger.Error("Error trying to call repository",
+		logger.FromContext(ctx).Error("Error trying to call repository",
 			err,
 			zap.String("journey", "UpdateUser"))
 		return response.UserResponse{}, err
@@ -109,15 +117,15 @@ func (uc *userService) UpdateUserService(id uuid.UUID, user domain.UserDomainInt
 	return result, nil
 }
 
-func (uc *userService) DeleteUserService(id uuid.UUID, ctx context.Context) *http_error.HttpError {
-	_, err := uc.FindUserByIDService(id, ctx)
+func (uc *userService) DeleteUserService(ctx context.Context, id uuid.UUID) *http_error.HttpError {
+	_, err := uc.FindUserByIDService(ctx, id)
 	if err != nil {
 		return http_error.NewNotFoundError("User not found")
 	}
 
 	err = uc.userRepository.DeleteUserRepository(ctx, id)
 	if err != nil {
-		logger.Error("Error trying to call repository",
+		logger.FromContext(ctx).Error("Error trying to call repository",
 			err,
 			zap.String("journey", "DeleteUser"))
 		return err
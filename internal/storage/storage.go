@@ -0,0 +1,97 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+var (
+	ErrNotFound   = errors.New("file not found")
+	ErrInvalidKey = errors.New("invalid file key")
+)
+
+// Storage keeps files by key. Keys are slash separated paths relative to the
+// root of the storage, such as "orders/<order id>/<attachment id>".
+type Storage interface {
+	Save(ctx context.Context, key string, content io.Reader) error
+	Open(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+}
+
+type localStorage struct {
+	root string
+}
+
+// NewLocalStorage returns a Storage that writes under the root directory of
+// the local filesystem. It stands in for an object storage.
+func NewLocalStorage(root string) Storage {
+	return &localStorage{strings.TrimSpace(root)}
+}
+
+// Save writes the content to a temporary file first and renames it, so a
+// failed upload never leaves a partial file behind the key.
+func (s *localStorage) Save(ctx context.Context, key string, content io.Reader) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := io.Copy(tmp, content); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
+
+func (s *localStorage) Open(ctx context.Context, key string) (io.ReadCloser, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrNotFound
+	}
+	return file, err
+}
+
+func (s *localStorage) Delete(ctx context.Context, key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	err = os.Remove(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+// path maps a key to a file under the root, refusing keys that would escape
+// it.
+func (s *localStorage) path(key string) (string, error) {
+	clean := filepath.Clean("/" + key)
+	if key == "" || clean == "/" || clean != "/"+key {
+		return "", ErrInvalidKey
+	}
+	return filepath.Join(s.root, filepath.FromSlash(clean)), nil
+}
@@ -15,8 +15,11 @@ type userDomain struct {
 	firstName  string
 	lastName   string
 	document   string
+	docType    string
 	balance    float64
 	isMerchant bool
+	category   string
+	tier       string
 	createdAt  time.Time
 	updatedAt  time.Time
 }
@@ -27,11 +30,14 @@ type UserDomainInterface interface {
 	GetIsMerchant() bool
 	GetCreatedAt() time.Time
 	GetDocument() string
+	GetDocumentType() string
 	GetUpdatedAt() time.Time
 	GetFirstName() string
 	GetLastName() string
 	GetPassword() string
 	GetBalance() float64
+	GetTier() string
+	GetMerchantCategory() string
 	EncryptPassword()
 }
 
@@ -41,8 +47,10 @@ func NewUserDomain(
 	first_name string,
 	last_name string,
 	document string,
+	documentType string,
 	balance float64,
 	isMerchant bool,
+	merchantCategory string,
 ) *userDomain {
 	return &userDomain{
 		id:         uuid.New(),
@@ -51,8 +59,10 @@ func NewUserDomain(
 		firstName:  first_name,
 		lastName:   last_name,
 		document:   document,
+		docType:    documentType,
 		balance:    balance,
 		isMerchant: isMerchant,
+		category:   merchantCategory,
 		createdAt:  time.Now(),
 		updatedAt:  time.Now(),
 	}
@@ -63,12 +73,16 @@ func NewUserUpdateDomain(
 	last_name string,
 	balance float64,
 	isMerchant bool,
+	tier string,
+	merchantCategory string,
 ) UserDomainInterface {
 	return &userDomain{
 		firstName:  first_name,
 		lastName:   last_name,
 		balance:    balance,
 		isMerchant: isMerchant,
+		tier:       tier,
+		category:   merchantCategory,
 		updatedAt:  time.Now(),
 	}
 }
@@ -109,10 +123,22 @@ func (u *userDomain) GetBalance() float64 {
 	return u.balance
 }
 
+func (u *userDomain) GetTier() string {
+	return u.tier
+}
+
+func (u *userDomain) GetMerchantCategory() string {
+	return u.category
+}
+
 func (u *userDomain) GetDocument() string {
 	return u.document
 }
 
+func (u *userDomain) GetDocumentType() string {
+	return u.docType
+}
+
 func (u *userDomain) EncryptPassword() {
 	hash := md5.New()
 	defer hash.Reset()
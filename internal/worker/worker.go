@@ -0,0 +1,89 @@
+package worker
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/felipeversiane/picpay-golang.git/config"
+	"github.com/felipeversiane/picpay-golang.git/config/http_error"
+	"github.com/felipeversiane/picpay-golang.git/config/logger"
+	"github.com/felipeversiane/picpay-golang.git/internal/service"
+	"go.uber.org/zap"
+)
+
+type Worker interface {
+	Run(ctx context.Context)
+}
+
+type periodicWorker struct {
+	name     string
+	interval time.Duration
+	task     func(ctx context.Context) *http_error.HttpError
+}
+
+func NewPeriodicWorker(
+	name string,
+	interval time.Duration,
+	task func(ctx context.Context) *http_error.HttpError,
+) Worker {
+	return &periodicWorker{
+		name, interval, task,
+	}
+}
+
+// Run executes the task on every tick until ctx is done. A task that is
+// already running when ctx is done is allowed to finish, so shutting down
+// never leaves a transfer half made.
+func (w *periodicWorker) Run(ctx context.Context) {
+	logger.FromContext(ctx).Info("Worker started", zap.String("journey", w.name))
+
+	taskCtx := context.WithoutCancel(ctx)
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			logger.FromContext(ctx).Info("Worker stopped", zap.String("journey", w.name))
+			return
+		case <-ticker.C:
+			if err := w.task(taskCtx); err != nil {
+				logger.FromContext(ctx).Error("Error running worker task", err, zap.String("journey", w.name))
+			}
+		}
+	}
+}
+
+// Services holds the services whose tasks run in the background. It is
+// built once by the composition root in cmd/api.
+type Services struct {
+	Recurrence     service.RecurrenceService
+	Settlement     service.SettlementService
+	PaymentRequest service.PaymentRequestService
+	Invoice        service.InvoiceService
+	Payout         service.PayoutService
+}
+
+// InitWorkers starts every worker in the background at the intervals of
+// cfg. The returned WaitGroup is done once all of them have stopped after
+// ctx is cancelled.
+func InitWorkers(ctx context.Context, s Services, cfg config.Workers) *sync.WaitGroup {
+	workers := []Worker{
+		NewPeriodicWorker("RecurrenceWorker", cfg.RecurrenceInterval, s.Recurrence.ExecuteDueRecurrencesService),
+		NewPeriodicWorker("SettlementWorker", cfg.SettlementInterval, s.Settlement.ReleaseDueSettlementsService),
+		NewPeriodicWorker("PaymentRequestWorker", cfg.PaymentRequestInterval, s.PaymentRequest.ExpirePaymentRequestsService),
+		NewPeriodicWorker("InvoiceWorker", cfg.InvoiceInterval, s.Invoice.MarkOverdueInvoicesService),
+		NewPeriodicWorker("PayoutWorker", cfg.PayoutInterval, s.Payout.ProcessPayoutBatchesService),
+	}
+
+	var wg sync.WaitGroup
+	for _, w := range workers {
+		wg.Add(1)
+		go func(w Worker) {
+			defer wg.Done()
+			w.Run(ctx)
+		}(w)
+	}
+	return &wg
+}
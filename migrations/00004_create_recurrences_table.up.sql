@@ -0,0 +1,29 @@
+CREATE TABLE IF NOT EXISTS recurrences (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    amount NUMERIC(10, 2) NOT NULL,
+    payee UUID NOT NULL,
+    payer UUID NOT NULL,
+    schedule VARCHAR(100) NOT NULL,
+    on_insufficient_funds VARCHAR(10) NOT NULL DEFAULT 'skip',
+    max_retries INTEGER NOT NULL DEFAULT 0,
+    retry_count INTEGER NOT NULL DEFAULT 0,
+    status VARCHAR(10) NOT NULL DEFAULT 'active',
+    next_run_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP DEFAULT now(),
+    updated_at TIMESTAMP DEFAULT now(),
+    CONSTRAINT fk_recurrence_payee FOREIGN KEY(payee) REFERENCES users(id) ON DELETE CASCADE,
+    CONSTRAINT fk_recurrence_payer FOREIGN KEY(payer) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_recurrences_due ON recurrences (status, next_run_at);
+
+CREATE TABLE IF NOT EXISTS recurrence_executions (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    recurrence_id UUID NOT NULL,
+    order_id UUID,
+    status VARCHAR(10) NOT NULL,
+    message VARCHAR(255) NOT NULL DEFAULT '',
+    executed_at TIMESTAMP DEFAULT now(),
+    CONSTRAINT fk_execution_recurrence FOREIGN KEY(recurrence_id) REFERENCES recurrences(id) ON DELETE CASCADE,
+    CONSTRAINT fk_execution_order FOREIGN KEY(order_id) REFERENCES orders(id) ON DELETE SET NULL
+);
@@ -0,0 +1,25 @@
+CREATE TABLE IF NOT EXISTS transfer_limits (
+    tier VARCHAR(20) PRIMARY KEY,
+    max_per_transaction NUMERIC(10, 2) NOT NULL,
+    daily_limit NUMERIC(10, 2) NOT NULL,
+    monthly_limit NUMERIC(10, 2) NOT NULL,
+    max_transfers_per_hour INTEGER NOT NULL,
+    nightly_limit NUMERIC(10, 2) NOT NULL,
+    created_at TIMESTAMP DEFAULT now(),
+    updated_at TIMESTAMP DEFAULT now()
+);
+
+INSERT INTO transfer_limits (tier, max_per_transaction, daily_limit, monthly_limit, max_transfers_per_hour, nightly_limit)
+VALUES
+    ('basic', 1000.00, 2000.00, 10000.00, 10, 500.00),
+    ('verified', 5000.00, 10000.00, 50000.00, 30, 1000.00),
+    ('premium', 20000.00, 50000.00, 200000.00, 60, 5000.00)
+ON CONFLICT (tier) DO NOTHING;
+
+ALTER TABLE users ADD COLUMN IF NOT EXISTS tier VARCHAR(20) NOT NULL DEFAULT 'basic';
+
+ALTER TABLE users DROP CONSTRAINT IF EXISTS fk_user_tier;
+ALTER TABLE users
+ADD CONSTRAINT fk_user_tier FOREIGN KEY (tier) REFERENCES transfer_limits(tier);
+
+CREATE INDEX IF NOT EXISTS idx_orders_payer_created_at ON orders (payer, created_at);
@@ -0,0 +1,49 @@
+ALTER TABLE users ADD COLUMN IF NOT EXISTS merchant_category VARCHAR(50) NOT NULL DEFAULT '';
+
+CREATE TABLE IF NOT EXISTS fee_plans (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    name VARCHAR(100) NOT NULL,
+    merchant_id UUID,
+    category VARCHAR(50),
+    percentage NUMERIC(5, 2) NOT NULL DEFAULT 0,
+    fixed_fee NUMERIC(10, 2) NOT NULL DEFAULT 0,
+    created_at TIMESTAMP DEFAULT now(),
+    updated_at TIMESTAMP DEFAULT now(),
+    CONSTRAINT fk_fee_plan_merchant FOREIGN KEY(merchant_id) REFERENCES users(id) ON DELETE CASCADE,
+    CONSTRAINT chk_fee_plan_target CHECK (merchant_id IS NULL OR category IS NULL),
+    CONSTRAINT chk_fee_plan_percentage CHECK (percentage >= 0 AND percentage <= 100),
+    CONSTRAINT chk_fee_plan_fixed_fee CHECK (fixed_fee >= 0)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_fee_plans_merchant ON fee_plans (merchant_id) WHERE merchant_id IS NOT NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS uq_fee_plans_category ON fee_plans (category) WHERE category IS NOT NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS uq_fee_plans_default ON fee_plans ((merchant_id IS NULL AND category IS NULL)) WHERE merchant_id IS NULL AND category IS NULL;
+
+CREATE TABLE IF NOT EXISTS platform_accounts (
+    id VARCHAR(50) PRIMARY KEY,
+    balance NUMERIC(12, 2) NOT NULL DEFAULT 0,
+    updated_at TIMESTAMP DEFAULT now()
+);
+
+INSERT INTO platform_accounts (id, balance) VALUES ('revenue', 0) ON CONFLICT (id) DO NOTHING;
+
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS fee NUMERIC(10, 2) NOT NULL DEFAULT 0;
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS net_amount NUMERIC(10, 2);
+UPDATE orders SET net_amount = amount WHERE net_amount IS NULL;
+ALTER TABLE orders ALTER COLUMN net_amount SET NOT NULL;
+
+CREATE TABLE IF NOT EXISTS fee_ledger (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    order_id UUID NOT NULL,
+    merchant_id UUID NOT NULL,
+    fee_plan_id UUID,
+    account_id VARCHAR(50) NOT NULL DEFAULT 'revenue',
+    gross_amount NUMERIC(10, 2) NOT NULL,
+    fee_amount NUMERIC(10, 2) NOT NULL,
+    net_amount NUMERIC(10, 2) NOT NULL,
+    created_at TIMESTAMP DEFAULT now(),
+    CONSTRAINT fk_fee_ledger_order FOREIGN KEY(order_id) REFERENCES orders(id) ON DELETE CASCADE,
+    CONSTRAINT fk_fee_ledger_merchant FOREIGN KEY(merchant_id) REFERENCES users(id) ON DELETE CASCADE,
+    CONSTRAINT fk_fee_ledger_plan FOREIGN KEY(fee_plan_id) REFERENCES fee_plans(id) ON DELETE SET NULL,
+    CONSTRAINT fk_fee_ledger_account FOREIGN KEY(account_id) REFERENCES platform_accounts(id)
+);
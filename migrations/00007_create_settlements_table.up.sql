@@ -0,0 +1,23 @@
+ALTER TABLE fee_plans ADD COLUMN IF NOT EXISTS settlement_days INTEGER NOT NULL DEFAULT 0;
+
+ALTER TABLE fee_plans DROP CONSTRAINT IF EXISTS chk_fee_plan_settlement_days;
+ALTER TABLE fee_plans
+ADD CONSTRAINT chk_fee_plan_settlement_days CHECK (settlement_days >= 0);
+
+ALTER TABLE users ADD COLUMN IF NOT EXISTS pending_balance NUMERIC(10, 2) NOT NULL DEFAULT 0;
+
+CREATE TABLE IF NOT EXISTS settlements (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    merchant_id UUID NOT NULL,
+    order_id UUID NOT NULL,
+    amount NUMERIC(10, 2) NOT NULL,
+    scheduled_for DATE NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    settled_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT now(),
+    CONSTRAINT fk_settlement_merchant FOREIGN KEY(merchant_id) REFERENCES users(id) ON DELETE CASCADE,
+    CONSTRAINT fk_settlement_order FOREIGN KEY(order_id) REFERENCES orders(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_settlements_pending ON settlements (status, scheduled_for);
+CREATE INDEX IF NOT EXISTS idx_settlements_merchant ON settlements (merchant_id, scheduled_for);
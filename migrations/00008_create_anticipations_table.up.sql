@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS anticipations (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    merchant_id UUID NOT NULL,
+    monthly_rate NUMERIC(5, 2) NOT NULL,
+    gross_amount NUMERIC(10, 2) NOT NULL,
+    fee NUMERIC(10, 2) NOT NULL,
+    net_amount NUMERIC(10, 2) NOT NULL,
+    created_at TIMESTAMP DEFAULT now(),
+    CONSTRAINT fk_anticipation_merchant FOREIGN KEY(merchant_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+ALTER TABLE settlements ADD COLUMN IF NOT EXISTS anticipation_id UUID;
+
+ALTER TABLE settlements DROP CONSTRAINT IF EXISTS fk_settlement_anticipation;
+ALTER TABLE settlements
+ADD CONSTRAINT fk_settlement_anticipation FOREIGN KEY(anticipation_id) REFERENCES anticipations(id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_anticipations_merchant ON anticipations (merchant_id, created_at);
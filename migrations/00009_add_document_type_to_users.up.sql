@@ -0,0 +1,31 @@
+-- Documents are stored as digits only from now on. Users whose documents
+-- only differ by punctuation, such as 123.456.789-00 and 12345678900, would
+-- break the unique constraint, so they are reported and must be resolved by
+-- hand before the migration runs again.
+DO $$
+DECLARE
+    duplicates TEXT;
+BEGIN
+    SELECT string_agg(ids, '; ') INTO duplicates
+    FROM (
+        SELECT string_agg(id::text, ', ' ORDER BY created_at) AS ids
+        FROM users
+        GROUP BY regexp_replace(document, '[^0-9]', '', 'g')
+        HAVING count(*) > 1
+    ) AS groups;
+
+    IF duplicates IS NOT NULL THEN
+        RAISE EXCEPTION 'users with the same document once punctuation is removed: %', duplicates
+            USING HINT = 'Merge or correct these users, run "migrate force 8" and migrate again.';
+    END IF;
+END $$;
+
+UPDATE users SET document = regexp_replace(document, '[^0-9]', '', 'g');
+
+ALTER TABLE users ADD COLUMN IF NOT EXISTS document_type VARCHAR(4) NOT NULL DEFAULT '';
+
+UPDATE users SET document_type = CASE length(document)
+    WHEN 11 THEN 'cpf'
+    WHEN 14 THEN 'cnpj'
+    ELSE ''
+END;
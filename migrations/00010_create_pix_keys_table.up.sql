@@ -0,0 +1,34 @@
+CREATE TABLE IF NOT EXISTS pix_keys (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id UUID NOT NULL,
+    key_type VARCHAR(10) NOT NULL,
+    key_value VARCHAR(255) NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    verification_code VARCHAR(6),
+    verification_expires_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT now(),
+    updated_at TIMESTAMP DEFAULT now(),
+    CONSTRAINT fk_pix_key_user FOREIGN KEY(user_id) REFERENCES users(id) ON DELETE CASCADE,
+    CONSTRAINT chk_pix_key_type CHECK (key_type IN ('email', 'phone', 'document', 'evp'))
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_pix_keys_active_value ON pix_keys (key_value) WHERE status = 'active';
+CREATE UNIQUE INDEX IF NOT EXISTS uq_pix_keys_user_value ON pix_keys (user_id, key_value);
+CREATE INDEX IF NOT EXISTS idx_pix_keys_user ON pix_keys (user_id);
+
+CREATE TABLE IF NOT EXISTS pix_key_claims (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    key_id UUID NOT NULL,
+    claimer_id UUID NOT NULL,
+    donor_id UUID NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'open',
+    verification_code VARCHAR(6) NOT NULL,
+    verification_expires_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP DEFAULT now(),
+    resolved_at TIMESTAMP,
+    CONSTRAINT fk_pix_key_claim_key FOREIGN KEY(key_id) REFERENCES pix_keys(id) ON DELETE CASCADE,
+    CONSTRAINT fk_pix_key_claim_claimer FOREIGN KEY(claimer_id) REFERENCES users(id) ON DELETE CASCADE,
+    CONSTRAINT fk_pix_key_claim_donor FOREIGN KEY(donor_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_pix_key_claims_open ON pix_key_claims (key_id) WHERE status = 'open';
@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS qr_codes (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    merchant_id UUID NOT NULL,
+    code_type VARCHAR(10) NOT NULL,
+    amount NUMERIC(10, 2),
+    payload TEXT NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'active',
+    expires_at TIMESTAMP,
+    order_id UUID,
+    created_at TIMESTAMP DEFAULT now(),
+    updated_at TIMESTAMP DEFAULT now(),
+    CONSTRAINT fk_qr_code_merchant FOREIGN KEY(merchant_id) REFERENCES users(id) ON DELETE CASCADE,
+    CONSTRAINT fk_qr_code_order FOREIGN KEY(order_id) REFERENCES orders(id) ON DELETE SET NULL,
+    CONSTRAINT chk_qr_code_type CHECK (code_type IN ('static', 'dynamic'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_qr_codes_merchant ON qr_codes (merchant_id, created_at);
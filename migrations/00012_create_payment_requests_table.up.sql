@@ -0,0 +1,20 @@
+CREATE TABLE IF NOT EXISTS payment_requests (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    requester_id UUID NOT NULL,
+    payer_id UUID NOT NULL,
+    amount NUMERIC(10, 2) NOT NULL,
+    note VARCHAR(140) NOT NULL DEFAULT '',
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    expires_at TIMESTAMP NOT NULL,
+    order_id UUID,
+    created_at TIMESTAMP DEFAULT now(),
+    updated_at TIMESTAMP DEFAULT now(),
+    CONSTRAINT fk_payment_request_requester FOREIGN KEY(requester_id) REFERENCES users(id) ON DELETE CASCADE,
+    CONSTRAINT fk_payment_request_payer FOREIGN KEY(payer_id) REFERENCES users(id) ON DELETE CASCADE,
+    CONSTRAINT fk_payment_request_order FOREIGN KEY(order_id) REFERENCES orders(id) ON DELETE SET NULL,
+    CONSTRAINT chk_payment_request_amount CHECK (amount > 0)
+);
+
+CREATE INDEX IF NOT EXISTS idx_payment_requests_requester ON payment_requests (requester_id, status);
+CREATE INDEX IF NOT EXISTS idx_payment_requests_payer ON payment_requests (payer_id, status);
+CREATE INDEX IF NOT EXISTS idx_payment_requests_pending ON payment_requests (expires_at) WHERE status = 'pending';
@@ -0,0 +1,20 @@
+CREATE TABLE IF NOT EXISTS bill_splits (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    owner_id UUID NOT NULL,
+    description VARCHAR(140) NOT NULL,
+    total_amount NUMERIC(10, 2) NOT NULL,
+    split_mode VARCHAR(10) NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'open',
+    settled_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT now(),
+    updated_at TIMESTAMP DEFAULT now(),
+    CONSTRAINT fk_bill_split_owner FOREIGN KEY(owner_id) REFERENCES users(id) ON DELETE CASCADE,
+    CONSTRAINT chk_bill_split_mode CHECK (split_mode IN ('equal', 'custom'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_bill_splits_owner ON bill_splits (owner_id, created_at);
+
+ALTER TABLE payment_requests ADD COLUMN IF NOT EXISTS split_id UUID;
+ALTER TABLE payment_requests ADD CONSTRAINT fk_payment_request_split FOREIGN KEY(split_id) REFERENCES bill_splits(id) ON DELETE CASCADE;
+
+CREATE INDEX IF NOT EXISTS idx_payment_requests_split ON payment_requests (split_id);
@@ -0,0 +1,29 @@
+CREATE TABLE IF NOT EXISTS payment_links (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    merchant_id UUID NOT NULL,
+    slug VARCHAR(16) NOT NULL,
+    description VARCHAR(140) NOT NULL,
+    amount NUMERIC(10, 2),
+    reusable BOOLEAN NOT NULL DEFAULT false,
+    status VARCHAR(20) NOT NULL DEFAULT 'active',
+    expires_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT now(),
+    updated_at TIMESTAMP DEFAULT now(),
+    CONSTRAINT fk_payment_link_merchant FOREIGN KEY(merchant_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_payment_links_slug ON payment_links (slug);
+CREATE INDEX IF NOT EXISTS idx_payment_links_merchant ON payment_links (merchant_id, created_at);
+
+CREATE TABLE IF NOT EXISTS payment_link_payments (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    payment_link_id UUID NOT NULL,
+    order_id UUID NOT NULL,
+    payer_id UUID NOT NULL,
+    amount NUMERIC(10, 2) NOT NULL,
+    created_at TIMESTAMP DEFAULT now(),
+    CONSTRAINT fk_payment_link_payment_link FOREIGN KEY(payment_link_id) REFERENCES payment_links(id) ON DELETE CASCADE,
+    CONSTRAINT fk_payment_link_payment_order FOREIGN KEY(order_id) REFERENCES orders(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_payment_link_payments_link ON payment_link_payments (payment_link_id, created_at);
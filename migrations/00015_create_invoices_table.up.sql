@@ -0,0 +1,36 @@
+CREATE TABLE IF NOT EXISTS invoices (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    merchant_id UUID NOT NULL,
+    customer_id UUID NOT NULL,
+    amount NUMERIC(10, 2) NOT NULL,
+    late_fee NUMERIC(5, 2) NOT NULL DEFAULT 0,
+    daily_interest NUMERIC(6, 4) NOT NULL DEFAULT 0,
+    due_date DATE NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'open',
+    paid_amount NUMERIC(10, 2),
+    order_id UUID,
+    paid_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT now(),
+    updated_at TIMESTAMP DEFAULT now(),
+    CONSTRAINT fk_invoice_merchant FOREIGN KEY(merchant_id) REFERENCES users(id) ON DELETE CASCADE,
+    CONSTRAINT fk_invoice_customer FOREIGN KEY(customer_id) REFERENCES users(id) ON DELETE CASCADE,
+    CONSTRAINT fk_invoice_order FOREIGN KEY(order_id) REFERENCES orders(id) ON DELETE SET NULL,
+    CONSTRAINT chk_invoice_status CHECK (status IN ('open', 'paid', 'overdue', 'cancelled'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_invoices_merchant ON invoices (merchant_id, due_date);
+CREATE INDEX IF NOT EXISTS idx_invoices_customer ON invoices (customer_id, due_date);
+CREATE INDEX IF NOT EXISTS idx_invoices_open ON invoices (due_date) WHERE status = 'open';
+
+CREATE TABLE IF NOT EXISTS invoice_items (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    invoice_id UUID NOT NULL,
+    description VARCHAR(140) NOT NULL,
+    quantity INTEGER NOT NULL,
+    unit_price NUMERIC(10, 2) NOT NULL,
+    amount NUMERIC(10, 2) NOT NULL,
+    position INTEGER NOT NULL,
+    CONSTRAINT fk_invoice_item_invoice FOREIGN KEY(invoice_id) REFERENCES invoices(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_invoice_items_invoice ON invoice_items (invoice_id, position);
@@ -0,0 +1,32 @@
+CREATE TABLE IF NOT EXISTS payout_batches (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    payer_id UUID NOT NULL,
+    mode VARCHAR(20) NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    total_items INTEGER NOT NULL,
+    total_amount NUMERIC(12, 2) NOT NULL,
+    succeeded_items INTEGER NOT NULL DEFAULT 0,
+    failed_items INTEGER NOT NULL DEFAULT 0,
+    completed_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT now(),
+    updated_at TIMESTAMP DEFAULT now(),
+    CONSTRAINT fk_payout_batch_payer FOREIGN KEY(payer_id) REFERENCES users(id) ON DELETE CASCADE,
+    CONSTRAINT chk_payout_batch_mode CHECK (mode IN ('partial', 'all_or_nothing'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_payout_batches_pending ON payout_batches (created_at) WHERE status = 'pending';
+
+CREATE TABLE IF NOT EXISTS payout_items (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    batch_id UUID NOT NULL,
+    position INTEGER NOT NULL,
+    payee_id UUID NOT NULL,
+    amount NUMERIC(10, 2) NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    message VARCHAR(255) NOT NULL DEFAULT '',
+    processed_at TIMESTAMP,
+    CONSTRAINT fk_payout_item_batch FOREIGN KEY(batch_id) REFERENCES payout_batches(id) ON DELETE CASCADE,
+    CONSTRAINT chk_payout_item_amount CHECK (amount > 0)
+);
+
+CREATE INDEX IF NOT EXISTS idx_payout_items_batch ON payout_items (batch_id, position);
@@ -0,0 +1 @@
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS message VARCHAR(140) NOT NULL DEFAULT '';
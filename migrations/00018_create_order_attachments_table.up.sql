@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS order_attachments (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    order_id UUID NOT NULL,
+    uploaded_by UUID NOT NULL,
+    file_name VARCHAR(255) NOT NULL,
+    content_type VARCHAR(100) NOT NULL,
+    size BIGINT NOT NULL,
+    storage_key VARCHAR(255) NOT NULL UNIQUE,
+    created_at TIMESTAMP DEFAULT now(),
+    CONSTRAINT fk_order_attachment_order FOREIGN KEY(order_id) REFERENCES orders(id) ON DELETE CASCADE,
+    CONSTRAINT fk_order_attachment_user FOREIGN KEY(uploaded_by) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_order_attachments_order ON order_attachments (order_id, created_at);
@@ -0,0 +1,2 @@
+ALTER TABLE pix_keys ADD COLUMN IF NOT EXISTS verification_attempts INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE pix_key_claims ADD COLUMN IF NOT EXISTS verification_attempts INTEGER NOT NULL DEFAULT 0;
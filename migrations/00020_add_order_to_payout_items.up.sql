@@ -0,0 +1,6 @@
+ALTER TABLE payout_items ADD COLUMN IF NOT EXISTS order_id UUID;
+
+ALTER TABLE payout_items DROP CONSTRAINT IF EXISTS fk_payout_item_order;
+ALTER TABLE payout_items ADD CONSTRAINT fk_payout_item_order FOREIGN KEY(order_id) REFERENCES orders(id);
+
+CREATE INDEX IF NOT EXISTS idx_payout_batches_processing ON payout_batches (updated_at) WHERE status = 'processing';
@@ -0,0 +1,5 @@
+ALTER TABLE pix_key_claims ADD COLUMN IF NOT EXISTS claimable_at TIMESTAMP;
+
+UPDATE pix_key_claims SET claimable_at = COALESCE(created_at, now()) WHERE claimable_at IS NULL;
+
+ALTER TABLE pix_key_claims ALTER COLUMN claimable_at SET NOT NULL;